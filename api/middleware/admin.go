@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"MetaFarmBackend/component/apikey"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware 管理接口认证, 只允许配置了admin的API Key调用
+func AdminMiddleware(keys *apikey.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name, ok := keys.VerifyAdmin(c.GetHeader(APIKeyHeader))
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, Response{
+				Code:    http.StatusForbidden,
+				Message: "无权调用管理接口",
+			})
+			return
+		}
+		c.Set("api_key_name", name)
+		c.Next()
+	}
+}
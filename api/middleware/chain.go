@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireChain 链相关接口保护中间件, 降级模式下直接返回503
+func RequireChain(enabled func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled() {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "链服务暂不可用"})
+			return
+		}
+		c.Next()
+	}
+}
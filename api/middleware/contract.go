@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"MetaFarmBackend/component/logger"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// 契约校验模式
+const (
+	ContractModeOff    = "off"    // 关闭
+	ContractModeLog    = "log"    // 仅记录违反契约的请求与响应
+	ContractModeStrict = "strict" // 违反契约时返回错误, 用于测试环境
+)
+
+// ContractValidator 按接口文档校验真实请求与响应
+type ContractValidator struct {
+	router routers.Router
+	strict bool
+}
+
+// NewContractValidator 根据swag生成的swagger 2.0文档创建校验器
+func NewContractValidator(spec []byte, mode string) (*ContractValidator, error) {
+	// openapi2conv不会转换additionalProperties等位置的引用, 预先统一改写为v3引用路径
+	spec = bytes.ReplaceAll(spec, []byte(`"#/definitions/`), []byte(`"#/components/schemas/`))
+
+	var doc2 openapi2.T
+	if err := json.Unmarshal(spec, &doc2); err != nil {
+		return nil, errors.Wrap(err, "解析接口文档失败")
+	}
+	doc3, err := openapi2conv.ToV3(&doc2)
+	if err != nil {
+		return nil, errors.Wrap(err, "转换接口文档失败")
+	}
+	// 不限制host, 任意部署地址都按路径匹配
+	doc3.Servers = nil
+
+	router, err := gorillamux.NewRouter(doc3)
+	if err != nil {
+		return nil, errors.Wrap(err, "构建契约路由失败")
+	}
+	return &ContractValidator{router: router, strict: mode == ContractModeStrict}, nil
+}
+
+// bufferedWriter 缓存响应, 校验通过后再写出
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   *bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// ContractValidationMiddleware 接口契约校验中间件
+// log模式只记录违反契约的请求与响应; strict模式下请求不合法返回400, 响应不合法返回500
+func ContractValidationMiddleware(v *ContractValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// WebSocket与SSE长连接无法缓冲整个响应, 不做校验
+		if c.IsWebsocket() || c.GetHeader("Accept") == "text/event-stream" {
+			c.Next()
+			return
+		}
+
+		route, pathParams, err := v.router.FindRoute(c.Request)
+		if err != nil {
+			// 未在文档中定义的接口(如/swagger自身)不做校验
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "读取请求体失败"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestInput := &openapi3filter.RequestValidationInput{
+			Request:    c.Request.Clone(c.Request.Context()),
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		requestInput.Request.Body = io.NopCloser(bytes.NewReader(body))
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), requestInput); err != nil {
+			logger.Errorf("请求违反接口契约: %s %s, err: %v", c.Request.Method, c.Request.URL.Path, err)
+			if v.strict {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "请求违反接口契约: " + err.Error()})
+				return
+			}
+		}
+
+		original := c.Writer
+		writer := &bufferedWriter{ResponseWriter: original, status: http.StatusOK, body: &bytes.Buffer{}}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		responseInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: requestInput,
+			Status:                 writer.status,
+			Header:                 original.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(writer.body.Bytes())),
+		}
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), responseInput); err != nil {
+			logger.Errorf("响应违反接口契约: %s %s, status: %d, err: %v", c.Request.Method, c.Request.URL.Path, writer.status, err)
+			if v.strict {
+				original.Header().Set("Content-Type", "application/json; charset=utf-8")
+				original.WriteHeader(http.StatusInternalServerError)
+				msg, _ := json.Marshal(gin.H{"error": "响应违反接口契约: " + err.Error()})
+				original.Write(msg)
+				return
+			}
+		}
+
+		original.WriteHeader(writer.status)
+		original.Write(writer.body.Bytes())
+	}
+}
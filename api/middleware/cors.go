@@ -10,7 +10,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	c := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Authorization"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Authorization", APIKeyHeader, IdempotencyKeyHeader, "Last-Event-ID"},
+		ExposedHeaders:   []string{"Idempotent-Replayed", APIVersionHeader, "Deprecation", "Sunset", "Link"},
 		AllowCredentials: true,
 		Debug:            false,
 	})
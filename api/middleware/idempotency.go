@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"MetaFarmBackend/component/idempotency"
+	"MetaFarmBackend/component/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyHeader 幂等键请求头
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// captureWriter 记录响应体以便保存首次执行结果
+type captureWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// IdempotencyMiddleware 幂等键中间件
+// 首次请求的状态码和响应体在窗口期内被重放; 相同幂等键携带不同请求体时返回422; 并发的重复请求等待首个请求完成后重放
+func IdempotencyMiddleware(store *idempotency.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > 128 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "幂等键长度不能超过128"})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "读取请求体失败"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		scopedKey := idempotencyScope(c) + ":" + key
+		fingerprint := idempotency.Fingerprint(c.Request.Method, c.Request.URL.Path, body)
+
+		record, acquired, err := store.Acquire(ctx, scopedKey, fingerprint)
+		if err != nil {
+			logger.Errorf("幂等键检查失败: %v, key: %s", err, scopedKey)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "幂等服务暂不可用, 请稍后重试"})
+			return
+		}
+
+		if !acquired {
+			if record.Fingerprint != fingerprint {
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "幂等键已被用于不同的请求"})
+				return
+			}
+			if record.Status == idempotency.StatusProcessing {
+				record, err = store.Wait(ctx, scopedKey)
+				if err != nil {
+					if errors.Is(err, idempotency.ErrWaitTimeout) {
+						c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "相同请求正在处理中"})
+						return
+					}
+					c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "幂等服务暂不可用, 请稍后重试"})
+					return
+				}
+				if record == nil {
+					// 首个请求执行失败已释放幂等键, 提示客户端重试
+					c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "相同请求执行失败, 请重试"})
+					return
+				}
+			}
+			replay(c, record)
+			return
+		}
+
+		// 客户端超时断开后请求上下文会被取消, 而处理函数可能已提交, 保存结果不能随之失败
+		storeCtx := context.WithoutCancel(ctx)
+		release := func() {
+			if err := store.Release(storeCtx, scopedKey); err != nil {
+				logger.Errorf("释放幂等键失败: %v, key: %s", err, scopedKey)
+			}
+		}
+
+		writer := &captureWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+		stopRenew := store.KeepAlive(storeCtx, scopedKey, fingerprint)
+		func() {
+			// 处理函数结束后停止续期; panic时释放幂等键后继续向上抛出, 由Recovery中间件处理
+			defer func() {
+				stopRenew()
+				if r := recover(); r != nil {
+					release()
+					panic(r)
+				}
+			}()
+			c.Next()
+		}()
+
+		// 服务端错误不缓存结果, 允许客户端使用同一幂等键重试
+		if writer.Status() >= http.StatusInternalServerError {
+			release()
+			return
+		}
+		if err := store.Complete(storeCtx, scopedKey, &idempotency.Record{
+			Fingerprint: fingerprint,
+			Code:        writer.Status(),
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		}); err != nil {
+			logger.Errorf("保存幂等记录失败: %v, key: %s", err, scopedKey)
+		}
+	}
+}
+
+// replay 重放首次执行的响应
+func replay(c *gin.Context, record *idempotency.Record) {
+	c.Header("Idempotent-Replayed", "true")
+	c.Data(record.Code, record.ContentType, record.Body)
+	c.Abort()
+}
+
+// idempotencyScope 幂等键按调用方隔离, 避免不同用户的键互相冲突; 只使用认证后的钱包地址,
+// 客户端可任意填写的user_address请求头不能用于隔离, 否则可冒用他人的键重放其响应
+func idempotencyScope(c *gin.Context) string {
+	if addr := c.GetString("wallet_address"); addr != "" {
+		return "user:" + addr
+	}
+	return "ip:" + c.ClientIP()
+}
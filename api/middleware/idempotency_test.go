@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestIdempotencyScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		wallet string
+		header string
+		want   string
+	}{
+		{name: "已认证用户", wallet: "0xabc", header: "0xother", want: "user:0xabc"},
+		{name: "未认证时忽略user_address请求头", header: "0xother", want: "ip:192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("POST", "/api/v1/land/market/buy", nil)
+			c.Request.Header.Set("user_address", tt.header)
+			if tt.wallet != "" {
+				c.Set("wallet_address", tt.wallet)
+			}
+			if got := idempotencyScope(c); got != tt.want {
+				t.Fatalf("scope = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"MetaFarmBackend/component/apikey"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader 第三方调用方使用的API Key请求头
+const APIKeyHeader = "X-API-Key"
+
+// RateLimitMiddleware 令牌桶限流中间件
+// 调用方优先按已认证钱包地址区分, 其次校验通过的API Key, 最后客户端IP
+func RateLimitMiddleware(limiter *ratelimit.Limiter, keys *apikey.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !limiter.Enabled() {
+			c.Next()
+			return
+		}
+
+		rule := limiter.Match(c.Request.URL.Path)
+		result, err := limiter.Take(c.Request.Context(), rule, rateLimitIdentity(c, keys))
+		if err != nil {
+			// redis异常时放行, 避免限流组件拖垮业务
+			logger.Errorf("限流检查失败, 放行请求: %v, path: %s", err, c.Request.URL.Path)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.FormatInt(ratelimit.Seconds(result.Reset), 10))
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.FormatInt(ratelimit.Seconds(result.RetryAfter), 10))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, Response{
+				Code:    http.StatusTooManyRequests,
+				Message: "请求过于频繁, 请稍后再试",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateLimitIdentity 获取限流维度的调用方标识
+// 未通过校验的API Key按IP限流, 避免伪造Key绕过配额
+func rateLimitIdentity(c *gin.Context, keys *apikey.Verifier) string {
+	if addr := c.GetString("wallet_address"); addr != "" {
+		return "user:" + addr
+	}
+	if name, ok := keys.Verify(c.GetHeader(APIKeyHeader)); ok {
+		return "key:" + name
+	}
+	return "ip:" + c.ClientIP()
+}
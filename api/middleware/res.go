@@ -3,14 +3,27 @@ package middleware
 import (
 	"net/http"
 
+	"MetaFarmBackend/component/pagination"
+
 	"github.com/gin-gonic/gin"
 )
 
 // Response 统一响应结构
 type Response struct {
-	Code    int         `json:"code"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data"`
+	Code       int         `json:"code"`
+	Message    string      `json:"message"`
+	Data       interface{} `json:"data"`
+	NextCursor string      `json:"next_cursor,omitempty"` // 下一页游标, 仅分页接口返回
+	HasMore    *bool       `json:"has_more,omitempty"`    // 是否还有更多数据, 仅分页接口返回
+}
+
+// PageResponse 分页接口的响应
+func PageResponse(data interface{}, page *pagination.Result) Response {
+	return Response{
+		Data:       data,
+		NextCursor: page.NextCursor,
+		HasMore:    &page.HasMore,
+	}
 }
 
 // LangResponse 多语言消息映射
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIVersionHeader 响应中标明实际处理请求的接口版本
+const APIVersionHeader = "API-Version"
+
+// APIVersionMiddleware 标记请求所属的接口版本
+func APIVersionMiddleware(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("api_version", version)
+		c.Header(APIVersionHeader, version)
+		c.Next()
+	}
+}
+
+// DeprecatedMiddleware 旧版路径兼容中间件
+// 按RFC 9745/8594返回Deprecation、Sunset头, 并通过Link头指向新版路径(将legacyPrefix替换为successorPrefix)
+func DeprecatedMiddleware(deprecatedAt, sunset time.Time, legacyPrefix, successorPrefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", fmt.Sprintf("@%d", deprecatedAt.Unix()))
+		if !sunset.IsZero() {
+			c.Header("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+		successor := successorPrefix + strings.TrimPrefix(c.Request.URL.Path, legacyPrefix)
+		c.Header("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+		c.Next()
+	}
+}
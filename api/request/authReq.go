@@ -0,0 +1,13 @@
+package request
+
+// LoginMessageRequest 获取登录消息请求
+type LoginMessageRequest struct {
+	WalletAddress string `json:"wallet_address" binding:"required"` // 钱包地址
+}
+
+// LoginRequest 签名登录请求
+type LoginRequest struct {
+	WalletAddress string `json:"wallet_address" binding:"required"` // 钱包地址
+	Signature     string `json:"signature" binding:"required"`      // 对登录消息的签名
+	Nonce         string `json:"nonce" binding:"required"`          // 登录消息中的随机数
+}
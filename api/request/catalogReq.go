@@ -0,0 +1,37 @@
+package request
+
+// ListCatalogRequest 获取作物/动物目录请求
+type ListCatalogRequest struct {
+	Kind *int8 `form:"kind" binding:"omitempty,oneof=0 1"` // 类型(0-作物,1-动物), 不传表示全部
+}
+
+// CropAnimalRequest 创建作物/动物请求
+type CropAnimalRequest struct {
+	Name            string   `json:"name" binding:"required,max=50"`                 // 名称
+	Kind            int8     `json:"kind" binding:"oneof=0 1"`                       // 类型(0-作物,1-动物)
+	GrowthMinutes   int      `json:"growthMinutes" binding:"required,min=1"`         // 生长时长(分钟)
+	FertilityPerSqm int      `json:"fertilityPerSqm" binding:"min=0"`                // 每平方米消耗肥力
+	BaseYield       float64  `json:"baseYield" binding:"min=0"`                      // 每平方米基础产量
+	LandTypes       []int8   `json:"landTypes" binding:"omitempty,dive,oneof=0 1 2"` // 适宜地形(0-平原,1-湿地,2-山地), 为空表示不限
+	ZoneTypes       []int8   `json:"zoneTypes" binding:"omitempty,dive,oneof=0 1 2"` // 适宜分区类型(0-种植区,1-养殖区,2-装饰区), 为空表示不限
+	Seasons         []int8   `json:"seasons" binding:"omitempty,dive,oneof=0 1 2 3"` // 可种植季节(0-春,1-夏,2-秋,3-冬), 为空表示全年
+	UnlockLevel     int8     `json:"unlockLevel" binding:"omitempty,min=1,max=10"`   // 解锁所需土地等级, 默认1
+	WitherMinutes   int      `json:"witherMinutes" binding:"min=0"`                  // 成熟后未收获多久枯萎(分钟), 为0时使用全局配置
+	WitherRefund    float64  `json:"witherRefund" binding:"min=0,max=1"`             // 枯萎时退还种植所耗肥力的比例, 为0时全部损失
+	ProductName     string   `json:"productName" binding:"max=50"`                   // 动物产物名称, 为空时使用动物名称
+	CycleMinutes    int      `json:"cycleMinutes" binding:"min=0"`                   // 动物成年后的产物周期(分钟), 为0时与生长时长相同
+	FeedCropIDs     []uint64 `json:"feedCropIds" binding:"omitempty,max=8"`          // 可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具
+	OffspringArea   int      `json:"offspringArea" binding:"min=0"`                  // 动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖
+	Enabled         *bool    `json:"enabled,omitempty"`                              // 是否上架, 创建时默认true, 更新时不传表示不变
+}
+
+// UpdateCropAnimalRequest 更新作物/动物请求
+type UpdateCropAnimalRequest struct {
+	ID uint64 `json:"id" binding:"required"` // 作物/动物ID
+	CropAnimalRequest
+}
+
+// DeleteCropAnimalRequest 删除作物/动物请求
+type DeleteCropAnimalRequest struct {
+	ID uint64 `json:"id" binding:"required"` // 作物/动物ID
+}
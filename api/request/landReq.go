@@ -9,7 +9,21 @@ type GetLandDetailRequest struct {
 type UpgradeLandRequest struct {
 	LandTokenID string `json:"landTokenId" binding:"required"`   // 土地NFT唯一标识
 	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址(42位)
-	Level       int8   `json:"level" binding:"required,min=1,max=10"` // 升级目标等级(1-10)
+	Level       int8   `json:"level" binding:"required,min=2"` // 升级目标等级(须为当前等级+1)
+}
+
+// SpeedUpUpgradeRequest 加速升级施工请求, 不指定道具时按剩余时间支付MFG立即完成
+type SpeedUpUpgradeRequest struct {
+	UpgradeID   uint64 `json:"upgradeId" binding:"required"`           // 升级记录ID
+	UserAddress string `json:"userAddress" binding:"required,max=42"`  // 用户钱包地址
+	ItemTokenID *int64 `json:"itemTokenId,omitempty"`                  // 加速道具TokenID
+	Uses        int    `json:"uses" binding:"omitempty,min=1,max=100"` // 道具使用次数, 默认1
+}
+
+// CancelUpgradeRequest 取消升级施工请求
+type CancelUpgradeRequest struct {
+	UpgradeID   uint64 `json:"upgradeId" binding:"required"`          // 升级记录ID
+	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
 }
 
 // CreateRentRequest 创建租赁请求
@@ -30,12 +44,13 @@ type BuyLandRequest struct {
 
 // LayoutZoneReq 布局区域请求
 type LayoutZoneReq struct {
-	Area      int  `json:"area" binding:"required,min=1"`          // 区域面积
-	ZoneType  int8 `json:"zoneType" binding:"required,oneof=0 1 2"` // 区域类型(0-普通,1-特殊,2-稀有)
-	PositionX int  `json:"positionX" binding:"required,min=0"`      // X坐标位置
-	PositionY int  `json:"positionY" binding:"required,min=0"`      // Y坐标位置
-	Width     int  `json:"width" binding:"required,min=1"`         // 区域宽度
-	Height    int  `json:"height" binding:"required,min=1"`        // 区域高度
+	ID        uint64 `json:"id"`                                  // 区域ID, 修改已有分区时传入, 不传表示新建
+	Area      int    `json:"area" binding:"required,min=1"`       // 区域面积, 须等于宽×高
+	ZoneType  int8   `json:"zoneType" binding:"oneof=0 1 2"`      // 区域类型(0-种植区,1-养殖区,2-装饰区)
+	PositionX int    `json:"positionX" binding:"min=0"`           // X坐标位置
+	PositionY int    `json:"positionY" binding:"min=0"`           // Y坐标位置
+	Width     int    `json:"width" binding:"required,min=1"`      // 区域宽度
+	Height    int    `json:"height" binding:"required,min=1"`     // 区域高度
 }
 
 // PlantCropRequest 种植作物请求
@@ -47,15 +62,102 @@ type PlantCropRequest struct {
 	Area         int    `json:"area" binding:"required,min=1"`     // 种植面积
 }
 
+// FertilizeLandRequest 施肥请求
+type FertilizeLandRequest struct {
+	LandTokenID string `json:"landTokenId" binding:"required"`          // 土地NFT唯一标识
+	UserAddress string `json:"userAddress" binding:"required,max=42"`  // 用户钱包地址
+	ItemTokenID int64  `json:"itemTokenId" binding:"required"`          // 肥料道具TokenID
+	Uses        int    `json:"uses" binding:"omitempty,min=1,max=100"` // 使用次数, 默认1
+}
+
 // HarvestCropRequest 收获作物请求
 type HarvestCropRequest struct {
 	ActivityID  uint64 `json:"activityId" binding:"required"`      // 活动ID
 	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
 }
 
+// CarePlotRequest 照料地块请求, 浇水、施肥、除虫各消耗一次对应道具
+type CarePlotRequest struct {
+	ActivityID  uint64 `json:"activityId" binding:"required"`      // 种植活动ID
+	ItemTokenID int64  `json:"itemTokenId" binding:"required"`     // 水壶、肥料或杀虫剂道具TokenID
+	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
+	Action      string `json:"-" binding:"oneof=water fertilize pesticide"` // 照料方式, 由路由决定
+}
+
+// FeedAnimalRequest 喂养动物请求, 使用饲料道具或目录允许的作物收获物, 二者选一
+type FeedAnimalRequest struct {
+	ActivityID  uint64 `json:"activityId" binding:"required"`                     // 养殖活动ID
+	ItemTokenID int64  `json:"itemTokenId" binding:"required_without=FeedCropID"` // 饲料道具TokenID, 每次消耗一次
+	FeedCropID  uint64 `json:"feedCropId" binding:"excluded_with=ItemTokenID"`    // 用作饲料的作物ID, 从收获物库存中扣减
+	Quantity    int    `json:"quantity" binding:"omitempty,min=1,max=100"`        // 作物饲料数量, 默认1
+	UserAddress string `json:"userAddress" binding:"required,max=42"`             // 用户钱包地址
+}
+
+// AnimalActionRequest 收集产物、繁殖等养殖操作请求
+type AnimalActionRequest struct {
+	ActivityID  uint64 `json:"activityId" binding:"required"`      // 养殖活动ID
+	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
+}
+
+// ClearDeadCropsRequest 清理枯萎作物请求
+type ClearDeadCropsRequest struct {
+	LandTokenID string `json:"landTokenId" binding:"required"`      // 土地NFT唯一标识
+	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
+}
+
+// BatchHarvestRequest 一键收获请求, 收获用户所有土地上已成熟的作物
+type BatchHarvestRequest struct {
+	Replant     bool   `json:"replant"`                               // 收获后是否在原分区重新种植同一作物
+	UserAddress string `json:"userAddress" binding:"required,max=42"` // 用户钱包地址
+}
+
+// BatchReplantRequest 批量补种请求, 在已收获活动的原分区重新种植同一作物
+type BatchReplantRequest struct {
+	ActivityIDs []uint64 `json:"activityIds" binding:"required,min=1,max=100,dive,required"` // 已收获的种植活动ID
+	UserAddress string   `json:"userAddress" binding:"required,max=42"`                      // 用户钱包地址
+}
+
+// ListUserLandsRequest 获取用户土地列表请求
+type ListUserLandsRequest struct {
+	PageRequest
+	LandType *int8 `form:"landType" binding:"omitempty,oneof=0 1 2"`   // 地形类型(0-平原,1-湿地,2-山地)
+	Rarity   *int8 `form:"rarity" binding:"omitempty,oneof=0 1 2 3"`   // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	MinLevel *int8 `form:"minLevel" binding:"omitempty,min=1,max=10"` // 最低等级
+	MaxLevel *int8 `form:"maxLevel" binding:"omitempty,min=1,max=10"` // 最高等级
+}
+
 // ListRentLandsRequest 获取租赁土地列表请求
 type ListRentLandsRequest struct {
-	RenterAddress string `json:"renterAddress" binding:"required,max=42"` // 租户钱包地址
+	PageRequest
+	LandTokenID    *string `form:"landTokenId"`                                // 土地NFT唯一标识
+	RentalDuration *int    `form:"rentalDuration" binding:"omitempty,oneof=7 14 30"` // 租赁时长(天)
+}
+
+// ListMarketLandsRequest 获取土地挂牌列表请求
+type ListMarketLandsRequest struct {
+	PageRequest
+	MinPrice *float64 `form:"minPrice" binding:"omitempty,min=0"` // 最低售价
+	MaxPrice *float64 `form:"maxPrice" binding:"omitempty,min=0"` // 最高售价
+	MinArea  *int     `form:"minArea" binding:"omitempty,min=1"`  // 最小面积
+	MaxArea  *int     `form:"maxArea" binding:"omitempty,min=1"`  // 最大面积
+}
+
+// SearchLandsRequest 搜索土地请求, 排序字段可选 id/level/rarity/fertility/area/createTime/price; 肥力按当前肥力筛选和排序
+type SearchLandsRequest struct {
+	PageRequest
+	LandType      *int8    `form:"landType" binding:"omitempty,oneof=0 1 2"`  // 地形类型(0-平原,1-湿地,2-山地)
+	Rarity        *int8    `form:"rarity" binding:"omitempty,oneof=0 1 2 3"`  // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	MinLevel      *int8    `form:"minLevel" binding:"omitempty,min=1,max=10"` // 最低等级
+	MaxLevel      *int8    `form:"maxLevel" binding:"omitempty,min=1,max=10"` // 最高等级
+	MinFertility  *int     `form:"minFertility" binding:"omitempty,min=0"`    // 最低肥力, 按当前肥力比较
+	MaxFertility  *int     `form:"maxFertility" binding:"omitempty,min=0"`    // 最高肥力, 按当前肥力比较
+	MinArea       *int     `form:"minArea" binding:"omitempty,min=1"`         // 最小面积
+	MaxArea       *int     `form:"maxArea" binding:"omitempty,min=1"`         // 最大面积
+	SpecialEffect *string  `form:"specialEffect" binding:"omitempty,max=100"` // 特殊效果(如"湿润土地"、"黄金土地")
+	ForSale       *bool    `form:"forSale"`                                   // 是否待出售
+	ForRent       *bool    `form:"forRent"`                                   // 是否租赁中
+	MinPrice      *float64 `form:"minPrice" binding:"omitempty,min=0"`        // 最低售价, 只匹配待出售的土地
+	MaxPrice      *float64 `form:"maxPrice" binding:"omitempty,min=0"`        // 最高售价, 只匹配待出售的土地
 }
 
 // CreateMarketListingRequest 创建土地挂牌请求
@@ -65,16 +167,11 @@ type CreateMarketListingRequest struct {
 	Price         float64 `json:"price" binding:"required,min=0"`    // 售价(非负)
 }
 
-// UpdateLandLayoutRequest 更新土地布局请求
+// UpdateLandLayoutRequest 更新土地布局请求, zones为完整的分区列表, 未列出的已有分区将被删除
 type UpdateLandLayoutRequest struct {
-	TokenID     string `json:"tokenId" binding:"required"`       // 土地NFT ID
-	UserAddress string `json:"userAddress" binding:"required"`   // 用户地址
-	Area        int    `json:"area" binding:"required,min=1"`    // 种植面积
-	ZoneType    int8   `json:"zoneType" binding:"required"`      // 区域类型
-	PosX        int    `json:"posX" binding:"required,min=0"`    // 布局X坐标
-	PosY        int    `json:"posY" binding:"required,min=0"`    // 布局Y坐标
-	Width       int    `json:"width" binding:"required,min=1"`   // 布局宽度
-	Height      int    `json:"height" binding:"required,min=1"`  // 布局高度
+	TokenID     string          `json:"tokenId" binding:"required"`              // 土地NFT ID
+	UserAddress string          `json:"userAddress" binding:"required"`          // 用户地址
+	Zones       []LayoutZoneReq `json:"zones" binding:"omitempty,max=64,dive"`  // 分区列表, 为空表示清空布局
 }
 
 // CancelRentalRequest 取消租赁请求
@@ -0,0 +1,9 @@
+package request
+
+// PageRequest 游标分页参数
+type PageRequest struct {
+	Cursor string `form:"cursor"`                                   // 上一页返回的next_cursor, 首页为空
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`  // 每页条数, 默认20
+	Sort   string `form:"sort"`                                     // 排序字段, 取值见各接口说明
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"` // 排序方向
+}
@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+// LoginMessageResponse 登录消息响应
+type LoginMessageResponse struct {
+	Message string `json:"message"` // 待签名的登录消息
+	Nonce   string `json:"nonce"`   // 随机数
+}
+
+// LoginResponse 登录响应
+type LoginResponse struct {
+	UserID        uint64    `json:"user_id"`        // 用户ID
+	WalletAddress string    `json:"wallet_address"` // 钱包地址
+	SessionToken  string    `json:"session_token"`  // 会话令牌
+	ExpiresAt     time.Time `json:"expires_at"`     // 过期时间
+}
+
+// MessageResponse 操作结果消息响应
+type MessageResponse struct {
+	Message string `json:"message"` // 结果描述
+}
@@ -0,0 +1,6 @@
+package response
+
+// ErrorResponse 错误响应
+type ErrorResponse struct {
+	Error string `json:"error"` // 错误信息
+}
@@ -5,18 +5,56 @@ import (
 	"time"
 )
 
-// LandDetailResponse 土地详情响应
+// LandDetailResponse 土地详情响应, 聚合土地属性、分区、生长中的活动、租赁、挂牌及最近一次升级
 type LandDetailResponse struct {
-	LandTokenID     string     `json:"landTokenId"`     // 土地NFT唯一标识
-	OwnerAddress    string     `json:"ownerAddress"`    // 所有者钱包地址
-	LandType        int8       `json:"landType"`        // 土地类型(0-普通,1-特殊,2-稀有)
-	Rarity          int8       `json:"rarity"`          // 稀有度(1-5星)
-	Area            int        `json:"area"`            // 土地面积(平方米)
-	Level           int8       `json:"level"`           // 土地等级(1-10)
-	Fertility       int        `json:"fertility"`       // 土地肥力(0-100)
-	SpecialEffect   string     `json:"specialEffect"`   // 特殊效果描述
-	LastHarvestTime *time.Time `json:"lastHarvestTime"` // 最后收获时间
-	MetadataURI     string     `json:"metadataUri"`     // 元数据URI
+	LandTokenID         string                `json:"landTokenId"`                                 // 土地NFT唯一标识
+	OwnerAddress        string                `json:"ownerAddress"`                                // 所有者钱包地址
+	LandType            int8                  `json:"landType"`                                    // 地形类型(0-平原,1-湿地,2-山地)
+	Rarity              int8                  `json:"rarity"`                                      // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	Area                int                   `json:"area"`                                        // 土地面积(㎡)
+	Level               int8                  `json:"level"`                                       // 土地等级(1-10)
+	Fertility           int                   `json:"fertility"`                                   // 当前肥力, 含上次结算后按时间恢复的部分
+	FertilityCap        int                   `json:"fertilityCap"`                                // 等级决定的肥力上限
+	UnlockedZones       string                `json:"unlockedZones"`                               // 已解锁的分区类型(逗号分隔)
+	UpgradeCompleteTime *time.Time            `json:"upgradeCompleteTime" extensions:"x-nullable"` // 升级施工完成时间, 为空表示未在施工
+	SpecialEffect       string                `json:"specialEffect"`                               // 特殊效果描述
+	LastHarvestTime     *time.Time            `json:"lastHarvestTime" extensions:"x-nullable"`     // 最后收获时间
+	MetadataURI         string                `json:"metadataUri"`                                 // 元数据URI
+	Zones               []LayoutZoneRes       `json:"zones"`                                       // 分区
+	Activities          []GrowingActivityRes  `json:"activities"`                                  // 生长中的种植/养殖活动
+	Rental              *RentLandResponse     `json:"rental" extensions:"x-nullable"`              // 生效的租赁, 没有时为空
+	Listing             *LandListingRes       `json:"listing" extensions:"x-nullable"`             // 待出售的挂牌, 没有时为空
+	LastUpgrade         *LandUpgradeRecordRes `json:"lastUpgrade" extensions:"x-nullable"`         // 最近一次完成的升级, 没有时为空
+}
+
+// GrowingActivityRes 生长中的种植/养殖活动
+type GrowingActivityRes struct {
+	ActivityID       uint64    `json:"activityId"`       // 活动ID
+	ActivityType     int8      `json:"activityType"`     // 活动类型(0-种植,1-养殖)
+	CropAnimalID     uint64    `json:"cropAnimalId"`     // 作物/动物ID
+	CropAnimalName   string    `json:"cropAnimalName"`   // 作物/动物名称
+	ZoneID           uint64    `json:"zoneId"`           // 所在分区ID
+	Area             int       `json:"area"`             // 占用面积(㎡)
+	StartTime        time.Time `json:"startTime"`        // 开始时间
+	ExpectedEndTime  time.Time `json:"expectedEndTime"`  // 预计成熟时间
+	RemainingSeconds int64     `json:"remainingSeconds"` // 距成熟的剩余秒数, 已成熟为0
+}
+
+// LandListingRes 土地挂牌信息
+type LandListingRes struct {
+	MarketID      uint64    `json:"marketId"`      // 挂牌ID
+	SellerAddress string    `json:"sellerAddress"` // 卖家钱包地址
+	Price         float64   `json:"price"`         // 售价
+	ListingTime   time.Time `json:"listingTime"`   // 挂牌时间
+}
+
+// LandUpgradeRecordRes 土地升级记录
+type LandUpgradeRecordRes struct {
+	UpgradeID  uint64     `json:"upgradeId"`                          // 升级记录ID
+	OldLevel   int8       `json:"oldLevel"`                           // 升级前等级
+	NewLevel   int8       `json:"newLevel"`                           // 升级后等级
+	CostTokens uint64     `json:"costTokens"`                         // 消耗MFG数量
+	FinishTime *time.Time `json:"finishTime" extensions:"x-nullable"` // 完成时间
 }
 
 // UpgradeLandResponse 土地升级响应
@@ -53,19 +91,25 @@ type LandLayoutResponse struct {
 type LayoutZoneRes struct {
 	ID               uint64  `json:"id"`                // 区域ID
 	Area             int     `json:"area"`              // 区域面积
-	ZoneType         int8    `json:"zoneType"`          // 区域类型(0-普通,1-特殊,2-稀有)
+	ZoneType         int8    `json:"zoneType"`          // 区域类型(0-种植区,1-养殖区,2-装饰区)
 	PositionX        int     `json:"positionX"`         // X坐标位置
 	PositionY        int     `json:"positionY"`         // Y坐标位置
 	Width            int     `json:"width"`             // 区域宽度
 	Height           int     `json:"height"`            // 区域高度
 	HasAdjacentBonus bool    `json:"hasAdjacentBonus"`  // 是否有相邻奖励
-	BonusType        int8    `json:"bonusType"`         // 奖励类型
-	BonusValue       float64 `json:"bonusValue"`        // 奖励值
+	BonusType        int8    `json:"bonusType"`         // 奖励类型(-1-无加成,0-肥力恢复,1-产量提升)
+	BonusValue       float64 `json:"bonusValue"`        // 奖励值(百分比)
 }
 
 // PlantCropResponse 种植作物响应
 type PlantCropResponse struct {
 	ActivityID      uint64    `json:"activityId"`      // 活动ID
+	ActivityType    int8      `json:"activityType"`    // 活动类型(0-种植,1-养殖)
+	CropAnimalID    uint64    `json:"cropAnimalId"`    // 作物/动物ID
+	CropAnimalName  string    `json:"cropAnimalName"`  // 作物/动物名称
+	Area            int       `json:"area"`            // 占用面积(㎡)
+	FertilityCost   int       `json:"fertilityCost"`   // 消耗肥力值
+	StartTime       time.Time `json:"startTime"`       // 开始时间
 	ExpectedEndTime time.Time `json:"expectedEndTime"` // 预计完成时间
 }
 
@@ -77,24 +121,200 @@ type HarvestCropResponse struct {
 	Experience int    `json:"experience,omitempty"` // 获得经验值(可选)
 }
 
+// CollectProductsResponse 收集动物产物响应
+type CollectProductsResponse struct {
+	ProductName     string    `json:"productName"`     // 产物名称
+	Yield           int       `json:"yield"`           // 产量
+	Experience      int64     `json:"experience"`      // 获得的经验值
+	Health          int       `json:"health"`          // 动物当前健康值
+	NextCollectTime time.Time `json:"nextCollectTime"` // 下次可收集产物的时间
+}
+
+// BreedAnimalResponse 繁殖动物响应
+type BreedAnimalResponse struct {
+	Parent    *dao.BreedingAnimal `json:"parent"`    // 繁殖后的亲代
+	Offspring *dao.BreedingAnimal `json:"offspring"` // 新出生的幼崽
+}
+
+// BatchFarmItem 批量收获/补种中单个活动的结果
+type BatchFarmItem struct {
+	ActivityID    uint64 `json:"activityId"`              // 原活动ID
+	LandTokenID   string `json:"landTokenId"`             // 土地NFT TokenID
+	CropName      string `json:"cropName"`                // 作物名称
+	Harvested     bool   `json:"harvested"`               // 是否已收获
+	Yield         int    `json:"yield"`                   // 收获产量
+	Experience    int64  `json:"experience"`              // 收获获得的经验值
+	Replanted     bool   `json:"replanted"`               // 是否已补种
+	NewActivityID uint64 `json:"newActivityId,omitempty"` // 补种创建的活动ID
+	Error         string `json:"error,omitempty"`         // 失败原因, 为空表示成功
+}
+
+// BatchFarmResponse 批量收获/补种响应
+type BatchFarmResponse struct {
+	Succeeded int             `json:"succeeded"` // 成功的项数
+	Failed    int             `json:"failed"`    // 失败的项数
+	HasMore   bool            `json:"hasMore"`   // 是否还有未处理的成熟作物, 为true时可再次调用
+	Items     []BatchFarmItem `json:"items"`     // 各项结果
+}
+
 // RentLandsListResponse 租赁土地列表响应
 type RentLandsListResponse struct {
 	Total   int                `json:"total"`   // 总记录数
 	Rentals []RentLandResponse `json:"rentals"` // 租赁列表
 }
 
-// ToLandDetailResponse 将dao.LandInfo转换为API响应结构体
-func ToLandDetailResponse(land *dao.LandInfo) *LandDetailResponse {
-	return &LandDetailResponse{
-		LandTokenID:     land.LandTokenID,
-		OwnerAddress:    land.OwnerAddress,
-		LandType:        land.LandType,
-		Rarity:          land.Rarity,
-		Area:            land.Area,
-		Level:           land.Level,
-		Fertility:       land.Fertility,
-		SpecialEffect:   land.SpecialEffect,
-		LastHarvestTime: land.LastHarvestTime,
-		MetadataURI:     land.MetadataURI,
+// SearchLandsResponse 土地搜索响应
+type SearchLandsResponse struct {
+	Lands  []LandSearchItem `json:"lands"`                                    // 土地列表
+	Facets *LandFacetsRes   `json:"facets,omitempty" extensions:"x-nullable"` // 按稀有度、地形类型的分面统计, 只在首页返回
+}
+
+// LandSearchItem 土地搜索结果项
+type LandSearchItem struct {
+	LandTokenID   string   `json:"landTokenId"`                      // 土地NFT唯一标识
+	OwnerAddress  string   `json:"ownerAddress"`                     // 所有者钱包地址
+	LandType      int8     `json:"landType"`                         // 地形类型(0-平原,1-湿地,2-山地)
+	Rarity        int8     `json:"rarity"`                           // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	Area          int      `json:"area"`                             // 土地面积(㎡)
+	Level         int8     `json:"level"`                            // 土地等级(1-10)
+	Fertility     int      `json:"fertility"`                        // 当前肥力, 含上次结算后按时间恢复的部分
+	FertilityCap  int      `json:"fertilityCap"`                     // 等级决定的肥力上限
+	SpecialEffect string   `json:"specialEffect"`                    // 特殊效果描述
+	MetadataURI   string   `json:"metadataUri"`                      // 元数据URI
+	ForSale       bool     `json:"forSale"`                          // 是否待出售
+	MarketID      *uint64  `json:"marketId" extensions:"x-nullable"` // 待出售挂牌ID, 未挂牌时为空
+	Price         *float64 `json:"price" extensions:"x-nullable"`    // 挂牌售价, 未挂牌时为空
+	Rented        bool     `json:"rented"`                           // 是否租赁中
+}
+
+// LandFacetsRes 土地搜索的分面统计, 每一项统计时忽略该项自身的筛选条件
+type LandFacetsRes struct {
+	Rarity   []FacetCountRes `json:"rarity"`   // 按稀有度统计
+	LandType []FacetCountRes `json:"landType"` // 按地形类型统计
+}
+
+// FacetCountRes 分面统计项
+type FacetCountRes struct {
+	Value int8  `json:"value"` // 取值
+	Count int64 `json:"count"` // 符合条件的土地数量
+}
+
+// ToLandDetailResponse 将土地详情快照转换为API响应结构体, 剩余生长时间按now计算
+func ToLandDetailResponse(detail *dao.LandDetail, now time.Time) *LandDetailResponse {
+	land := detail.Land
+	resp := &LandDetailResponse{
+		LandTokenID:         land.LandTokenID,
+		OwnerAddress:        land.OwnerAddress,
+		LandType:            land.LandType,
+		Rarity:              land.Rarity,
+		Area:                land.Area,
+		Level:               land.Level,
+		Fertility:           land.Fertility,
+		FertilityCap:        land.FertilityCap,
+		UnlockedZones:       land.UnlockedZones,
+		UpgradeCompleteTime: land.UpgradeCompleteTime,
+		SpecialEffect:       land.SpecialEffect,
+		LastHarvestTime:     land.LastHarvestTime,
+		MetadataURI:         land.MetadataURI,
+		Zones:               ToLandLayoutResponse(land.LandTokenID, detail.Layouts).Layouts,
+		Activities:          make([]GrowingActivityRes, 0, len(detail.Activities)),
+	}
+	for _, activity := range detail.Activities {
+		resp.Activities = append(resp.Activities, GrowingActivityRes{
+			ActivityID:       activity.ID,
+			ActivityType:     activity.ActivityType,
+			CropAnimalID:     activity.CropAnimalID,
+			CropAnimalName:   activity.CropAnimalName,
+			ZoneID:           activity.ZoneID,
+			Area:             activity.Area,
+			StartTime:        activity.Start_time,
+			ExpectedEndTime:  activity.ExpectedEndTime,
+			RemainingSeconds: max(int64(activity.ExpectedEndTime.Sub(now).Seconds()), 0),
+		})
+	}
+	if rental := detail.Rental; rental != nil {
+		resp.Rental = &RentLandResponse{
+			RentalID:        rental.ID,
+			LandTokenID:     rental.LandTokenID,
+			TotalRent:       rental.TotalRent,
+			RentalStartTime: rental.RentalStartTime,
+			RentalEndTime:   rental.RentalEndTime,
+		}
+	}
+	if listing := detail.Listing; listing != nil {
+		resp.Listing = &LandListingRes{
+			MarketID:      listing.ID,
+			SellerAddress: listing.SellerAddress,
+			Price:         listing.Price,
+			ListingTime:   listing.ListingTime,
+		}
+	}
+	if upgrade := detail.LastUpgrade; upgrade != nil {
+		resp.LastUpgrade = &LandUpgradeRecordRes{
+			UpgradeID:  upgrade.ID,
+			OldLevel:   upgrade.OldLevel,
+			NewLevel:   upgrade.NewLevel,
+			CostTokens: upgrade.CostTokens,
+			FinishTime: upgrade.FinishTime,
+		}
+	}
+	return resp
+}
+
+// ToLandLayoutResponse 将土地的分区列表转换为API响应结构体
+func ToLandLayoutResponse(tokenID string, layouts []*dao.LandLayout) *LandLayoutResponse {
+	zones := make([]LayoutZoneRes, 0, len(layouts))
+	for _, layout := range layouts {
+		zones = append(zones, LayoutZoneRes{
+			ID:               layout.ID,
+			Area:             layout.Area,
+			ZoneType:         layout.ZoneType,
+			PositionX:        layout.PositionX,
+			PositionY:        layout.PositionY,
+			Width:            layout.Width,
+			Height:           layout.Height,
+			HasAdjacentBonus: layout.HasAdjacentBonus,
+			BonusType:        layout.BonusType,
+			BonusValue:       layout.BonusValue,
+		})
+	}
+	return &LandLayoutResponse{LandTokenID: tokenID, Layouts: zones}
+}
+
+// ToSearchLandsResponse 将土地搜索结果转换为API响应结构体, facets为空时不返回分面统计
+func ToSearchLandsResponse(results []*dao.LandSearchResult, facets *dao.LandFacets) *SearchLandsResponse {
+	resp := &SearchLandsResponse{Lands: make([]LandSearchItem, 0, len(results))}
+	for _, r := range results {
+		resp.Lands = append(resp.Lands, LandSearchItem{
+			LandTokenID:   r.LandTokenID,
+			OwnerAddress:  r.OwnerAddress,
+			LandType:      r.LandType,
+			Rarity:        r.Rarity,
+			Area:          r.Area,
+			Level:         r.Level,
+			Fertility:     r.Fertility,
+			FertilityCap:  r.FertilityCap,
+			SpecialEffect: r.SpecialEffect,
+			MetadataURI:   r.MetadataURI,
+			ForSale:       r.ListingID != nil,
+			MarketID:      r.ListingID,
+			Price:         r.ListingPrice,
+			Rented:        r.Rented,
+		})
+	}
+	if facets != nil {
+		resp.Facets = &LandFacetsRes{
+			Rarity:   toFacetCountRes(facets.Rarity),
+			LandType: toFacetCountRes(facets.LandType),
+		}
+	}
+	return resp
+}
+
+func toFacetCountRes(counts []dao.FacetCount) []FacetCountRes {
+	out := make([]FacetCountRes, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, FacetCountRes{Value: c.Value, Count: c.Count})
 	}
+	return out
 }
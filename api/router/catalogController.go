@@ -0,0 +1,188 @@
+package router
+
+import (
+	"net/http"
+
+	"MetaFarmBackend/api/middleware"
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// CatalogController 作物/动物目录控制器
+type CatalogController struct {
+	catalogService service.CatalogService
+	admin          gin.HandlerFunc
+}
+
+// 构造函数
+func NewCatalogController(catalogService service.CatalogService, admin gin.HandlerFunc) *CatalogController {
+	return &CatalogController{
+		catalogService: catalogService,
+		admin:          admin,
+	}
+}
+
+// RegisterRoutes 注册作物/动物目录路由, 管理接口需要admin API Key
+func (c *CatalogController) RegisterRoutes(router *gin.RouterGroup) {
+	catalogRouter := router.Group("/catalog")
+	{
+		catalogRouter.GET("/list", c.ListCatalog)
+	}
+	adminRouter := catalogRouter.Group("/admin", c.admin)
+	{
+		adminRouter.GET("/list", c.AdminListCatalog)
+		adminRouter.POST("/create", c.CreateCropAnimal)
+		adminRouter.POST("/update", c.UpdateCropAnimal)
+		adminRouter.POST("/delete", c.DeleteCropAnimal)
+	}
+}
+
+// ListCatalog 获取作物/动物目录
+// @Summary 获取作物/动物目录
+// @Description 获取上架的作物/动物及其种植规则: 生长时长、每平方米肥力消耗、适宜地形与分区、可种植季节及解锁等级
+// @Tags catalog
+// @Accept json
+// @Produce json
+// @Param query query request.ListCatalogRequest false "筛选参数"
+// @Success 200 {object} middleware.Response{data=[]dao.CropAnimal}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/catalog/list [get]
+func (c *CatalogController) ListCatalog(ctx *gin.Context) {
+	c.listCatalog(ctx, false)
+}
+
+// AdminListCatalog 管理接口: 获取全部作物/动物
+// @Summary 获取全部作物/动物(管理)
+// @Description 获取包括未上架条目在内的全部作物/动物
+// @Tags catalog
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "管理API Key"
+// @Param query query request.ListCatalogRequest false "筛选参数"
+// @Success 200 {object} middleware.Response{data=[]dao.CropAnimal}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/catalog/admin/list [get]
+func (c *CatalogController) AdminListCatalog(ctx *gin.Context) {
+	c.listCatalog(ctx, true)
+}
+
+func (c *CatalogController) listCatalog(ctx *gin.Context, includeDisabled bool) {
+	var req request.ListCatalogRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	items, err := c.catalogService.ListCatalog(ctx, req.Kind, includeDisabled)
+	if err != nil {
+		logger.Error("获取作物/动物目录失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.Response{Data: items})
+}
+
+// CreateCropAnimal 管理接口: 创建作物/动物
+// @Summary 创建作物/动物(管理)
+// @Description 新增作物/动物目录条目, 名称不能重复
+// @Tags catalog
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "管理API Key"
+// @Param body body request.CropAnimalRequest true "作物/动物"
+// @Success 200 {object} middleware.Response{data=dao.CropAnimal}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/catalog/admin/create [post]
+func (c *CatalogController) CreateCropAnimal(ctx *gin.Context) {
+	var req request.CropAnimalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	item, err := c.catalogService.CreateCropAnimal(ctx, req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.Response{Data: item})
+}
+
+// UpdateCropAnimal 管理接口: 更新作物/动物
+// @Summary 更新作物/动物(管理)
+// @Description 更新作物/动物目录条目, 只影响之后的种植, 已开始的活动不变
+// @Tags catalog
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "管理API Key"
+// @Param body body request.UpdateCropAnimalRequest true "作物/动物"
+// @Success 200 {object} middleware.Response{data=dao.CropAnimal}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/catalog/admin/update [post]
+func (c *CatalogController) UpdateCropAnimal(ctx *gin.Context) {
+	var req request.UpdateCropAnimalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	item, err := c.catalogService.UpdateCropAnimal(ctx, req)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "作物/动物不存在"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.Response{Data: item})
+}
+
+// DeleteCropAnimal 管理接口: 删除作物/动物
+// @Summary 删除作物/动物(管理)
+// @Description 删除作物/动物目录条目, 已有的种植记录保留名称; 临时下架请使用更新接口将enabled置为false
+// @Tags catalog
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "管理API Key"
+// @Param body body request.DeleteCropAnimalRequest true "删除请求"
+// @Success 200 {object} middleware.Response{data=string}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/catalog/admin/delete [post]
+func (c *CatalogController) DeleteCropAnimal(ctx *gin.Context) {
+	var req request.DeleteCropAnimalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	err := c.catalogService.DeleteCropAnimal(ctx, req.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "作物/动物不存在"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.Response{Data: "作物/动物已删除"})
+}
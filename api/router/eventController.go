@@ -0,0 +1,244 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	lastEventIDHeader = "Last-Event-ID"
+	wsWriteTimeout    = 10 * time.Second
+)
+
+// EventController 实时事件推送控制器, 提供WebSocket及SSE两种方式
+type EventController struct {
+	bus       *events.Bus
+	heartbeat time.Duration
+	upgrader  websocket.Upgrader
+}
+
+// 构造函数
+func NewEventController(bus *events.Bus, heartbeat time.Duration) *EventController {
+	if heartbeat <= 0 {
+		heartbeat = 25 * time.Second
+	}
+	return &EventController{
+		bus:       bus,
+		heartbeat: heartbeat,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			// 与CORS策略保持一致, 允许游戏客户端跨域连接, 身份由会话令牌校验
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// RegisterRoutes 注册事件推送路由, auth为会话认证中间件
+func (c *EventController) RegisterRoutes(router gin.IRouter, auth gin.HandlerFunc) {
+	eventRouter := router.Group("/events", auth)
+	{
+		eventRouter.GET("/ws", c.ServeWebSocket)
+		eventRouter.GET("/stream", c.ServeSSE)
+	}
+}
+
+// ServeWebSocket 通过WebSocket推送事件
+// @Summary 订阅实时事件(WebSocket)
+// @Description 升级为WebSocket后推送当前用户的作物成熟/枯萎、土地售出、租赁及余额变动事件, 每条文本消息为一个JSON事件; 传入lastEventId可补发断线期间的事件
+// @Tags events
+// @Param Authorization header string false "会话令牌, 也可使用session_token Cookie"
+// @Param lastEventId query int false "最后收到的事件ID"
+// @Success 101 {object} events.Event
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/v1/events/ws [get]
+func (c *EventController) ServeWebSocket(ctx *gin.Context) {
+	userAddr := ctx.GetString("wallet_address")
+	conn, err := c.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// Upgrade失败时已写入错误响应
+		logger.Errorf("WebSocket升级失败: %v, user: %s", err, userAddr)
+		return
+	}
+	defer conn.Close()
+
+	sub, backlog, err := c.subscribe(ctx, userAddr)
+	if err != nil {
+		logger.Errorf("订阅事件失败: %v, user: %s", err, userAddr)
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "订阅事件失败"), time.Now().Add(wsWriteTimeout))
+		return
+	}
+	defer sub.Close()
+
+	// 读协程只处理心跳与关闭帧, 客户端断开时通知写循环退出
+	readDone := make(chan struct{})
+	conn.SetReadLimit(512)
+	conn.SetReadDeadline(time.Now().Add(2 * c.heartbeat))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * c.heartbeat))
+	})
+	go func() {
+		defer close(readDone)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	var lastID uint64
+	send := func(e *events.Event) bool {
+		if e.ID <= lastID && e.Type != events.TypeReset {
+			return true
+		}
+		conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		if err := conn.WriteJSON(e); err != nil {
+			return false
+		}
+		lastID = e.ID
+		return true
+	}
+	for _, e := range backlog {
+		if !send(e) {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-readDone:
+			return
+		case <-c.bus.Closing():
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "服务重启"), time.Now().Add(wsWriteTimeout))
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return
+			}
+		case e, ok := <-sub.C():
+			if !ok {
+				// 消费过慢被断开, 客户端按lastEventId重连补发
+				conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "消费过慢"), time.Now().Add(wsWriteTimeout))
+				return
+			}
+			if !send(e) {
+				return
+			}
+		}
+	}
+}
+
+// ServeSSE 通过Server-Sent Events推送事件, 作为不支持WebSocket时的降级方案
+// @Summary 订阅实时事件(SSE)
+// @Description 以text/event-stream推送当前用户的事件, 事件id即事件ID, event为事件类型; 浏览器重连时会自动携带Last-Event-ID补发断线期间的事件
+// @Tags events
+// @Produce text/event-stream
+// @Param Authorization header string false "会话令牌, 也可使用session_token Cookie"
+// @Param Last-Event-ID header int false "最后收到的事件ID"
+// @Param lastEventId query int false "最后收到的事件ID, 优先使用Last-Event-ID请求头"
+// @Success 200 {object} events.Event
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/v1/events/stream [get]
+func (c *EventController) ServeSSE(ctx *gin.Context) {
+	userAddr := ctx.GetString("wallet_address")
+	sub, backlog, err := c.subscribe(ctx, userAddr)
+	if err != nil {
+		logger.Errorf("订阅事件失败: %v, user: %s", err, userAddr)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer sub.Close()
+
+	// 长连接不受服务端写超时限制
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warnf("取消SSE写超时失败: %v", err)
+	}
+
+	header := ctx.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	fmt.Fprintf(ctx.Writer, "retry: %d\n\n", 3000)
+	ctx.Writer.Flush()
+
+	var lastID uint64
+	send := func(e *events.Event) bool {
+		if e.ID <= lastID && e.Type != events.TypeReset {
+			return true
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			logger.Errorf("序列化事件失败: %v", err)
+			return true
+		}
+		if _, err := fmt.Fprintf(ctx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data); err != nil {
+			return false
+		}
+		ctx.Writer.Flush()
+		lastID = e.ID
+		return true
+	}
+	for _, e := range backlog {
+		if !send(e) {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case <-c.bus.Closing():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(ctx.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		case e, ok := <-sub.C():
+			if !ok || !send(e) {
+				return
+			}
+		}
+	}
+}
+
+// subscribe 先注册实时订阅再读取断线期间的事件, 保证两者之间不丢事件, 重复事件由调用方按ID过滤
+func (c *EventController) subscribe(ctx *gin.Context, userAddr string) (*events.Subscription, []*events.Event, error) {
+	sub := c.bus.Subscribe(userAddr)
+	lastID := lastEventID(ctx)
+	if lastID == 0 {
+		return sub, nil, nil
+	}
+	backlog, err := c.bus.Since(ctx, userAddr, lastID)
+	if err != nil {
+		sub.Close()
+		return nil, nil, err
+	}
+	return sub, backlog, nil
+}
+
+// lastEventID 读取客户端最后收到的事件ID, SSE重连时浏览器携带Last-Event-ID请求头
+func lastEventID(ctx *gin.Context) uint64 {
+	raw := ctx.GetHeader(lastEventIDHeader)
+	if raw == "" {
+		raw = ctx.Query("lastEventId")
+	}
+	id, _ := strconv.ParseUint(raw, 10, 64)
+	return id
+}
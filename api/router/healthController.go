@@ -0,0 +1,59 @@
+package router
+
+import (
+	"MetaFarmBackend/api/middleware"
+	"MetaFarmBackend/component/health"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthController 健康检查控制器
+type HealthController struct {
+	checker *health.Checker
+}
+
+// 构造函数
+func NewHealthController(checker *health.Checker) *HealthController {
+	return &HealthController{
+		checker: checker,
+	}
+}
+
+// RegisterRoutes 注册健康检查路由
+func (c *HealthController) RegisterRoutes(r *gin.Engine) {
+	r.GET("/healthz", c.Liveness)
+	r.GET("/readyz", c.Readiness)
+}
+
+// Liveness 存活检查
+// @Summary 存活检查
+// @Description 进程存活即返回200, 不检查外部依赖
+// @Tags health
+// @Produce json
+// @Success 200 {object} middleware.Response{data=map[string]string}
+// @Router /healthz [get]
+func (c *HealthController) Liveness(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, middleware.Response{Data: gin.H{
+		"status": health.StatusOK,
+		"time":   time.Now(),
+	}})
+}
+
+// Readiness 就绪检查
+// @Summary 就绪检查
+// @Description 检查MySQL、Redis及L1/L2区块新鲜度, 核心依赖不可用时返回503
+// @Tags health
+// @Produce json
+// @Success 200 {object} middleware.Response{data=health.Report}
+// @Failure 503 {object} middleware.Response{data=health.Report}
+// @Router /readyz [get]
+func (c *HealthController) Readiness(ctx *gin.Context) {
+	report := c.checker.Readiness(ctx.Request.Context())
+	if report.Status == health.StatusDown {
+		ctx.JSON(http.StatusServiceUnavailable, middleware.Response{Code: http.StatusServiceUnavailable, Message: report.Status, Data: report})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.Response{Code: 0, Message: report.Status, Data: report})
+}
@@ -5,21 +5,29 @@ import (
 	"MetaFarmBackend/api/request"
 	"MetaFarmBackend/api/response"
 	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/pagination"
 	"MetaFarmBackend/service"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 // landController 土地相关控制器
 type LandController struct {
-	landService service.LandService
+	landService  service.LandService
+	idempotent   gin.HandlerFunc
+	requireChain gin.HandlerFunc
 }
 
 // 构造函数
-func NewLandController(landService service.LandService) *LandController {
+func NewLandController(landService service.LandService, idempotent, requireChain gin.HandlerFunc) *LandController {
 	return &LandController{
-		landService: landService,
+		landService:  landService,
+		idempotent:   idempotent,
+		requireChain: requireChain,
 	}
 }
 
@@ -27,71 +35,157 @@ func NewLandController(landService service.LandService) *LandController {
 func (c *LandController) RegisterRoutes(router *gin.RouterGroup) {
 	landRouter := router.Group("/land")
 	{
-		// 需要身份验证的路由
+		// 需要身份验证的路由, 状态变更类接口支持Idempotency-Key
 		landRouter.GET("/list", c.ListUserLands)
 		landRouter.GET("/:tokenID/detail", c.GetLandDetail)
-		landRouter.POST("/upgrade", c.UpgradeLand)
+		landRouter.GET("/:tokenID/history", c.GetLandHistory)
+		landRouter.GET("/:tokenID/plots", c.ListLandPlots)
+		landRouter.GET("/:tokenID/zones", c.ListZoneOccupancy)
+		landRouter.GET("/:tokenID/layout", c.GetLayout)
+		landRouter.POST("/upgrade", c.idempotent, c.UpgradeLand)
+		landRouter.POST("/upgrade/speedup", c.idempotent, c.SpeedUpUpgrade)
+		landRouter.POST("/upgrade/cancel", c.CancelUpgrade)
+		landRouter.GET("/upgrade/queue", c.ListUpgradeQueue)
 		landRouter.POST("/rent/list", c.ListRentLands)
-		landRouter.POST("/rent/create", c.CreateRent)
+		landRouter.POST("/rent/create", c.requireChain, c.idempotent, c.CreateRent)
 		landRouter.POST("/rent/cancel", c.CancelRent)
-		landRouter.POST("/market/buy", c.BuyLand)
+		landRouter.GET("/search", c.SearchLands)
+		landRouter.GET("/market/list", c.ListMarketLands)
+		landRouter.POST("/market/buy", c.requireChain, c.idempotent, c.BuyLand)
 		landRouter.POST("/layout/update", c.UpdateLayout)
-		landRouter.POST("/activity/plant", c.PlantCrop)
-		landRouter.POST("/activity/harvest", c.HarvestCrop)
+		landRouter.POST("/layout/preview", c.PreviewLayout)
+		landRouter.POST("/fertilize", c.idempotent, c.FertilizeLand)
+		landRouter.POST("/activity/plant", c.idempotent, c.PlantCrop)
+		landRouter.POST("/activity/harvest", c.idempotent, c.HarvestCrop)
+		landRouter.POST("/activity/clear", c.ClearDeadCrops)
+		landRouter.POST("/batch/harvest", c.idempotent, c.BatchHarvest)
+		landRouter.POST("/batch/replant", c.idempotent, c.BatchReplant)
+		landRouter.POST("/plot/water", c.idempotent, c.WaterPlot)
+		landRouter.POST("/plot/fertilize", c.idempotent, c.FertilizePlot)
+		landRouter.POST("/plot/pesticide", c.idempotent, c.ApplyPesticide)
+		landRouter.GET("/:tokenID/animals", c.ListLandAnimals)
+		landRouter.POST("/animal/feed", c.idempotent, c.FeedAnimal)
+		landRouter.POST("/animal/collect", c.idempotent, c.CollectAnimalProducts)
+		landRouter.POST("/animal/breed", c.idempotent, c.BreedAnimal)
+		landRouter.GET("/produce/list", c.ListProduce)
 	}
 }
 
 // ListUserLands 获取用户土地列表
 // @Summary 获取用户所有土地
-// @Description 获取当前登录用户的所有土地信息
+// @Description 分页获取当前登录用户的土地信息, sort可选id、level、rarity、fertility、area、createTime. 按肥力排序及返回值均为当前肥力
 // @Tags land
 // @Accept json
 // @Produce json
-// @Success 200 {object} Response{data=[]land.LandInfo}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/list [get]
+// @Param user_address header string true "用户钱包地址"
+// @Param query query request.ListUserLandsRequest false "分页及筛选参数"
+// @Success 200 {object} middleware.Response{data=[]dao.LandInfo}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/list [get]
 func (a *LandController) ListUserLands(ctx *gin.Context) {
+	var req request.ListUserLandsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	userAddr := ctx.GetHeader("user_address")
-	lands, err := a.landService.GetUserLands(ctx, userAddr)
+	lands, page, err := a.landService.GetUserLands(ctx, userAddr, req)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidQuery) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		logger.Error("获取土地列表失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, middleware.Response{Data: lands})
+	ctx.JSON(http.StatusOK, middleware.PageResponse(lands, page))
 }
 
 // GetLandDetail 获取土地详细信息
 // @Summary 获取土地详细信息
-// @Description 根据tokenID获取土地详细信息
+// @Description 根据tokenID获取土地属性及当前肥力、分区、生长中的活动及距成熟的剩余时间、生效的租赁、待出售的挂牌和最近一次完成的升级. 结果缓存在redis中, 土地相关的写操作后立即失效. 土地不存在时返回404
 // @Tags land
 // @Accept json
 // @Produce json
 // @Param tokenID path string true "土地NFT TokenID"
-// @Success 200 {object} Response{data=dao.LandInfo}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/{tokenID}/detail [get]
+// @Success 200 {object} middleware.Response{data=response.LandDetailResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/detail [get]
 func (a *LandController) GetLandDetail(ctx *gin.Context) {
 	tokenID := ctx.Param("tokenID")
 	landDetail, err := a.landService.GetLandDetail(ctx, tokenID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
 	if err != nil {
 		logger.Error("获取土地详情失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, middleware.Response{Data: landDetail})
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.ToLandDetailResponse(landDetail, time.Now())})
+}
+
+// GetLandHistory 获取土地历史
+// @Summary 获取土地历史时间线
+// @Description 按时间倒序分页获取土地的铸造、升级、挂牌、成交(所有权转移)、租赁及种植养殖记录, sort仅支持time. 土地不存在时返回404
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param tokenID path string true "土地NFT TokenID"
+// @Param query query request.PageRequest false "分页参数"
+// @Success 200 {object} middleware.Response{data=[]dao.LandHistoryEntry}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/history [get]
+func (a *LandController) GetLandHistory(ctx *gin.Context) {
+	var req request.PageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries, page, err := a.landService.GetLandHistory(ctx, ctx.Param("tokenID"), req)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidQuery) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+			return
+		}
+		logger.Error("获取土地历史失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.PageResponse(entries, page))
 }
 
 // UpgradeLand 升级土地
 // @Summary 升级土地
-// @Description 升级指定土地的等级
+// @Description 将土地升级到下一等级, 按土地稀有度与地形匹配升级规则, 升级后面积、肥力上限、产量倍率提升并可能解锁新的分区类型. 消耗的MFG与道具在同一事务中扣减, 不足时返回400
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body UpgradeLandRequest true "升级土地请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/upgrade [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.UpgradeLandRequest true "升级土地请求"
+// @Success 200 {object} middleware.Response{data=dao.LandUpgrade}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/upgrade [post]
 func (a *LandController) UpgradeLand(ctx *gin.Context) {
 	var req request.UpgradeLandRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -109,46 +203,293 @@ func (a *LandController) UpgradeLand(ctx *gin.Context) {
 	req.UserAddress = userAddr
 
 	// 调用服务层升级土地
-	err := a.landService.UpgradeLand(ctx, req)
+	upgrade, err := a.landService.UpgradeLand(ctx, req)
+	if errors.Is(err, service.ErrUpgradeNotAllowed) || errors.Is(err, service.ErrInsufficientAssets) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		logger.Error("升级土地失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, middleware.Response{Data: "土地升级成功"})
+	ctx.JSON(http.StatusOK, middleware.Response{Data: upgrade})
+}
+
+// SpeedUpUpgrade 加速升级施工
+// @Summary 加速升级施工
+// @Description 指定itemTokenId时使用加速道具(每次缩短道具Power分钟), 否则按剩余分钟支付MFG立即完成
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.SpeedUpUpgradeRequest true "加速升级请求"
+// @Success 200 {object} middleware.Response{data=dao.LandUpgrade}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/upgrade/speedup [post]
+func (a *LandController) SpeedUpUpgrade(ctx *gin.Context) {
+	var req request.SpeedUpUpgradeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	upgrade, err := a.landService.SpeedUpUpgrade(ctx, req)
+	if errors.Is(err, service.ErrUpgradeNotAllowed) || errors.Is(err, service.ErrInsufficientAssets) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		logger.Error("加速升级失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: upgrade})
+}
+
+// CancelUpgrade 取消升级施工
+// @Summary 取消升级施工
+// @Description 取消施工中的升级, 按配置比例退还MFG, 已消耗的道具不退还
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CancelUpgradeRequest true "取消升级请求"
+// @Success 200 {object} middleware.Response{data=dao.LandUpgrade}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/upgrade/cancel [post]
+func (a *LandController) CancelUpgrade(ctx *gin.Context) {
+	var req request.CancelUpgradeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	upgrade, err := a.landService.CancelUpgrade(ctx, req)
+	if errors.Is(err, service.ErrUpgradeNotAllowed) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		logger.Error("取消升级失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: upgrade})
+}
+
+// ListUpgradeQueue 获取升级施工队列
+// @Summary 获取升级施工队列
+// @Description 获取当前用户施工中的土地升级, 按完工时间升序
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Success 200 {object} middleware.Response{data=[]dao.LandUpgrade}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/upgrade/queue [get]
+func (a *LandController) ListUpgradeQueue(ctx *gin.Context) {
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+
+	upgrades, err := a.landService.GetUpgradeQueue(ctx, userAddr)
+	if err != nil {
+		logger.Error("获取升级队列失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: upgrades})
 }
 
 // ListRentLands 获取租赁订单列表
 // @Summary 获取租赁订单列表
-// @Description 获取当前用户的所有租赁订单
+// @Description 分页获取当前用户的租赁订单, sort可选id、rentalEndTime、rentalStartTime、totalRent
 // @Tags land
 // @Accept json
 // @Produce json
-// @Success 200 {object} Response{data=[]dao.LandRental}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/rent/list [get]
+// @Param user_address header string true "用户钱包地址"
+// @Param query query request.ListRentLandsRequest false "分页及筛选参数"
+// @Success 200 {object} middleware.Response{data=[]dao.LandRental}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/rent/list [post]
 func (a *LandController) ListRentLands(ctx *gin.Context) {
+	var req request.ListRentLandsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	userAddr := ctx.GetHeader("user_address")
-	lands, err := a.landService.GetActiveRentals(ctx, userAddr)
+	lands, page, err := a.landService.GetActiveRentals(ctx, userAddr, req)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidQuery) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		logger.Error("获取租赁列表失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, middleware.Response{Data: lands})
+	ctx.JSON(http.StatusOK, middleware.PageResponse(lands, page))
+}
+
+// ListMarketLands 获取土地挂牌列表
+// @Summary 获取土地挂牌列表
+// @Description 分页获取市场上待出售的土地, 默认按价格升序, sort可选id、price、area、listingTime
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param query query request.ListMarketLandsRequest false "分页及筛选参数"
+// @Success 200 {object} middleware.Response{data=[]dao.LandMarket}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/market/list [get]
+func (a *LandController) ListMarketLands(ctx *gin.Context) {
+	var req request.ListMarketLandsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	listings, page, err := a.landService.GetMarketListings(ctx, req)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidQuery) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Error("获取土地挂牌列表失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.PageResponse(listings, page))
+}
+
+// SearchLands 搜索土地
+// @Summary 搜索土地
+// @Description 按地形、稀有度、等级、肥力、面积、特殊效果、出售/租赁状态及售价筛选全部土地, sort可选id、level、rarity、fertility、area、createTime、price(按价格排序时只返回待出售的土地). 肥力按当前肥力筛选和排序. 首页(cursor为空)返回按稀有度、地形类型的分面统计, 每一项统计时忽略该项自身的筛选条件
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param query query request.SearchLandsRequest false "分页及筛选参数"
+// @Success 200 {object} middleware.Response{data=response.SearchLandsResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/search [get]
+func (a *LandController) SearchLands(ctx *gin.Context) {
+	var req request.SearchLandsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, facets, page, err := a.landService.SearchLands(ctx, req)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidQuery) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Error("搜索土地失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, middleware.PageResponse(response.ToSearchLandsResponse(results, facets), page))
+}
+
+// FertilizeLand 施肥
+// @Summary 使用肥料恢复土地肥力
+// @Description 消耗肥料道具(item_type=1), 每次使用恢复道具Power点肥力, 不超过肥力上限. 肥力平时按时间自动恢复, 恢复速度受稀有度、特殊效果及布局加成影响
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.FertilizeLandRequest true "施肥请求"
+// @Success 200 {object} middleware.Response{data=dao.LandInfo}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/fertilize [post]
+func (a *LandController) FertilizeLand(ctx *gin.Context) {
+	var req request.FertilizeLandRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	land, err := a.landService.FertilizeLand(ctx, req)
+	if errors.Is(err, service.ErrFertilizeNotAllowed) || errors.Is(err, service.ErrInsufficientAssets) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		logger.Error("施肥失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: land})
 }
 
 // CreateRent 创建土地租赁订单
 // @Summary 创建土地租赁订单
-// @Description 创建土地租赁订单
+// @Description 创建土地租赁订单, 链服务不可用(降级模式)时返回503
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body CreateRentRequest true "创建租赁请求"
-// @Success 200 {object} Response{data=dao.LandRental}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/rent/create [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CreateRentRequest true "创建租赁请求"
+// @Success 200 {object} response.RentLandResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/rent/create [post]
 func (c *LandController) CreateRent(ctx *gin.Context) {
 	// 1. 绑定请求参数
 	var req request.CreateRentRequest
@@ -180,10 +521,13 @@ func (c *LandController) CreateRent(ctx *gin.Context) {
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body CancelRentRequest true "取消租赁请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/rent/cancel [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CancelRentalRequest true "取消租赁请求"
+// @Success 200 {object} middleware.Response{data=string}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/rent/cancel [post]
 func (a *LandController) CancelRent(ctx *gin.Context) {
 	var req request.CancelRentalRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -214,14 +558,19 @@ func (a *LandController) CancelRent(ctx *gin.Context) {
 
 // BuyLand 购买土地
 // @Summary 购买土地
-// @Description 从市场购买土地
+// @Description 从市场购买土地, 链服务不可用(降级模式)时返回503
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body BuyLandRequest true "购买土地请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/market/buy [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.BuyLandRequest true "购买土地请求"
+// @Success 200 {object} middleware.Response{data=string}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/market/buy [post]
 func (a *LandController) BuyLand(ctx *gin.Context) {
 	var req request.BuyLandRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -251,14 +600,18 @@ func (a *LandController) BuyLand(ctx *gin.Context) {
 
 // UpdateLayout 更新土地布局
 // @Summary 更新土地布局
-// @Description 更新土地分区布局
+// @Description 整体替换土地的分区布局: 带id的分区更新, 不带id的新建, 未列出的已有分区删除. 土地视为边长⌊√面积⌋的正方形网格, 分区须在网格内、互不重叠且面积等于宽×高; 有生长中或枯萎未清理作物的分区不能修改或删除, 不满足时返回400. 保存后按相邻规则重新计算各分区加成
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body UpdateLayoutRequest true "更新布局请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/layout/update [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.UpdateLandLayoutRequest true "更新布局请求"
+// @Success 200 {object} middleware.Response{data=response.LandLayoutResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/layout/update [post]
 func (a *LandController) UpdateLayout(ctx *gin.Context) {
 	var req request.UpdateLandLayoutRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -276,26 +629,114 @@ func (a *LandController) UpdateLayout(ctx *gin.Context) {
 	req.UserAddress = userAddr
 
 	// 调用服务层更新布局
-	err := a.landService.UpdateLandLayout(ctx, req)
+	layouts, err := a.landService.UpdateLandLayout(ctx, req)
+	if errors.Is(err, service.ErrZoneLocked) || errors.Is(err, service.ErrLayoutInvalid) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
 	if err != nil {
 		logger.Error("更新土地布局失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, middleware.Response{Data: "土地布局更新成功"})
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.ToLandLayoutResponse(req.TokenID, layouts)})
+}
+
+// PreviewLayout 预览土地布局
+// @Summary 预览土地布局
+// @Description 按更新布局的规则校验分区, 返回各分区按相邻规则计算出的加成, 不保存. 新分区的id为0; 布局不合法时返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.UpdateLandLayoutRequest true "预览布局请求"
+// @Success 200 {object} middleware.Response{data=response.LandLayoutResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/layout/preview [post]
+func (a *LandController) PreviewLayout(ctx *gin.Context) {
+	var req request.UpdateLandLayoutRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	layouts, err := a.landService.PreviewLandLayout(ctx, req)
+	if errors.Is(err, service.ErrZoneLocked) || errors.Is(err, service.ErrLayoutInvalid) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("预览土地布局失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.ToLandLayoutResponse(req.TokenID, layouts)})
+}
+
+// GetLayout 获取土地布局
+// @Summary 获取土地布局
+// @Description 获取土地的全部分区及其相邻加成. 土地不存在时返回404
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param tokenID path string true "土地NFT TokenID"
+// @Success 200 {object} middleware.Response{data=response.LandLayoutResponse}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/layout [get]
+func (a *LandController) GetLayout(ctx *gin.Context) {
+	tokenID := ctx.Param("tokenID")
+	layouts, err := a.landService.GetLandLayout(ctx, tokenID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("获取土地布局失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.ToLandLayoutResponse(tokenID, layouts)})
 }
 
 // PlantCrop 种植作物
 // @Summary 种植作物
-// @Description 在土地上种植作物
+// @Description 在分区中种植作物或养殖动物, 作物只能种植在种植区, 动物只能养殖在养殖区, 种植面积不超过分区剩余面积. 作物/动物须在目录中上架, 并满足解锁等级、适宜地形、分区类型及季节, 生长时长与每平方米肥力消耗由目录决定
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body PlantCropRequest true "种植作物请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/activity/plant [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.PlantCropRequest true "种植作物请求"
+// @Success 200 {object} middleware.Response{data=response.PlantCropResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/activity/plant [post]
 func (a *LandController) PlantCrop(ctx *gin.Context) {
 	var req request.PlantCropRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -314,26 +755,44 @@ func (a *LandController) PlantCrop(ctx *gin.Context) {
 	req.UserAddress = userAddr
 
 	// 调用服务层种植作物
-	err := a.landService.PlantCrop(ctx, req)
+	activity, err := a.landService.PlantCrop(ctx, req)
+	if errors.Is(err, service.ErrPlantNotAllowed) || errors.Is(err, service.ErrZoneLocked) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		logger.Error("种植作物失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, middleware.Response{Data: "作物种植成功"})
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.PlantCropResponse{
+		ActivityID:      activity.ID,
+		ActivityType:    activity.ActivityType,
+		CropAnimalID:    activity.CropAnimalID,
+		CropAnimalName:  activity.CropAnimalName,
+		Area:            activity.Area,
+		FertilityCost:   activity.FertilityCost,
+		StartTime:       activity.Start_time,
+		ExpectedEndTime: activity.ExpectedEndTime,
+	}})
 }
 
 // HarvestCrop 收获作物
 // @Summary 收获作物
-// @Description 收获成熟的作物
+// @Description 收获成熟的作物, 产量由目录基础产量、面积、土地等级、种植时肥力、稀有度、特殊效果及布局加成决定, 收获物计入库存并获得经验值
 // @Tags land
 // @Accept json
 // @Produce json
-// @Param body body HarvestCropRequest true "收获作物请求"
-// @Success 200 {object} Response{data=string}
-// @Failure 400 {object} Response{error=string}
-// @Router /land/activity/harvest [post]
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.HarvestCropRequest true "收获作物请求"
+// @Success 200 {object} middleware.Response{data=response.HarvestCropResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/activity/harvest [post]
 func (a *LandController) HarvestCrop(ctx *gin.Context) {
 	var req request.HarvestCropRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -351,12 +810,528 @@ func (a *LandController) HarvestCrop(ctx *gin.Context) {
 	req.UserAddress = userAddr
 
 	// 调用服务层收获作物
-	err := a.landService.HarvestCrop(ctx, req)
+	result, err := a.landService.HarvestCrop(ctx, req)
+	if errors.Is(err, service.ErrHarvestNotAllowed) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "活动不存在"})
+		return
+	}
 	if err != nil {
 		logger.Error("收获作物失败: ", err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, middleware.Response{Data: "作物收获成功"})
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.HarvestCropResponse{
+		Success:    true,
+		Yield:      result.Yield,
+		CropName:   result.Activity.CropAnimalName,
+		Experience: int(result.Experience),
+	}})
+}
+
+// BatchHarvest 一键收获
+// @Summary 一键收获
+// @Description 收获当前用户所有土地上已成熟的作物, 每次最多处理100项, hasMore为true时可再次调用; replant为true时在原分区补种同一作物. 每项独立成功或失败, 失败原因见各项的error
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.BatchHarvestRequest true "一键收获请求"
+// @Success 200 {object} middleware.Response{data=response.BatchFarmResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/batch/harvest [post]
+func (a *LandController) BatchHarvest(ctx *gin.Context) {
+	var req request.BatchHarvestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	result, err := a.landService.HarvestAll(ctx, req)
+	if err != nil {
+		logger.Error("一键收获失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: toBatchFarmResponse(result)})
+}
+
+// BatchReplant 批量补种
+// @Summary 批量补种
+// @Description 在已收获活动的原分区重新种植同一作物, 面积与原活动相同, 一次最多100项. 每项独立成功或失败, 不满足种植条件、肥力或分区剩余面积不足的项失败原因见各项的error
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.BatchReplantRequest true "批量补种请求"
+// @Success 200 {object} middleware.Response{data=response.BatchFarmResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/batch/replant [post]
+func (a *LandController) BatchReplant(ctx *gin.Context) {
+	var req request.BatchReplantRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	result, err := a.landService.ReplantCrops(ctx, req)
+	if err != nil {
+		logger.Error("批量补种失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: toBatchFarmResponse(result)})
+}
+
+// toBatchFarmResponse 将批量操作结果转换为响应, 统计成功与失败的项数
+func toBatchFarmResponse(result *service.BatchResult) response.BatchFarmResponse {
+	resp := response.BatchFarmResponse{HasMore: result.HasMore, Items: make([]response.BatchFarmItem, 0, len(result.Items))}
+	for _, item := range result.Items {
+		entry := response.BatchFarmItem{
+			ActivityID:    item.ActivityID,
+			LandTokenID:   item.LandTokenID,
+			CropName:      item.CropName,
+			Harvested:     item.Harvested,
+			Yield:         item.Yield,
+			Experience:    item.Experience,
+			Replanted:     item.Replanted,
+			NewActivityID: item.NewActivityID,
+		}
+		if item.Err != nil {
+			entry.Error = item.Err.Error()
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Items = append(resp.Items, entry)
+	}
+	return resp
+}
+
+// ClearDeadCrops 清理枯萎作物
+// @Summary 清理枯萎作物
+// @Description 土地所有者清理土地上超过收获宽限期而枯萎的作物, 释放占用的面积, 返回清理的数量
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.ClearDeadCropsRequest true "清理枯萎作物请求"
+// @Success 200 {object} middleware.Response{data=int64}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/activity/clear [post]
+func (a *LandController) ClearDeadCrops(ctx *gin.Context) {
+	var req request.ClearDeadCropsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	cleared, err := a.landService.ClearDeadCrops(ctx, req)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("清理枯萎作物失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: cleared})
+}
+
+// WaterPlot 浇水
+// @Summary 浇水
+// @Description 给地块浇水, 消耗一次水壶道具增加水分. 水分随时间流失, 为0时无法收获. 受冷却时间限制, 道具不符或冷却中返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CarePlotRequest true "照料地块请求, itemTokenId为水壶道具"
+// @Success 200 {object} middleware.Response{data=dao.PlotPlanting}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/plot/water [post]
+func (a *LandController) WaterPlot(ctx *gin.Context) {
+	a.carePlot(ctx, service.CareWater)
+}
+
+// FertilizePlot 地块施肥
+// @Summary 地块施肥
+// @Description 给地块施肥, 消耗一次肥料道具提高肥料等级, 肥料等级越高预计产量越高. 受冷却时间限制, 道具不符或冷却中返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CarePlotRequest true "照料地块请求, itemTokenId为肥料道具"
+// @Success 200 {object} middleware.Response{data=dao.PlotPlanting}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/plot/fertilize [post]
+func (a *LandController) FertilizePlot(ctx *gin.Context) {
+	a.carePlot(ctx, service.CareFertilize)
+}
+
+// ApplyPesticide 除虫
+// @Summary 除虫
+// @Description 给地块除虫, 消耗一次杀虫剂道具降低虫害. 虫害随时间增长, 达到100时无法收获. 受冷却时间限制, 道具不符或冷却中返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.CarePlotRequest true "照料地块请求, itemTokenId为杀虫剂道具"
+// @Success 200 {object} middleware.Response{data=dao.PlotPlanting}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/plot/pesticide [post]
+func (a *LandController) ApplyPesticide(ctx *gin.Context) {
+	a.carePlot(ctx, service.CarePesticide)
+}
+
+// carePlot 浇水、施肥、除虫接口的公共处理
+func (a *LandController) carePlot(ctx *gin.Context, action string) {
+	var req request.CarePlotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+	req.Action = action
+
+	plot, err := a.landService.CarePlot(ctx, req)
+	if errors.Is(err, service.ErrCareNotAllowed) || errors.Is(err, service.ErrInsufficientAssets) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "活动不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("照料地块失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: plot})
+}
+
+// ListLandPlots 获取土地上的地块
+// @Summary 获取土地上的地块
+// @Description 获取土地上正在种植的地块及其水分、肥料、虫害、预计产量和是否可收获, 按当前时间计算. 土地不存在时返回404
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param tokenID path string true "土地NFT TokenID"
+// @Success 200 {object} middleware.Response{data=[]dao.PlotPlanting}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/plots [get]
+func (a *LandController) ListLandPlots(ctx *gin.Context) {
+	plots, err := a.landService.GetLandPlots(ctx, ctx.Param("tokenID"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("获取地块失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: plots})
+}
+
+// FeedAnimal 喂养动物
+// @Summary 喂养动物
+// @Description 使用饲料道具或目录允许的作物收获物喂养动物, 二者选一. 饲料道具每次消耗一次并按效果值恢复健康值, 作物按数量恢复. 健康值随时间下降, 降到0时动物饿死; 饲料不符、健康值已满或动物已饿死返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.FeedAnimalRequest true "喂养动物请求"
+// @Success 200 {object} middleware.Response{data=dao.BreedingAnimal}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/animal/feed [post]
+func (a *LandController) FeedAnimal(ctx *gin.Context) {
+	var req request.FeedAnimalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	animal, err := a.landService.FeedAnimal(ctx, req)
+	if errors.Is(err, service.ErrBreedingNotAllowed) || errors.Is(err, service.ErrInsufficientAssets) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "活动不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("喂养动物失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: animal})
+}
+
+// CollectAnimalProducts 收集动物产物
+// @Summary 收集动物产物
+// @Description 动物成年后按目录中的周期产出鸡蛋、牛奶等产物, 产量按收获规则计算并按健康值修正, 产物计入库存并获得经验值, 收集后开始下一周期. 产物未就绪或动物已饿死返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.AnimalActionRequest true "收集产物请求"
+// @Success 200 {object} middleware.Response{data=response.CollectProductsResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/animal/collect [post]
+func (a *LandController) CollectAnimalProducts(ctx *gin.Context) {
+	var req request.AnimalActionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	result, err := a.landService.CollectAnimalProducts(ctx, req)
+	if errors.Is(err, service.ErrBreedingNotAllowed) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "活动不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("收集产物失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.CollectProductsResponse{
+		ProductName:     result.ProductName,
+		Yield:           result.Yield,
+		Experience:      result.Experience,
+		Health:          result.Animal.Health,
+		NextCollectTime: result.Animal.NextCollectTime,
+	}})
+}
+
+// BreedAnimal 繁殖动物
+// @Summary 繁殖动物
+// @Description 已成年且健康值达到要求的动物繁殖幼崽, 消耗亲代健康值及幼崽占用面积对应的土地肥力, 受冷却时间限制. 幼崽作为新的养殖活动放入同一分区, 成年后才能产出和繁殖; 分区剩余面积不足或不满足条件时返回400
+// @Tags land
+// @Accept json
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param body body request.AnimalActionRequest true "繁殖动物请求"
+// @Success 200 {object} middleware.Response{data=response.BreedAnimalResponse}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Param Idempotency-Key header string false "幂等键"
+// @Router /api/v1/land/animal/breed [post]
+func (a *LandController) BreedAnimal(ctx *gin.Context) {
+	var req request.AnimalActionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Error("参数绑定失败: ", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求参数"})
+		return
+	}
+
+	// 从请求头获取用户地址
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+	req.UserAddress = userAddr
+
+	result, err := a.landService.BreedAnimal(ctx, req)
+	if errors.Is(err, service.ErrBreedingNotAllowed) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "活动不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("繁殖动物失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: response.BreedAnimalResponse{Parent: result.Parent, Offspring: result.Offspring}})
+}
+
+// ListLandAnimals 获取土地上的养殖动物
+// @Summary 获取土地上的养殖动物
+// @Description 获取土地上存活的养殖动物及其健康值、饿死时间、下次可收集产物的时间和代数, 健康值按当前时间计算. 土地不存在时返回404
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param tokenID path string true "土地NFT TokenID"
+// @Success 200 {object} middleware.Response{data=[]dao.BreedingAnimal}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/animals [get]
+func (a *LandController) ListLandAnimals(ctx *gin.Context) {
+	animals, err := a.landService.GetLandAnimals(ctx, ctx.Param("tokenID"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("获取养殖动物失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: animals})
+}
+
+// ListZoneOccupancy 获取分区面积占用
+// @Summary 获取分区面积占用
+// @Description 获取土地各分区的面积、被生长中及枯萎未清理的活动占用的面积和剩余可种植面积. 土地不存在时返回404
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Param tokenID path string true "土地NFT TokenID"
+// @Success 200 {object} middleware.Response{data=[]dao.ZoneOccupancy}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/{tokenID}/zones [get]
+func (a *LandController) ListZoneOccupancy(ctx *gin.Context) {
+	zones, err := a.landService.GetZoneOccupancy(ctx, ctx.Param("tokenID"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "土地不存在"})
+		return
+	}
+	if err != nil {
+		logger.Error("获取分区面积占用失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: zones})
+}
+
+// ListProduce 获取收获物库存
+// @Summary 获取收获物库存
+// @Description 获取当前用户收获的作物/动物库存
+// @Tags land
+// @Produce json
+// @Param user_address header string true "用户钱包地址"
+// @Success 200 {object} middleware.Response{data=[]dao.UserProduce}
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/land/produce/list [get]
+func (a *LandController) ListProduce(ctx *gin.Context) {
+	userAddr := ctx.GetHeader("user_address")
+	if userAddr == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+
+	items, err := a.landService.GetUserProduce(ctx, userAddr)
+	if err != nil {
+		logger.Error("获取收获物库存失败: ", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, middleware.Response{Data: items})
 }
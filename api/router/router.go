@@ -1,10 +1,19 @@
 package router
 
 import (
+	stdcontext "context"
+	"time"
+
 	"MetaFarmBackend/api/middleware"
+	"MetaFarmBackend/api/rpc"
+	"MetaFarmBackend/component/config"
 	"MetaFarmBackend/component/context"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/docs"
 
 	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
 func InitRouter(appContext *context.AppContext) *gin.Engine {
@@ -16,13 +25,70 @@ func InitRouter(appContext *context.AppContext) *gin.Engine {
 	r.Use(middleware.RequestLogger())
 	r.Use(middleware.CORSMiddleware())
 
+	// 接口文档及契约校验
+	registerSwagger(r, appContext.Config.Swagger)
+
+	healthController := NewHealthController(appContext.Health)
+	healthController.RegisterRoutes(r)
+
 	authController := NewWalletAuthController(appContext.WalletAuthService)
-	authController.RegisterRoutes(r)
 
-	apiLand := r.Group("/api/land")
-	{
-		landController := NewLandController(appContext.LandService)
-		landController.RegisterRoutes(apiLand)
-	}
+	// 健康检查不参与限流, 已登录用户按钱包地址限流
+	r.Use(authController.OptionalAuthMiddleware())
+	r.Use(middleware.RateLimitMiddleware(appContext.RateLimiter, appContext.APIKeys))
+
+	landController := NewLandController(appContext.LandService,
+		middleware.IdempotencyMiddleware(appContext.Idempotency),
+		middleware.RequireChain(appContext.ChainEnabled))
+	catalogController := NewCatalogController(appContext.CatalogService, middleware.AdminMiddleware(appContext.APIKeys))
+	eventController := NewEventController(appContext.Events, time.Duration(appContext.Config.Events.Heartbeat)*time.Second)
+
+	// /api/v1为规范路径, 新版本在此追加, 如 apiVersion{name: APIVersionV2, register: ...}
+	registerVersions(r,
+		apiVersion{name: APIVersionV1, register: func(group *gin.RouterGroup) {
+			authController.RegisterRoutes(group)
+			landController.RegisterRoutes(group)
+			catalogController.RegisterRoutes(group)
+			eventController.RegisterRoutes(group, authController.AuthMiddleware())
+		}},
+	)
+
+	// 兼容已发布的游戏客户端: /login等认证接口与/api/land/land/...土地接口
+	sunset := appContext.Config.API.LegacySunset
+	authController.RegisterRoutes(deprecatedRoutes(r, "", "/api/v1", sunset))
+	landController.RegisterRoutes(deprecatedRoutes(r, "/api/land", "/api/v1", sunset))
+
+	// gRPC接口的JSON形式, 供不便接入gRPC的调用方使用
+	registerGateway(r, appContext.Config.GRPC)
 	return r
 }
+
+// registerGateway 在/rpc/v1下挂载grpc-gateway, 认证由gRPC拦截器完成
+func registerGateway(r *gin.Engine, cfg config.GRPCConfig) {
+	if !cfg.Enabled || !cfg.Gateway {
+		return
+	}
+	gw, err := rpc.NewGateway(stdcontext.Background(), cfg.Port)
+	if err != nil {
+		logger.Errorf("初始化gRPC网关失败, 已跳过: %v", err)
+		return
+	}
+	r.Any("/rpc/v1/*path", gin.WrapH(gw))
+}
+
+// registerSwagger 在/swagger提供接口文档, 并按配置开启请求/响应契约校验
+func registerSwagger(r *gin.Engine, cfg config.SwaggerConfig) {
+	if cfg.Enabled {
+		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	}
+
+	if cfg.Validate == "" || cfg.Validate == middleware.ContractModeOff {
+		return
+	}
+	validator, err := middleware.NewContractValidator([]byte(docs.SwaggerInfo.ReadDoc()), cfg.Validate)
+	if err != nil {
+		logger.Errorf("初始化接口契约校验失败, 已跳过: %v", err)
+		return
+	}
+	r.Use(middleware.ContractValidationMiddleware(validator))
+}
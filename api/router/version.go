@@ -0,0 +1,46 @@
+package router
+
+import (
+	"time"
+
+	"MetaFarmBackend/api/middleware"
+	"MetaFarmBackend/component/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 接口版本
+const (
+	APIVersionV1 = "v1"
+	APIVersionV2 = "v2"
+)
+
+// apiVersion 一个版本的路由集合, 新版本只需实现有变化的接口, 其余可直接复用上一版本的控制器
+type apiVersion struct {
+	name     string
+	register func(group *gin.RouterGroup)
+}
+
+// registerVersions 按/api/{version}注册各版本路由, 多版本并存
+func registerVersions(r *gin.Engine, versions ...apiVersion) {
+	for _, v := range versions {
+		group := r.Group("/api/"+v.name, middleware.APIVersionMiddleware(v.name))
+		v.register(group)
+	}
+}
+
+// deprecatedRoutes 创建旧版路径分组, 请求仍由最新控制器处理, 但响应携带Deprecation/Sunset头
+func deprecatedRoutes(r *gin.Engine, legacyPrefix, successorPrefix, sunset string) *gin.RouterGroup {
+	var sunsetTime time.Time
+	if sunset != "" {
+		t, err := time.Parse("2006-01-02", sunset)
+		if err != nil {
+			logger.Errorf("旧版路径下线日期格式错误: %s, err: %v", sunset, err)
+		}
+		sunsetTime = t
+	}
+	return r.Group(legacyPrefix, middleware.DeprecatedMiddleware(legacyDeprecatedAt, sunsetTime, legacyPrefix, successorPrefix))
+}
+
+// legacyDeprecatedAt 旧版路径开始废弃的时间(引入/api/v1的版本)
+var legacyDeprecatedAt = time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
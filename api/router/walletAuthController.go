@@ -1,6 +1,8 @@
 package router
 
 import (
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/api/response"
 	"MetaFarmBackend/service"
 	"net/http"
 	"strings"
@@ -21,38 +23,52 @@ func NewWalletAuthController(walletAuthService service.WalletAuthService) *Walle
 }
 
 // GenerateLoginMessage 生成登录消息和随机数
+// @Summary 获取登录消息
+// @Description 生成待钱包签名的登录消息和随机数
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.LoginMessageRequest true "获取登录消息请求"
+// @Success 200 {object} response.LoginMessageResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/login/message [post]
 func (c *WalletAuthController) GenerateLoginMessage(ctx *gin.Context) {
-	var request struct {
-		WalletAddress string `json:"wallet_address" binding:"required"`
-	}
+	var req request.LoginMessageRequest
 
-	if err := ctx.ShouldBindJSON(&request); err != nil {
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
 	// 生成登录消息和随机数
-	message, nonce, err := c.walletAuthService.GenerateLoginMessage(ctx, request.WalletAddress)
+	message, nonce, err := c.walletAuthService.GenerateLoginMessage(ctx, req.WalletAddress)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{
-		"message": message,
-		"nonce":   nonce,
+	ctx.JSON(http.StatusOK, response.LoginMessageResponse{
+		Message: message,
+		Nonce:   nonce,
 	})
 }
 
 // VerifySignatureAndLogin 验证签名并登录
+// @Summary 签名登录
+// @Description 验证钱包签名并创建会话
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.LoginRequest true "签名登录请求"
+// @Success 200 {object} response.LoginResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/v1/login [post]
 func (c *WalletAuthController) VerifySignatureAndLogin(ctx *gin.Context) {
-	var request struct {
-		WalletAddress string `json:"wallet_address" binding:"required"`
-		Signature     string `json:"signature" binding:"required"`
-		Nonce         string `json:"nonce" binding:"required"`
-	}
+	var req request.LoginRequest
 
-	if err := ctx.ShouldBindJSON(&request); err != nil {
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -63,7 +79,7 @@ func (c *WalletAuthController) VerifySignatureAndLogin(ctx *gin.Context) {
 
 	// 验证签名并登录
 	result, err := c.walletAuthService.VerifySignatureAndLogin(ctx,
-		request.WalletAddress, request.Signature, request.Nonce, ipAddress, userAgent)
+		req.WalletAddress, req.Signature, req.Nonce, ipAddress, userAgent)
 
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
@@ -73,15 +89,24 @@ func (c *WalletAuthController) VerifySignatureAndLogin(ctx *gin.Context) {
 	// 设置会话Cookie（可选）
 	c.setSessionCookie(ctx, result.SessionToken)
 
-	ctx.JSON(http.StatusOK, gin.H{
-		"user_id":        result.UserID,
-		"wallet_address": result.WalletAddress,
-		"session_token":  result.SessionToken,
-		"expires_at":     result.ExpiresAt,
+	ctx.JSON(http.StatusOK, response.LoginResponse{
+		UserID:        result.UserID,
+		WalletAddress: result.WalletAddress,
+		SessionToken:  result.SessionToken,
+		ExpiresAt:     result.ExpiresAt,
 	})
 }
 
 // Logout 注销
+// @Summary 注销
+// @Description 吊销当前会话令牌
+// @Tags auth
+// @Produce json
+// @Param Authorization header string false "Bearer 会话令牌"
+// @Success 200 {object} response.MessageResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/logout [post]
 func (c *WalletAuthController) Logout(ctx *gin.Context) {
 	// 从请求头或Cookie获取会话令牌
 	token := c.getSessionToken(ctx)
@@ -99,7 +124,7 @@ func (c *WalletAuthController) Logout(ctx *gin.Context) {
 	// 清除会话Cookie（如果有）
 	ctx.SetCookie("session_token", "", -1, "/", "", false, true)
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "注销成功"})
+	ctx.JSON(http.StatusOK, response.MessageResponse{Message: "注销成功"})
 }
 
 // AuthMiddleware 认证中间件
@@ -127,6 +152,19 @@ func (c *WalletAuthController) AuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+// OptionalAuthMiddleware 可选认证中间件, 携带有效会话令牌时写入用户信息, 否则直接放行
+func (c *WalletAuthController) OptionalAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if token := c.getSessionToken(ctx); token != "" {
+			if session, err := c.walletAuthService.VerifySessionToken(ctx, token); err == nil {
+				ctx.Set("user_id", session.UserID)
+				ctx.Set("wallet_address", session.WalletAddress)
+			}
+		}
+		ctx.Next()
+	}
+}
+
 // 获取会话令牌
 func (c *WalletAuthController) getSessionToken(ctx *gin.Context) string {
 	// 优先从请求头获取
@@ -159,7 +197,7 @@ func (c *WalletAuthController) setSessionCookie(ctx *gin.Context, token string)
 }
 
 // 注册路由
-func (c *WalletAuthController) RegisterRoutes(r *gin.Engine) {
+func (c *WalletAuthController) RegisterRoutes(r gin.IRouter) {
 	r.POST("/login/message", c.GenerateLoginMessage)
 	r.POST("/login", c.VerifySignatureAndLogin)
 	r.POST("/logout", c.Logout)
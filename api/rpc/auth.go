@@ -0,0 +1,132 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"MetaFarmBackend/component/apikey"
+	"MetaFarmBackend/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationKey = "authorization"
+	apiKeyKey        = "x-api-key"
+)
+
+// 仅允许API Key调用的方法
+var apiKeyOnlyMethods = map[string]bool{
+	"/metafarm.v1.SessionService/VerifySession": true,
+}
+
+// 无需认证的方法前缀
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+}
+
+// caller 当前调用方, 会话调用方只能以自己的钱包地址操作, API Key调用方可代任意玩家操作
+type caller struct {
+	UserID        uint64
+	WalletAddress string
+	Client        string
+}
+
+type callerKey struct{}
+
+// userAddress 返回本次操作的用户地址: 会话调用方固定为会话钱包地址, API Key调用方使用请求中的地址
+func (c *caller) userAddress(requested string) string {
+	if c != nil && c.WalletAddress != "" {
+		return c.WalletAddress
+	}
+	return requested
+}
+
+func callerFrom(ctx context.Context) *caller {
+	c, _ := ctx.Value(callerKey{}).(*caller)
+	return c
+}
+
+// Authenticator 复用HTTP接口的会话令牌与API Key校验
+type Authenticator struct {
+	sessions service.WalletAuthService
+	keys     *apikey.Verifier
+}
+
+// NewAuthenticator 创建认证器
+func NewAuthenticator(sessions service.WalletAuthService, keys *apikey.Verifier) *Authenticator {
+	return &Authenticator{sessions: sessions, keys: keys}
+}
+
+// UnaryInterceptor 一元调用认证拦截器
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor 流式调用认证拦截器
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate 优先校验API Key, 其次校验会话令牌, 通过后将调用方写入上下文
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if key := first(md, apiKeyKey); key != "" {
+		name, ok := a.keys.Verify(key)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "无效的API Key")
+		}
+		return context.WithValue(ctx, callerKey{}, &caller{Client: name}), nil
+	}
+	if apiKeyOnlyMethods[method] {
+		return nil, status.Error(codes.PermissionDenied, "该接口仅允许API Key调用")
+	}
+
+	token := strings.TrimPrefix(first(md, authorizationKey), "Bearer ")
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "未授权")
+	}
+	session, err := a.sessions.VerifySessionToken(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "未授权")
+	}
+	return context.WithValue(ctx, callerKey{}, &caller{UserID: session.UserID, WalletAddress: session.WalletAddress}), nil
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// authenticatedStream 携带认证后上下文的ServerStream
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
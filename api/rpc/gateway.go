@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"MetaFarmBackend/api/rpc/pb"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewGateway 创建grpc-gateway, 将/rpc/v1下的JSON请求转发到本机gRPC服务
+// Authorization请求头由gateway原样转发, X-API-Key转换为x-api-key元数据
+func NewGateway(ctx context.Context, port string) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
+	endpoint := gatewayEndpoint(port)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if err := pb.RegisterLandServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, errors.Wrap(err, "注册土地服务网关失败")
+	}
+	if err := pb.RegisterSessionServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, errors.Wrap(err, "注册会话服务网关失败")
+	}
+	return mux, nil
+}
+
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, apiKeyKey) {
+		return apiKeyKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayEndpoint 监听地址未指定主机时通过回环地址访问
+func gatewayEndpoint(port string) string {
+	addr := ListenAddr(port)
+	if strings.HasPrefix(addr, ":") {
+		return "127.0.0.1" + addr
+	}
+	return addr
+}
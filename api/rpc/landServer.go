@@ -0,0 +1,866 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/api/rpc/pb"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/pagination"
+	"MetaFarmBackend/dao"
+	"MetaFarmBackend/service"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// landServer gRPC土地服务, 请求转换为api/request后复用HTTP接口的参数校验与LandService
+type landServer struct {
+	pb.UnimplementedLandServiceServer
+	landService    service.LandService
+	catalogService service.CatalogService
+}
+
+// 构造函数
+func newLandServer(landService service.LandService, catalogService service.CatalogService) *landServer {
+	return &landServer{landService: landService, catalogService: catalogService}
+}
+
+// ListUserLands 分页获取用户拥有的土地
+func (s *landServer) ListUserLands(ctx context.Context, in *pb.ListUserLandsRequest) (*pb.ListUserLandsResponse, error) {
+	userAddress := callerFrom(ctx).userAddress(in.GetUserAddress())
+	if userAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少用户地址")
+	}
+	req := request.ListUserLandsRequest{
+		PageRequest: toPageRequest(in.GetPage()),
+		LandType:    toInt8(in.LandType),
+		Rarity:      toInt8(in.Rarity),
+		MinLevel:    toInt8(in.MinLevel),
+		MaxLevel:    toInt8(in.MaxLevel),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	lands, page, err := s.landService.GetUserLands(ctx, userAddress, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListUserLandsResponse{Page: toPageInfo(page)}
+	for _, land := range lands {
+		resp.Lands = append(resp.Lands, toLandDetail(land))
+	}
+	return resp, nil
+}
+
+// GetLandDetail 获取土地详情
+func (s *landServer) GetLandDetail(ctx context.Context, in *pb.GetLandDetailRequest) (*pb.LandDetail, error) {
+	if in.GetLandTokenId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少土地TokenID")
+	}
+	detail, err := s.landService.GetLandDetail(ctx, in.GetLandTokenId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandDetail(detail.Land), nil
+}
+
+// ListZoneOccupancy 获取土地各分区的占用面积与剩余面积
+func (s *landServer) ListZoneOccupancy(ctx context.Context, in *pb.GetLandDetailRequest) (*pb.ListZoneOccupancyResponse, error) {
+	if in.GetLandTokenId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少土地TokenID")
+	}
+	zones, err := s.landService.GetZoneOccupancy(ctx, in.GetLandTokenId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListZoneOccupancyResponse{Zones: make([]*pb.ZoneOccupancy, 0, len(zones))}
+	for _, zone := range zones {
+		resp.Zones = append(resp.Zones, &pb.ZoneOccupancy{
+			ZoneId:       zone.ZoneID,
+			ZoneType:     int32(zone.ZoneType),
+			Area:         int32(zone.Area),
+			OccupiedArea: int32(zone.OccupiedArea),
+			FreeArea:     int32(zone.FreeArea),
+		})
+	}
+	return resp, nil
+}
+
+// ListLandHistory 分页获取土地历史时间线
+func (s *landServer) ListLandHistory(ctx context.Context, in *pb.ListLandHistoryRequest) (*pb.ListLandHistoryResponse, error) {
+	if in.GetLandTokenId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少土地TokenID")
+	}
+	req := toPageRequest(in.GetPage())
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	entries, page, err := s.landService.GetLandHistory(ctx, in.GetLandTokenId(), req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListLandHistoryResponse{Page: toPageInfo(page)}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.LandHistoryEntry{
+			Kind:         entry.Kind,
+			RefId:        entry.RefID,
+			EventTime:    timestamppb.New(entry.EventTime),
+			Actor:        entry.Actor,
+			Counterparty: entry.Counterparty,
+			Amount:       entry.Amount,
+			Status:       int32(entry.Status),
+			OldLevel:     int32(entry.OldLevel),
+			NewLevel:     int32(entry.NewLevel),
+			Subject:      entry.Subject,
+		})
+	}
+	return resp, nil
+}
+
+// UpgradeLand 升级土地
+func (s *landServer) UpgradeLand(ctx context.Context, in *pb.UpgradeLandRequest) (*pb.LandUpgrade, error) {
+	req := request.UpgradeLandRequest{
+		LandTokenID: in.GetLandTokenId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+		Level:       int8(in.GetLevel()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	upgrade, err := s.landService.UpgradeLand(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandUpgrade(upgrade), nil
+}
+
+// SpeedUpUpgrade 加速升级施工
+func (s *landServer) SpeedUpUpgrade(ctx context.Context, in *pb.SpeedUpUpgradeRequest) (*pb.LandUpgrade, error) {
+	req := request.SpeedUpUpgradeRequest{
+		UpgradeID:   in.GetUpgradeId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+		ItemTokenID: in.ItemTokenId,
+		Uses:        int(in.GetUses()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	upgrade, err := s.landService.SpeedUpUpgrade(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandUpgrade(upgrade), nil
+}
+
+// CancelUpgrade 取消升级施工
+func (s *landServer) CancelUpgrade(ctx context.Context, in *pb.CancelUpgradeRequest) (*pb.LandUpgrade, error) {
+	req := request.CancelUpgradeRequest{
+		UpgradeID:   in.GetUpgradeId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	upgrade, err := s.landService.CancelUpgrade(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandUpgrade(upgrade), nil
+}
+
+// ListUpgradeQueue 获取升级施工队列
+func (s *landServer) ListUpgradeQueue(ctx context.Context, in *pb.ListUpgradeQueueRequest) (*pb.ListUpgradeQueueResponse, error) {
+	userAddress := callerFrom(ctx).userAddress(in.GetUserAddress())
+	if userAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少用户地址")
+	}
+	upgrades, err := s.landService.GetUpgradeQueue(ctx, userAddress)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListUpgradeQueueResponse{}
+	for _, upgrade := range upgrades {
+		resp.Upgrades = append(resp.Upgrades, toLandUpgrade(upgrade))
+	}
+	return resp, nil
+}
+
+// CreateRental 创建土地租赁订单
+func (s *landServer) CreateRental(ctx context.Context, in *pb.CreateRentRequest) (*pb.RentLandResponse, error) {
+	req := request.CreateRentRequest{
+		LandTokenID:    in.GetLandTokenId(),
+		RenterAddress:  in.GetRenterAddress(),
+		RentalDuration: int(in.GetRentalDuration()),
+		RentPerSqm:     in.GetRentPerSqm(),
+		UserAddress:    callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	rental, err := s.landService.CreateRental(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RentLandResponse{
+		RentalId:        rental.ID,
+		LandTokenId:     rental.LandTokenID,
+		TotalRent:       rental.TotalRent,
+		RentalStartTime: timestamppb.New(rental.RentalStartTime),
+		RentalEndTime:   timestamppb.New(rental.RentalEndTime),
+	}, nil
+}
+
+// ListRentals 分页获取租客的活跃租赁订单
+func (s *landServer) ListRentals(ctx context.Context, in *pb.ListRentLandsRequest) (*pb.ListRentalsResponse, error) {
+	userAddress := callerFrom(ctx).userAddress(in.GetUserAddress())
+	if userAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少用户地址")
+	}
+	req := request.ListRentLandsRequest{
+		PageRequest: toPageRequest(in.GetPage()),
+		LandTokenID: in.LandTokenId,
+	}
+	if in.RentalDuration != nil {
+		duration := int(in.GetRentalDuration())
+		req.RentalDuration = &duration
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	rentals, page, err := s.landService.GetActiveRentals(ctx, userAddress, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListRentalsResponse{Page: toPageInfo(page)}
+	for _, rental := range rentals {
+		resp.Rentals = append(resp.Rentals, &pb.LandRental{
+			Id:               rental.ID,
+			LandTokenId:      rental.LandTokenID,
+			OwnerAddress:     rental.OwnerAddress,
+			RenterAddress:    rental.RenterAddress,
+			RentalDuration:   int32(rental.RentalDuration),
+			RentPerSqmPerDay: rental.RentPerSqmPerDay,
+			TotalRent:        rental.TotalRent,
+			SystemFee:        rental.SystemFee,
+			Status:           int32(rental.Status),
+			RentalStartTime:  timestamppb.New(rental.RentalStartTime),
+			RentalEndTime:    timestamppb.New(rental.RentalEndTime),
+		})
+	}
+	return resp, nil
+}
+
+// CancelRental 取消土地租赁
+func (s *landServer) CancelRental(ctx context.Context, in *pb.CancelRentalRequest) (*pb.MessageResponse, error) {
+	req := request.CancelRentalRequest{
+		RentalID:    in.GetRentalId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	if err := s.landService.CancelRental(ctx, req); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.MessageResponse{Message: "租赁取消成功"}, nil
+}
+
+// ListMarketLands 分页获取待出售的土地挂牌
+func (s *landServer) ListMarketLands(ctx context.Context, in *pb.ListMarketLandsRequest) (*pb.ListMarketLandsResponse, error) {
+	req := request.ListMarketLandsRequest{
+		PageRequest: toPageRequest(in.GetPage()),
+		MinPrice:    in.MinPrice,
+		MaxPrice:    in.MaxPrice,
+		MinArea:     toInt(in.MinArea),
+		MaxArea:     toInt(in.MaxArea),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	listings, page, err := s.landService.GetMarketListings(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListMarketLandsResponse{Page: toPageInfo(page)}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, &pb.LandListing{
+			Id:            listing.ID,
+			LandTokenId:   listing.LandTokenID,
+			SellerAddress: listing.SellerAddress,
+			Area:          int32(listing.Area),
+			Price:         listing.Price,
+			ListingTime:   timestamppb.New(listing.ListingTime),
+		})
+	}
+	return resp, nil
+}
+
+// SearchLands 按属性、出售及租赁状态分页搜索全部土地
+func (s *landServer) SearchLands(ctx context.Context, in *pb.SearchLandsRequest) (*pb.SearchLandsResponse, error) {
+	req := request.SearchLandsRequest{
+		PageRequest:   toPageRequest(in.GetPage()),
+		LandType:      toInt8(in.LandType),
+		Rarity:        toInt8(in.Rarity),
+		MinLevel:      toInt8(in.MinLevel),
+		MaxLevel:      toInt8(in.MaxLevel),
+		MinFertility:  toInt(in.MinFertility),
+		MaxFertility:  toInt(in.MaxFertility),
+		MinArea:       toInt(in.MinArea),
+		MaxArea:       toInt(in.MaxArea),
+		SpecialEffect: in.SpecialEffect,
+		ForSale:       in.ForSale,
+		ForRent:       in.ForRent,
+		MinPrice:      in.MinPrice,
+		MaxPrice:      in.MaxPrice,
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	results, facets, page, err := s.landService.SearchLands(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.SearchLandsResponse{Page: toPageInfo(page)}
+	for _, r := range results {
+		item := &pb.LandSearchItem{
+			Land:   toLandDetail(&r.LandInfo),
+			Price:  r.ListingPrice,
+			Rented: r.Rented,
+		}
+		if r.ListingID != nil {
+			item.MarketId = *r.ListingID
+		}
+		resp.Lands = append(resp.Lands, item)
+	}
+	if facets != nil {
+		resp.Facets = &pb.LandFacets{
+			Rarity:   toFacetCounts(facets.Rarity),
+			LandType: toFacetCounts(facets.LandType),
+		}
+	}
+	return resp, nil
+}
+
+// CreateMarketListing 创建土地挂牌
+func (s *landServer) CreateMarketListing(ctx context.Context, in *pb.CreateMarketListingRequest) (*pb.MessageResponse, error) {
+	req := request.CreateMarketListingRequest{
+		TokenID:       in.GetTokenId(),
+		SellerAddress: callerFrom(ctx).userAddress(in.GetSellerAddress()),
+		Price:         in.GetPrice(),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	if err := s.landService.CreateMarketListing(ctx, req); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.MessageResponse{Message: "土地挂牌成功"}, nil
+}
+
+// BuyLand 购买土地
+func (s *landServer) BuyLand(ctx context.Context, in *pb.BuyLandRequest) (*pb.MessageResponse, error) {
+	req := request.BuyLandRequest{
+		MarketID:     in.GetMarketId(),
+		ListingID:    in.GetListingId(),
+		BuyerAddress: callerFrom(ctx).userAddress(in.GetBuyerAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	if err := s.landService.BuyLand(ctx, req); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.MessageResponse{Message: "土地购买成功"}, nil
+}
+
+// toLayoutRequest 将更新布局请求转换为服务层请求
+func toLayoutRequest(ctx context.Context, in *pb.UpdateLandLayoutRequest) request.UpdateLandLayoutRequest {
+	req := request.UpdateLandLayoutRequest{
+		TokenID:     in.GetTokenId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+		Zones:       make([]request.LayoutZoneReq, 0, len(in.GetZones())),
+	}
+	for _, zone := range in.GetZones() {
+		req.Zones = append(req.Zones, request.LayoutZoneReq{
+			ID:        zone.GetId(),
+			Area:      int(zone.GetArea()),
+			ZoneType:  int8(zone.GetZoneType()),
+			PositionX: int(zone.GetPositionX()),
+			PositionY: int(zone.GetPositionY()),
+			Width:     int(zone.GetWidth()),
+			Height:    int(zone.GetHeight()),
+		})
+	}
+	return req
+}
+
+// UpdateLayout 更新土地布局
+func (s *landServer) UpdateLayout(ctx context.Context, in *pb.UpdateLandLayoutRequest) (*pb.LandLayout, error) {
+	req := toLayoutRequest(ctx, in)
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	layouts, err := s.landService.UpdateLandLayout(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandLayout(req.TokenID, layouts), nil
+}
+
+// PreviewLayout 预览布局调整后的分区相邻加成, 不保存
+func (s *landServer) PreviewLayout(ctx context.Context, in *pb.UpdateLandLayoutRequest) (*pb.LandLayout, error) {
+	req := toLayoutRequest(ctx, in)
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	layouts, err := s.landService.PreviewLandLayout(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandLayout(req.TokenID, layouts), nil
+}
+
+// GetLayout 获取土地的分区布局
+func (s *landServer) GetLayout(ctx context.Context, in *pb.GetLandDetailRequest) (*pb.LandLayout, error) {
+	if in.GetLandTokenId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少土地TokenID")
+	}
+	layouts, err := s.landService.GetLandLayout(ctx, in.GetLandTokenId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandLayout(in.GetLandTokenId(), layouts), nil
+}
+
+// FertilizeLand 使用肥料恢复土地肥力
+func (s *landServer) FertilizeLand(ctx context.Context, in *pb.FertilizeLandRequest) (*pb.LandDetail, error) {
+	req := request.FertilizeLandRequest{
+		LandTokenID: in.GetLandTokenId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+		ItemTokenID: in.GetItemTokenId(),
+		Uses:        int(in.GetUses()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	land, err := s.landService.FertilizeLand(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toLandDetail(land), nil
+}
+
+// ListCatalog 获取上架的作物/动物目录
+func (s *landServer) ListCatalog(ctx context.Context, in *pb.ListCatalogRequest) (*pb.ListCatalogResponse, error) {
+	req := request.ListCatalogRequest{Kind: toInt8(in.Kind)}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	items, err := s.catalogService.ListCatalog(ctx, req.Kind, false)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListCatalogResponse{}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &pb.CropAnimal{
+			Id:              item.ID,
+			Name:            item.Name,
+			Kind:            int32(item.Kind),
+			GrowthMinutes:   int32(item.GrowthMinutes),
+			FertilityPerSqm: int32(item.FertilityPerSqm),
+			BaseYield:       item.BaseYield,
+			LandTypes:       toInt32s(dao.ParseInt8List(item.LandTypes)),
+			ZoneTypes:       toInt32s(dao.ParseInt8List(item.ZoneTypes)),
+			Seasons:         toInt32s(dao.ParseInt8List(item.Seasons)),
+			UnlockLevel:     int32(item.UnlockLevel),
+			WitherMinutes:   int32(item.WitherMinutes),
+			WitherRefund:    item.WitherRefund,
+			ProductName:     item.ProductName,
+			CycleMinutes:    int32(item.CycleMinutes),
+			FeedCropIds:     dao.ParseIDList(item.FeedCropIDs),
+			OffspringArea:   int32(item.OffspringArea),
+		})
+	}
+	return resp, nil
+}
+
+// PlantCrop 种植作物
+func (s *landServer) PlantCrop(ctx context.Context, in *pb.PlantCropRequest) (*pb.PlantCropResponse, error) {
+	req := request.PlantCropRequest{
+		LandTokenID:  in.GetLandTokenId(),
+		ZoneID:       in.GetZoneId(),
+		CropAnimalID: in.GetCropAnimalId(),
+		UserAddress:  callerFrom(ctx).userAddress(in.GetUserAddress()),
+		Area:         int(in.GetArea()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	activity, err := s.landService.PlantCrop(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.PlantCropResponse{
+		ActivityId:      activity.ID,
+		ActivityType:    int32(activity.ActivityType),
+		CropAnimalId:    activity.CropAnimalID,
+		CropAnimalName:  activity.CropAnimalName,
+		Area:            int32(activity.Area),
+		FertilityCost:   int32(activity.FertilityCost),
+		StartTime:       timestamppb.New(activity.Start_time),
+		ExpectedEndTime: timestamppb.New(activity.ExpectedEndTime),
+	}, nil
+}
+
+// HarvestCrop 收获作物
+func (s *landServer) HarvestCrop(ctx context.Context, in *pb.HarvestCropRequest) (*pb.HarvestCropResponse, error) {
+	req := request.HarvestCropRequest{
+		ActivityID:  in.GetActivityId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	result, err := s.landService.HarvestCrop(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.HarvestCropResponse{
+		ActivityId:   result.Activity.ID,
+		CropAnimalId: result.Activity.CropAnimalID,
+		CropName:     result.Activity.CropAnimalName,
+		Yield:        int32(result.Yield),
+		Experience:   result.Experience,
+	}, nil
+}
+
+// CarePlot 浇水、施肥或除虫
+func (s *landServer) CarePlot(ctx context.Context, in *pb.CarePlotRequest) (*pb.Plot, error) {
+	req := request.CarePlotRequest{
+		ActivityID:  in.GetActivityId(),
+		ItemTokenID: in.GetItemTokenId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+		Action:      in.GetAction(),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	plot, err := s.landService.CarePlot(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.Plot{
+		ActivityId:      plot.ActivityID,
+		LandTokenId:     plot.LandTokenID,
+		ZoneId:          plot.ZoneID,
+		CropName:        plot.CropName,
+		WaterLevel:      int32(plot.WaterLevel),
+		FertilizerLevel: int32(plot.FertilizerLevel),
+		PestLevel:       int32(plot.PestLevel),
+		ExpectedYield:   int32(plot.ExpectedYield),
+		Harvestable:     plot.IsHarvestable == 1,
+	}, nil
+}
+
+// ClearDeadCrops 清理枯萎作物
+func (s *landServer) ClearDeadCrops(ctx context.Context, in *pb.ClearDeadCropsRequest) (*pb.ClearDeadCropsResponse, error) {
+	req := request.ClearDeadCropsRequest{
+		LandTokenID: in.GetLandTokenId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	cleared, err := s.landService.ClearDeadCrops(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ClearDeadCropsResponse{Cleared: cleared}, nil
+}
+
+// BatchHarvest 一键收获
+func (s *landServer) BatchHarvest(ctx context.Context, in *pb.BatchHarvestRequest) (*pb.BatchFarmResponse, error) {
+	req := request.BatchHarvestRequest{
+		Replant:     in.GetReplant(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	result, err := s.landService.HarvestAll(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toBatchFarmResponse(result), nil
+}
+
+// BatchReplant 批量补种
+func (s *landServer) BatchReplant(ctx context.Context, in *pb.BatchReplantRequest) (*pb.BatchFarmResponse, error) {
+	req := request.BatchReplantRequest{
+		ActivityIDs: in.GetActivityIds(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	result, err := s.landService.ReplantCrops(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toBatchFarmResponse(result), nil
+}
+
+// toBatchFarmResponse 将批量操作结果转换为gRPC消息, 统计成功与失败的项数
+func toBatchFarmResponse(result *service.BatchResult) *pb.BatchFarmResponse {
+	resp := &pb.BatchFarmResponse{HasMore: result.HasMore, Items: make([]*pb.BatchFarmItem, 0, len(result.Items))}
+	for _, item := range result.Items {
+		entry := &pb.BatchFarmItem{
+			ActivityId:    item.ActivityID,
+			LandTokenId:   item.LandTokenID,
+			CropName:      item.CropName,
+			Harvested:     item.Harvested,
+			Yield:         int32(item.Yield),
+			Experience:    item.Experience,
+			Replanted:     item.Replanted,
+			NewActivityId: item.NewActivityID,
+		}
+		if item.Err != nil {
+			entry.Error = item.Err.Error()
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Items = append(resp.Items, entry)
+	}
+	return resp
+}
+
+// toAnimal 将养殖动物转换为gRPC消息
+func toAnimal(animal *dao.BreedingAnimal) *pb.Animal {
+	return &pb.Animal{
+		ActivityId:       animal.ActivityID,
+		LandTokenId:      animal.LandTokenID,
+		ZoneId:           animal.ZoneID,
+		AnimalId:         animal.AnimalID,
+		AnimalName:       animal.AnimalName,
+		Health:           int32(animal.Health),
+		StarveTime:       timestamppb.New(animal.StarveTime),
+		NextCollectTime:  timestamppb.New(animal.NextCollectTime),
+		CollectCount:     int32(animal.CollectCount),
+		ParentActivityId: animal.ParentActivityID,
+		Generation:       int32(animal.Generation),
+	}
+}
+
+// ListLandAnimals 获取土地上存活的养殖动物
+func (s *landServer) ListLandAnimals(ctx context.Context, in *pb.GetLandDetailRequest) (*pb.ListLandAnimalsResponse, error) {
+	if in.GetLandTokenId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少土地TokenID")
+	}
+	animals, err := s.landService.GetLandAnimals(ctx, in.GetLandTokenId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListLandAnimalsResponse{}
+	for _, animal := range animals {
+		resp.Animals = append(resp.Animals, toAnimal(animal))
+	}
+	return resp, nil
+}
+
+// FeedAnimal 喂养动物
+func (s *landServer) FeedAnimal(ctx context.Context, in *pb.FeedAnimalRequest) (*pb.Animal, error) {
+	req := request.FeedAnimalRequest{
+		ActivityID:  in.GetActivityId(),
+		ItemTokenID: in.GetItemTokenId(),
+		FeedCropID:  in.GetFeedCropId(),
+		Quantity:    int(in.GetQuantity()),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	animal, err := s.landService.FeedAnimal(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toAnimal(animal), nil
+}
+
+// CollectAnimalProducts 收集动物产物
+func (s *landServer) CollectAnimalProducts(ctx context.Context, in *pb.AnimalActionRequest) (*pb.CollectProductsResponse, error) {
+	req := request.AnimalActionRequest{
+		ActivityID:  in.GetActivityId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	result, err := s.landService.CollectAnimalProducts(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CollectProductsResponse{
+		Animal:      toAnimal(result.Animal),
+		ProductName: result.ProductName,
+		Yield:       int32(result.Yield),
+		Experience:  result.Experience,
+	}, nil
+}
+
+// BreedAnimal 繁殖动物
+func (s *landServer) BreedAnimal(ctx context.Context, in *pb.AnimalActionRequest) (*pb.BreedAnimalResponse, error) {
+	req := request.AnimalActionRequest{
+		ActivityID:  in.GetActivityId(),
+		UserAddress: callerFrom(ctx).userAddress(in.GetUserAddress()),
+	}
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	result, err := s.landService.BreedAnimal(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.BreedAnimalResponse{Parent: toAnimal(result.Parent), Offspring: toAnimal(result.Offspring)}, nil
+}
+
+// validate 按api/request上的binding标签校验, 与HTTP接口保持一致
+func validate(req interface{}) error {
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// toStatus 将业务错误转换为gRPC状态码
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, pagination.ErrInvalidQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUpgradeNotAllowed), errors.Is(err, service.ErrZoneLocked), errors.Is(err, service.ErrInsufficientAssets),
+		errors.Is(err, service.ErrFertilizeNotAllowed), errors.Is(err, service.ErrPlantNotAllowed), errors.Is(err, service.ErrHarvestNotAllowed), errors.Is(err, service.ErrCareNotAllowed), errors.Is(err, service.ErrLayoutInvalid),
+		errors.Is(err, service.ErrBreedingNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger.Errorf("gRPC调用失败: %v", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func toPageRequest(in *pb.PageRequest) request.PageRequest {
+	return request.PageRequest{
+		Cursor: in.GetCursor(),
+		Limit:  int(in.GetLimit()),
+		Sort:   in.GetSort(),
+		Order:  in.GetOrder(),
+	}
+}
+
+func toPageInfo(page *pagination.Result) *pb.PageInfo {
+	return &pb.PageInfo{NextCursor: page.NextCursor, HasMore: page.HasMore}
+}
+
+func toLandLayout(tokenID string, layouts []*dao.LandLayout) *pb.LandLayout {
+	resp := &pb.LandLayout{LandTokenId: tokenID, Zones: make([]*pb.LayoutZone, 0, len(layouts))}
+	for _, layout := range layouts {
+		resp.Zones = append(resp.Zones, &pb.LayoutZone{
+			Id:               layout.ID,
+			Area:             int32(layout.Area),
+			ZoneType:         int32(layout.ZoneType),
+			PositionX:        int32(layout.PositionX),
+			PositionY:        int32(layout.PositionY),
+			Width:            int32(layout.Width),
+			Height:           int32(layout.Height),
+			HasAdjacentBonus: layout.HasAdjacentBonus,
+			BonusType:        int32(layout.BonusType),
+			BonusValue:       layout.BonusValue,
+		})
+	}
+	return resp
+}
+
+func toLandDetail(land *dao.LandInfo) *pb.LandDetail {
+	return &pb.LandDetail{
+		LandTokenId:         land.LandTokenID,
+		OwnerAddress:        land.OwnerAddress,
+		LandType:            int32(land.LandType),
+		Rarity:              int32(land.Rarity),
+		Area:                int32(land.Area),
+		Level:               int32(land.Level),
+		Fertility:           int32(land.Fertility),
+		SpecialEffect:       land.SpecialEffect,
+		LastHarvestTime:     toTimestamp(land.LastHarvestTime),
+		MetadataUri:         land.MetadataURI,
+		FertilityCap:        int32(land.FertilityCap),
+		YieldMultiplier:     land.YieldMultiplier,
+		UnlockedZones:       toInt32s(land.Zones()),
+		UpgradeCompleteTime: toTimestamp(land.UpgradeCompleteTime),
+	}
+}
+
+func toLandUpgrade(upgrade *dao.LandUpgrade) *pb.LandUpgrade {
+	return &pb.LandUpgrade{
+		Id:           upgrade.ID,
+		LandTokenId:  upgrade.LandTokenID,
+		OwnerAddress: upgrade.OwnerAddress,
+		OldLevel:     int32(upgrade.OldLevel),
+		NewLevel:     int32(upgrade.NewLevel),
+		CostTokens:   upgrade.CostTokens,
+		RefundTokens: upgrade.RefundTokens,
+		Status:       int32(upgrade.Status),
+		UpgradeTime:  timestamppb.New(upgrade.UpgradeTime),
+		CompleteTime: toTimestamp(upgrade.CompleteTime),
+		FinishTime:   toTimestamp(upgrade.FinishTime),
+	}
+}
+
+func toInt32s(values []int8) []int32 {
+	var out []int32
+	for _, v := range values {
+		out = append(out, int32(v))
+	}
+	return out
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toFacetCounts(counts []dao.FacetCount) []*pb.FacetCount {
+	var out []*pb.FacetCount
+	for _, c := range counts {
+		out = append(out, &pb.FacetCount{Value: int32(c.Value), Count: c.Count})
+	}
+	return out
+}
+
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	n := int8(*v)
+	return &n
+}
+
+func toInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
@@ -0,0 +1,5338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: metafarm.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PageRequest 游标分页参数
+type PageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上一页返回的next_cursor, 首页为空
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数, 默认20, 最大100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 排序字段
+	Sort string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// 排序方向(asc/desc)
+	Order         string `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_metafarm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PageRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *PageRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+// PageInfo 分页结果
+type PageInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 下一页游标, 为空表示没有更多数据
+	NextCursor string `protobuf:"bytes,1,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 是否还有更多数据
+	HasMore       bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_metafarm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageInfo) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// MessageResponse 通用操作结果
+type MessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 操作结果描述
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	mi := &file_metafarm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// LandDetail 土地详情
+type LandDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 所有者钱包地址
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// 地形类型(0-平原,1-湿地,2-山地)
+	LandType int32 `protobuf:"varint,3,opt,name=land_type,json=landType,proto3" json:"land_type,omitempty"`
+	// 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	Rarity int32 `protobuf:"varint,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	// 土地面积(㎡)
+	Area int32 `protobuf:"varint,5,opt,name=area,proto3" json:"area,omitempty"`
+	// 土地等级(1-10)
+	Level int32 `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	// 土地肥力(0-肥力上限)
+	Fertility int32 `protobuf:"varint,7,opt,name=fertility,proto3" json:"fertility,omitempty"`
+	// 特殊效果描述
+	SpecialEffect string `protobuf:"bytes,8,opt,name=special_effect,json=specialEffect,proto3" json:"special_effect,omitempty"`
+	// 最后收获时间
+	LastHarvestTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_harvest_time,json=lastHarvestTime,proto3" json:"last_harvest_time,omitempty"`
+	// 元数据URI
+	MetadataUri string `protobuf:"bytes,10,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	// 肥力上限, 随等级提升
+	FertilityCap int32 `protobuf:"varint,11,opt,name=fertility_cap,json=fertilityCap,proto3" json:"fertility_cap,omitempty"`
+	// 产量倍率, 随等级提升
+	YieldMultiplier float64 `protobuf:"fixed64,12,opt,name=yield_multiplier,json=yieldMultiplier,proto3" json:"yield_multiplier,omitempty"`
+	// 已解锁的分区类型(0-种植区,1-养殖区,2-装饰区)
+	UnlockedZones []int32 `protobuf:"varint,13,rep,packed,name=unlocked_zones,json=unlockedZones,proto3" json:"unlocked_zones,omitempty"`
+	// 升级施工完成时间, 为空表示未在施工
+	UpgradeCompleteTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=upgrade_complete_time,json=upgradeCompleteTime,proto3" json:"upgrade_complete_time,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LandDetail) Reset() {
+	*x = LandDetail{}
+	mi := &file_metafarm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandDetail) ProtoMessage() {}
+
+func (x *LandDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandDetail.ProtoReflect.Descriptor instead.
+func (*LandDetail) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LandDetail) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *LandDetail) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *LandDetail) GetLandType() int32 {
+	if x != nil {
+		return x.LandType
+	}
+	return 0
+}
+
+func (x *LandDetail) GetRarity() int32 {
+	if x != nil {
+		return x.Rarity
+	}
+	return 0
+}
+
+func (x *LandDetail) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *LandDetail) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *LandDetail) GetFertility() int32 {
+	if x != nil {
+		return x.Fertility
+	}
+	return 0
+}
+
+func (x *LandDetail) GetSpecialEffect() string {
+	if x != nil {
+		return x.SpecialEffect
+	}
+	return ""
+}
+
+func (x *LandDetail) GetLastHarvestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHarvestTime
+	}
+	return nil
+}
+
+func (x *LandDetail) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
+func (x *LandDetail) GetFertilityCap() int32 {
+	if x != nil {
+		return x.FertilityCap
+	}
+	return 0
+}
+
+func (x *LandDetail) GetYieldMultiplier() float64 {
+	if x != nil {
+		return x.YieldMultiplier
+	}
+	return 0
+}
+
+func (x *LandDetail) GetUnlockedZones() []int32 {
+	if x != nil {
+		return x.UnlockedZones
+	}
+	return nil
+}
+
+func (x *LandDetail) GetUpgradeCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpgradeCompleteTime
+	}
+	return nil
+}
+
+// ListUserLandsRequest 获取用户土地列表请求
+type ListUserLandsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 分页参数
+	Page *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 地形类型
+	LandType *int32 `protobuf:"varint,3,opt,name=land_type,json=landType,proto3,oneof" json:"land_type,omitempty"`
+	// 稀有度
+	Rarity *int32 `protobuf:"varint,4,opt,name=rarity,proto3,oneof" json:"rarity,omitempty"`
+	// 最低等级
+	MinLevel *int32 `protobuf:"varint,5,opt,name=min_level,json=minLevel,proto3,oneof" json:"min_level,omitempty"`
+	// 最高等级
+	MaxLevel      *int32 `protobuf:"varint,6,opt,name=max_level,json=maxLevel,proto3,oneof" json:"max_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserLandsRequest) Reset() {
+	*x = ListUserLandsRequest{}
+	mi := &file_metafarm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserLandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLandsRequest) ProtoMessage() {}
+
+func (x *ListUserLandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLandsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserLandsRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListUserLandsRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *ListUserLandsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListUserLandsRequest) GetLandType() int32 {
+	if x != nil && x.LandType != nil {
+		return *x.LandType
+	}
+	return 0
+}
+
+func (x *ListUserLandsRequest) GetRarity() int32 {
+	if x != nil && x.Rarity != nil {
+		return *x.Rarity
+	}
+	return 0
+}
+
+func (x *ListUserLandsRequest) GetMinLevel() int32 {
+	if x != nil && x.MinLevel != nil {
+		return *x.MinLevel
+	}
+	return 0
+}
+
+func (x *ListUserLandsRequest) GetMaxLevel() int32 {
+	if x != nil && x.MaxLevel != nil {
+		return *x.MaxLevel
+	}
+	return 0
+}
+
+// ListUserLandsResponse 用户土地列表
+type ListUserLandsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地列表
+	Lands []*LandDetail `protobuf:"bytes,1,rep,name=lands,proto3" json:"lands,omitempty"`
+	// 分页结果
+	Page          *PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserLandsResponse) Reset() {
+	*x = ListUserLandsResponse{}
+	mi := &file_metafarm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserLandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLandsResponse) ProtoMessage() {}
+
+func (x *ListUserLandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLandsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserLandsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListUserLandsResponse) GetLands() []*LandDetail {
+	if x != nil {
+		return x.Lands
+	}
+	return nil
+}
+
+func (x *ListUserLandsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// GetLandDetailRequest 获取土地详情请求
+type GetLandDetailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId   string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLandDetailRequest) Reset() {
+	*x = GetLandDetailRequest{}
+	mi := &file_metafarm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLandDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandDetailRequest) ProtoMessage() {}
+
+func (x *GetLandDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetLandDetailRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLandDetailRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+// ZoneOccupancy 分区面积占用情况
+type ZoneOccupancy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分区ID
+	ZoneId uint64 `protobuf:"varint,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	// 分区类型(0-种植区,1-养殖区,2-装饰区)
+	ZoneType int32 `protobuf:"varint,2,opt,name=zone_type,json=zoneType,proto3" json:"zone_type,omitempty"`
+	// 分区面积(㎡)
+	Area int32 `protobuf:"varint,3,opt,name=area,proto3" json:"area,omitempty"`
+	// 生长中及枯萎未清理的活动占用的面积(㎡)
+	OccupiedArea int32 `protobuf:"varint,4,opt,name=occupied_area,json=occupiedArea,proto3" json:"occupied_area,omitempty"`
+	// 剩余可用面积(㎡)
+	FreeArea      int32 `protobuf:"varint,5,opt,name=free_area,json=freeArea,proto3" json:"free_area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneOccupancy) Reset() {
+	*x = ZoneOccupancy{}
+	mi := &file_metafarm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneOccupancy) ProtoMessage() {}
+
+func (x *ZoneOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneOccupancy.ProtoReflect.Descriptor instead.
+func (*ZoneOccupancy) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ZoneOccupancy) GetZoneId() uint64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *ZoneOccupancy) GetZoneType() int32 {
+	if x != nil {
+		return x.ZoneType
+	}
+	return 0
+}
+
+func (x *ZoneOccupancy) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *ZoneOccupancy) GetOccupiedArea() int32 {
+	if x != nil {
+		return x.OccupiedArea
+	}
+	return 0
+}
+
+func (x *ZoneOccupancy) GetFreeArea() int32 {
+	if x != nil {
+		return x.FreeArea
+	}
+	return 0
+}
+
+// ListZoneOccupancyResponse 分区面积占用列表
+type ListZoneOccupancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*ZoneOccupancy       `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneOccupancyResponse) Reset() {
+	*x = ListZoneOccupancyResponse{}
+	mi := &file_metafarm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneOccupancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneOccupancyResponse) ProtoMessage() {}
+
+func (x *ListZoneOccupancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneOccupancyResponse.ProtoReflect.Descriptor instead.
+func (*ListZoneOccupancyResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListZoneOccupancyResponse) GetZones() []*ZoneOccupancy {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// ListLandHistoryRequest 获取土地历史请求
+type ListLandHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 分页参数, 仅支持按time排序
+	Page          *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLandHistoryRequest) Reset() {
+	*x = ListLandHistoryRequest{}
+	mi := &file_metafarm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLandHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLandHistoryRequest) ProtoMessage() {}
+
+func (x *ListLandHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLandHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLandHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLandHistoryRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *ListLandHistoryRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// LandHistoryEntry 土地历史事件
+type LandHistoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件类型(mint/upgrade/listing/sale/rental/activity)
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 来源记录ID
+	RefId uint64 `protobuf:"varint,2,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	// 事件时间
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// 发起方地址
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// 对手方地址
+	Counterparty string `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// 金额
+	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 来源记录状态
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 升级前等级
+	OldLevel int32 `protobuf:"varint,8,opt,name=old_level,json=oldLevel,proto3" json:"old_level,omitempty"`
+	// 升级后等级
+	NewLevel int32 `protobuf:"varint,9,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
+	// 作物/动物名称
+	Subject       string `protobuf:"bytes,10,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandHistoryEntry) Reset() {
+	*x = LandHistoryEntry{}
+	mi := &file_metafarm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandHistoryEntry) ProtoMessage() {}
+
+func (x *LandHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LandHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LandHistoryEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LandHistoryEntry) GetRefId() uint64 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *LandHistoryEntry) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *LandHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LandHistoryEntry) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *LandHistoryEntry) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LandHistoryEntry) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LandHistoryEntry) GetOldLevel() int32 {
+	if x != nil {
+		return x.OldLevel
+	}
+	return 0
+}
+
+func (x *LandHistoryEntry) GetNewLevel() int32 {
+	if x != nil {
+		return x.NewLevel
+	}
+	return 0
+}
+
+func (x *LandHistoryEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// ListLandHistoryResponse 土地历史时间线
+type ListLandHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 历史事件, 默认按时间倒序
+	Entries []*LandHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// 分页结果
+	Page          *PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLandHistoryResponse) Reset() {
+	*x = ListLandHistoryResponse{}
+	mi := &file_metafarm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLandHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLandHistoryResponse) ProtoMessage() {}
+
+func (x *ListLandHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLandHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLandHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListLandHistoryResponse) GetEntries() []*LandHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLandHistoryResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// UpgradeLandRequest 升级土地请求
+type UpgradeLandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 升级目标等级(须为当前等级+1)
+	Level         int32 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeLandRequest) Reset() {
+	*x = UpgradeLandRequest{}
+	mi := &file_metafarm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeLandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeLandRequest) ProtoMessage() {}
+
+func (x *UpgradeLandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeLandRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeLandRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpgradeLandRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *UpgradeLandRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *UpgradeLandRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// LandUpgrade 土地升级记录
+type LandUpgrade struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 升级记录ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 所有者钱包地址
+	OwnerAddress string `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// 升级前等级
+	OldLevel int32 `protobuf:"varint,4,opt,name=old_level,json=oldLevel,proto3" json:"old_level,omitempty"`
+	// 升级后等级
+	NewLevel int32 `protobuf:"varint,5,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
+	// 消耗代币数量
+	CostTokens uint64 `protobuf:"varint,6,opt,name=cost_tokens,json=costTokens,proto3" json:"cost_tokens,omitempty"`
+	// 取消时退还的代币数量
+	RefundTokens uint64 `protobuf:"varint,7,opt,name=refund_tokens,json=refundTokens,proto3" json:"refund_tokens,omitempty"`
+	// 状态(1-施工中,2-已完成,3-已取消)
+	Status int32 `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	// 开始升级时间
+	UpgradeTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=upgrade_time,json=upgradeTime,proto3" json:"upgrade_time,omitempty"`
+	// 预计完工时间
+	CompleteTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	// 实际完成或取消时间
+	FinishTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandUpgrade) Reset() {
+	*x = LandUpgrade{}
+	mi := &file_metafarm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandUpgrade) ProtoMessage() {}
+
+func (x *LandUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandUpgrade.ProtoReflect.Descriptor instead.
+func (*LandUpgrade) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LandUpgrade) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *LandUpgrade) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *LandUpgrade) GetOldLevel() int32 {
+	if x != nil {
+		return x.OldLevel
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetNewLevel() int32 {
+	if x != nil {
+		return x.NewLevel
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetCostTokens() uint64 {
+	if x != nil {
+		return x.CostTokens
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetRefundTokens() uint64 {
+	if x != nil {
+		return x.RefundTokens
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LandUpgrade) GetUpgradeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpgradeTime
+	}
+	return nil
+}
+
+func (x *LandUpgrade) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
+func (x *LandUpgrade) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+// SpeedUpUpgradeRequest 加速升级施工请求, 不指定道具时按剩余时间支付MFG立即完成
+type SpeedUpUpgradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 升级记录ID
+	UpgradeId uint64 `protobuf:"varint,1,opt,name=upgrade_id,json=upgradeId,proto3" json:"upgrade_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 加速道具TokenID
+	ItemTokenId *int64 `protobuf:"varint,3,opt,name=item_token_id,json=itemTokenId,proto3,oneof" json:"item_token_id,omitempty"`
+	// 道具使用次数, 默认1
+	Uses          int32 `protobuf:"varint,4,opt,name=uses,proto3" json:"uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeedUpUpgradeRequest) Reset() {
+	*x = SpeedUpUpgradeRequest{}
+	mi := &file_metafarm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeedUpUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedUpUpgradeRequest) ProtoMessage() {}
+
+func (x *SpeedUpUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedUpUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*SpeedUpUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SpeedUpUpgradeRequest) GetUpgradeId() uint64 {
+	if x != nil {
+		return x.UpgradeId
+	}
+	return 0
+}
+
+func (x *SpeedUpUpgradeRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *SpeedUpUpgradeRequest) GetItemTokenId() int64 {
+	if x != nil && x.ItemTokenId != nil {
+		return *x.ItemTokenId
+	}
+	return 0
+}
+
+func (x *SpeedUpUpgradeRequest) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+// CancelUpgradeRequest 取消升级施工请求
+type CancelUpgradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 升级记录ID
+	UpgradeId uint64 `protobuf:"varint,1,opt,name=upgrade_id,json=upgradeId,proto3" json:"upgrade_id,omitempty"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelUpgradeRequest) Reset() {
+	*x = CancelUpgradeRequest{}
+	mi := &file_metafarm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUpgradeRequest) ProtoMessage() {}
+
+func (x *CancelUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*CancelUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelUpgradeRequest) GetUpgradeId() uint64 {
+	if x != nil {
+		return x.UpgradeId
+	}
+	return 0
+}
+
+func (x *CancelUpgradeRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// ListUpgradeQueueRequest 获取升级施工队列请求
+type ListUpgradeQueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpgradeQueueRequest) Reset() {
+	*x = ListUpgradeQueueRequest{}
+	mi := &file_metafarm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpgradeQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpgradeQueueRequest) ProtoMessage() {}
+
+func (x *ListUpgradeQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpgradeQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListUpgradeQueueRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUpgradeQueueRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// ListUpgradeQueueResponse 升级施工队列
+type ListUpgradeQueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 施工中的升级, 按完工时间升序
+	Upgrades      []*LandUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpgradeQueueResponse) Reset() {
+	*x = ListUpgradeQueueResponse{}
+	mi := &file_metafarm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpgradeQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpgradeQueueResponse) ProtoMessage() {}
+
+func (x *ListUpgradeQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpgradeQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListUpgradeQueueResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListUpgradeQueueResponse) GetUpgrades() []*LandUpgrade {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+// CreateRentRequest 创建租赁请求
+type CreateRentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 租户钱包地址
+	RenterAddress string `protobuf:"bytes,2,opt,name=renter_address,json=renterAddress,proto3" json:"renter_address,omitempty"`
+	// 租赁时长(天, 7/14/30)
+	RentalDuration int32 `protobuf:"varint,3,opt,name=rental_duration,json=rentalDuration,proto3" json:"rental_duration,omitempty"`
+	// 每平方米租金
+	RentPerSqm float64 `protobuf:"fixed64,4,opt,name=rent_per_sqm,json=rentPerSqm,proto3" json:"rent_per_sqm,omitempty"`
+	// 操作人钱包地址
+	UserAddress   string `protobuf:"bytes,5,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRentRequest) Reset() {
+	*x = CreateRentRequest{}
+	mi := &file_metafarm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRentRequest) ProtoMessage() {}
+
+func (x *CreateRentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRentRequest.ProtoReflect.Descriptor instead.
+func (*CreateRentRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateRentRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *CreateRentRequest) GetRenterAddress() string {
+	if x != nil {
+		return x.RenterAddress
+	}
+	return ""
+}
+
+func (x *CreateRentRequest) GetRentalDuration() int32 {
+	if x != nil {
+		return x.RentalDuration
+	}
+	return 0
+}
+
+func (x *CreateRentRequest) GetRentPerSqm() float64 {
+	if x != nil {
+		return x.RentPerSqm
+	}
+	return 0
+}
+
+func (x *CreateRentRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// RentLandResponse 租赁土地响应
+type RentLandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租赁订单ID
+	RentalId uint64 `protobuf:"varint,1,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 总租金
+	TotalRent float64 `protobuf:"fixed64,3,opt,name=total_rent,json=totalRent,proto3" json:"total_rent,omitempty"`
+	// 租赁开始时间
+	RentalStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=rental_start_time,json=rentalStartTime,proto3" json:"rental_start_time,omitempty"`
+	// 租赁结束时间
+	RentalEndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rental_end_time,json=rentalEndTime,proto3" json:"rental_end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RentLandResponse) Reset() {
+	*x = RentLandResponse{}
+	mi := &file_metafarm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RentLandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentLandResponse) ProtoMessage() {}
+
+func (x *RentLandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentLandResponse.ProtoReflect.Descriptor instead.
+func (*RentLandResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RentLandResponse) GetRentalId() uint64 {
+	if x != nil {
+		return x.RentalId
+	}
+	return 0
+}
+
+func (x *RentLandResponse) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *RentLandResponse) GetTotalRent() float64 {
+	if x != nil {
+		return x.TotalRent
+	}
+	return 0
+}
+
+func (x *RentLandResponse) GetRentalStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentalStartTime
+	}
+	return nil
+}
+
+func (x *RentLandResponse) GetRentalEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentalEndTime
+	}
+	return nil
+}
+
+// LandRental 租赁订单
+type LandRental struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租赁订单ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 所有者钱包地址
+	OwnerAddress string `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// 租客钱包地址
+	RenterAddress string `protobuf:"bytes,4,opt,name=renter_address,json=renterAddress,proto3" json:"renter_address,omitempty"`
+	// 租期(天)
+	RentalDuration int32 `protobuf:"varint,5,opt,name=rental_duration,json=rentalDuration,proto3" json:"rental_duration,omitempty"`
+	// 每平方米日租金
+	RentPerSqmPerDay float64 `protobuf:"fixed64,6,opt,name=rent_per_sqm_per_day,json=rentPerSqmPerDay,proto3" json:"rent_per_sqm_per_day,omitempty"`
+	// 总租金
+	TotalRent float64 `protobuf:"fixed64,7,opt,name=total_rent,json=totalRent,proto3" json:"total_rent,omitempty"`
+	// 系统手续费
+	SystemFee float64 `protobuf:"fixed64,8,opt,name=system_fee,json=systemFee,proto3" json:"system_fee,omitempty"`
+	// 状态(0-待确认,1-租赁中,2-已结束,3-已取消)
+	Status int32 `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	// 租赁开始时间
+	RentalStartTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rental_start_time,json=rentalStartTime,proto3" json:"rental_start_time,omitempty"`
+	// 租赁结束时间
+	RentalEndTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=rental_end_time,json=rentalEndTime,proto3" json:"rental_end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandRental) Reset() {
+	*x = LandRental{}
+	mi := &file_metafarm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandRental) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandRental) ProtoMessage() {}
+
+func (x *LandRental) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandRental.ProtoReflect.Descriptor instead.
+func (*LandRental) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LandRental) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LandRental) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *LandRental) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *LandRental) GetRenterAddress() string {
+	if x != nil {
+		return x.RenterAddress
+	}
+	return ""
+}
+
+func (x *LandRental) GetRentalDuration() int32 {
+	if x != nil {
+		return x.RentalDuration
+	}
+	return 0
+}
+
+func (x *LandRental) GetRentPerSqmPerDay() float64 {
+	if x != nil {
+		return x.RentPerSqmPerDay
+	}
+	return 0
+}
+
+func (x *LandRental) GetTotalRent() float64 {
+	if x != nil {
+		return x.TotalRent
+	}
+	return 0
+}
+
+func (x *LandRental) GetSystemFee() float64 {
+	if x != nil {
+		return x.SystemFee
+	}
+	return 0
+}
+
+func (x *LandRental) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LandRental) GetRentalStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentalStartTime
+	}
+	return nil
+}
+
+func (x *LandRental) GetRentalEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentalEndTime
+	}
+	return nil
+}
+
+// ListRentLandsRequest 获取租赁订单列表请求
+type ListRentLandsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租客钱包地址
+	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 分页参数
+	Page *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId *string `protobuf:"bytes,3,opt,name=land_token_id,json=landTokenId,proto3,oneof" json:"land_token_id,omitempty"`
+	// 租赁时长(天)
+	RentalDuration *int32 `protobuf:"varint,4,opt,name=rental_duration,json=rentalDuration,proto3,oneof" json:"rental_duration,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRentLandsRequest) Reset() {
+	*x = ListRentLandsRequest{}
+	mi := &file_metafarm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentLandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentLandsRequest) ProtoMessage() {}
+
+func (x *ListRentLandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentLandsRequest.ProtoReflect.Descriptor instead.
+func (*ListRentLandsRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRentLandsRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *ListRentLandsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListRentLandsRequest) GetLandTokenId() string {
+	if x != nil && x.LandTokenId != nil {
+		return *x.LandTokenId
+	}
+	return ""
+}
+
+func (x *ListRentLandsRequest) GetRentalDuration() int32 {
+	if x != nil && x.RentalDuration != nil {
+		return *x.RentalDuration
+	}
+	return 0
+}
+
+// ListRentalsResponse 租赁订单列表
+type ListRentalsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租赁订单列表
+	Rentals []*LandRental `protobuf:"bytes,1,rep,name=rentals,proto3" json:"rentals,omitempty"`
+	// 分页结果
+	Page          *PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRentalsResponse) Reset() {
+	*x = ListRentalsResponse{}
+	mi := &file_metafarm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentalsResponse) ProtoMessage() {}
+
+func (x *ListRentalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentalsResponse.ProtoReflect.Descriptor instead.
+func (*ListRentalsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRentalsResponse) GetRentals() []*LandRental {
+	if x != nil {
+		return x.Rentals
+	}
+	return nil
+}
+
+func (x *ListRentalsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// CancelRentalRequest 取消租赁请求
+type CancelRentalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租赁订单ID
+	RentalId uint64 `protobuf:"varint,1,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRentalRequest) Reset() {
+	*x = CancelRentalRequest{}
+	mi := &file_metafarm_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRentalRequest) ProtoMessage() {}
+
+func (x *CancelRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRentalRequest.ProtoReflect.Descriptor instead.
+func (*CancelRentalRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelRentalRequest) GetRentalId() uint64 {
+	if x != nil {
+		return x.RentalId
+	}
+	return 0
+}
+
+func (x *CancelRentalRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// LandListing 土地挂牌
+type LandListing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 市场挂牌ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 卖家钱包地址
+	SellerAddress string `protobuf:"bytes,3,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
+	// 土地面积(㎡)
+	Area int32 `protobuf:"varint,4,opt,name=area,proto3" json:"area,omitempty"`
+	// 售价
+	Price float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	// 挂牌时间
+	ListingTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=listing_time,json=listingTime,proto3" json:"listing_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandListing) Reset() {
+	*x = LandListing{}
+	mi := &file_metafarm_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandListing) ProtoMessage() {}
+
+func (x *LandListing) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandListing.ProtoReflect.Descriptor instead.
+func (*LandListing) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LandListing) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LandListing) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *LandListing) GetSellerAddress() string {
+	if x != nil {
+		return x.SellerAddress
+	}
+	return ""
+}
+
+func (x *LandListing) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *LandListing) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *LandListing) GetListingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListingTime
+	}
+	return nil
+}
+
+// ListMarketLandsRequest 获取土地挂牌列表请求
+type ListMarketLandsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分页参数
+	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// 最低售价
+	MinPrice *float64 `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	// 最高售价
+	MaxPrice *float64 `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	// 最小面积
+	MinArea *int32 `protobuf:"varint,4,opt,name=min_area,json=minArea,proto3,oneof" json:"min_area,omitempty"`
+	// 最大面积
+	MaxArea       *int32 `protobuf:"varint,5,opt,name=max_area,json=maxArea,proto3,oneof" json:"max_area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketLandsRequest) Reset() {
+	*x = ListMarketLandsRequest{}
+	mi := &file_metafarm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketLandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketLandsRequest) ProtoMessage() {}
+
+func (x *ListMarketLandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketLandsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketLandsRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListMarketLandsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListMarketLandsRequest) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListMarketLandsRequest) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListMarketLandsRequest) GetMinArea() int32 {
+	if x != nil && x.MinArea != nil {
+		return *x.MinArea
+	}
+	return 0
+}
+
+func (x *ListMarketLandsRequest) GetMaxArea() int32 {
+	if x != nil && x.MaxArea != nil {
+		return *x.MaxArea
+	}
+	return 0
+}
+
+// ListMarketLandsResponse 土地挂牌列表
+type ListMarketLandsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 挂牌列表
+	Listings []*LandListing `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	// 分页结果
+	Page          *PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketLandsResponse) Reset() {
+	*x = ListMarketLandsResponse{}
+	mi := &file_metafarm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketLandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketLandsResponse) ProtoMessage() {}
+
+func (x *ListMarketLandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketLandsResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketLandsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMarketLandsResponse) GetListings() []*LandListing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *ListMarketLandsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// SearchLandsRequest 搜索土地请求, 排序字段可选 id/level/rarity/fertility/area/createTime/price; 肥力按当前肥力筛选和排序
+type SearchLandsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分页参数
+	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// 地形类型
+	LandType *int32 `protobuf:"varint,2,opt,name=land_type,json=landType,proto3,oneof" json:"land_type,omitempty"`
+	// 稀有度
+	Rarity *int32 `protobuf:"varint,3,opt,name=rarity,proto3,oneof" json:"rarity,omitempty"`
+	// 最低等级
+	MinLevel *int32 `protobuf:"varint,4,opt,name=min_level,json=minLevel,proto3,oneof" json:"min_level,omitempty"`
+	// 最高等级
+	MaxLevel *int32 `protobuf:"varint,5,opt,name=max_level,json=maxLevel,proto3,oneof" json:"max_level,omitempty"`
+	// 最低肥力, 按当前肥力比较
+	MinFertility *int32 `protobuf:"varint,6,opt,name=min_fertility,json=minFertility,proto3,oneof" json:"min_fertility,omitempty"`
+	// 最高肥力, 按当前肥力比较
+	MaxFertility *int32 `protobuf:"varint,7,opt,name=max_fertility,json=maxFertility,proto3,oneof" json:"max_fertility,omitempty"`
+	// 最小面积
+	MinArea *int32 `protobuf:"varint,8,opt,name=min_area,json=minArea,proto3,oneof" json:"min_area,omitempty"`
+	// 最大面积
+	MaxArea *int32 `protobuf:"varint,9,opt,name=max_area,json=maxArea,proto3,oneof" json:"max_area,omitempty"`
+	// 特殊效果
+	SpecialEffect *string `protobuf:"bytes,10,opt,name=special_effect,json=specialEffect,proto3,oneof" json:"special_effect,omitempty"`
+	// 是否待出售
+	ForSale *bool `protobuf:"varint,11,opt,name=for_sale,json=forSale,proto3,oneof" json:"for_sale,omitempty"`
+	// 是否租赁中
+	ForRent *bool `protobuf:"varint,12,opt,name=for_rent,json=forRent,proto3,oneof" json:"for_rent,omitempty"`
+	// 最低售价, 只匹配待出售的土地
+	MinPrice *float64 `protobuf:"fixed64,13,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	// 最高售价, 只匹配待出售的土地
+	MaxPrice      *float64 `protobuf:"fixed64,14,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLandsRequest) Reset() {
+	*x = SearchLandsRequest{}
+	mi := &file_metafarm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLandsRequest) ProtoMessage() {}
+
+func (x *SearchLandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLandsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLandsRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchLandsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SearchLandsRequest) GetLandType() int32 {
+	if x != nil && x.LandType != nil {
+		return *x.LandType
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetRarity() int32 {
+	if x != nil && x.Rarity != nil {
+		return *x.Rarity
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMinLevel() int32 {
+	if x != nil && x.MinLevel != nil {
+		return *x.MinLevel
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMaxLevel() int32 {
+	if x != nil && x.MaxLevel != nil {
+		return *x.MaxLevel
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMinFertility() int32 {
+	if x != nil && x.MinFertility != nil {
+		return *x.MinFertility
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMaxFertility() int32 {
+	if x != nil && x.MaxFertility != nil {
+		return *x.MaxFertility
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMinArea() int32 {
+	if x != nil && x.MinArea != nil {
+		return *x.MinArea
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMaxArea() int32 {
+	if x != nil && x.MaxArea != nil {
+		return *x.MaxArea
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetSpecialEffect() string {
+	if x != nil && x.SpecialEffect != nil {
+		return *x.SpecialEffect
+	}
+	return ""
+}
+
+func (x *SearchLandsRequest) GetForSale() bool {
+	if x != nil && x.ForSale != nil {
+		return *x.ForSale
+	}
+	return false
+}
+
+func (x *SearchLandsRequest) GetForRent() bool {
+	if x != nil && x.ForRent != nil {
+		return *x.ForRent
+	}
+	return false
+}
+
+func (x *SearchLandsRequest) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchLandsRequest) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+// LandSearchItem 土地搜索结果项
+type LandSearchItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地信息
+	Land *LandDetail `protobuf:"bytes,1,opt,name=land,proto3" json:"land,omitempty"`
+	// 待出售挂牌ID, 未挂牌时为0
+	MarketId uint64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// 挂牌售价, 未挂牌时为空
+	Price *float64 `protobuf:"fixed64,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	// 是否租赁中
+	Rented        bool `protobuf:"varint,4,opt,name=rented,proto3" json:"rented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandSearchItem) Reset() {
+	*x = LandSearchItem{}
+	mi := &file_metafarm_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandSearchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandSearchItem) ProtoMessage() {}
+
+func (x *LandSearchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandSearchItem.ProtoReflect.Descriptor instead.
+func (*LandSearchItem) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LandSearchItem) GetLand() *LandDetail {
+	if x != nil {
+		return x.Land
+	}
+	return nil
+}
+
+func (x *LandSearchItem) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *LandSearchItem) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *LandSearchItem) GetRented() bool {
+	if x != nil {
+		return x.Rented
+	}
+	return false
+}
+
+// FacetCount 分面统计项
+type FacetCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 取值
+	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// 符合条件的土地数量
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_metafarm_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FacetCount) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// LandFacets 土地搜索的分面统计, 每一项统计时忽略该项自身的筛选条件
+type LandFacets struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按稀有度统计
+	Rarity []*FacetCount `protobuf:"bytes,1,rep,name=rarity,proto3" json:"rarity,omitempty"`
+	// 按地形类型统计
+	LandType      []*FacetCount `protobuf:"bytes,2,rep,name=land_type,json=landType,proto3" json:"land_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandFacets) Reset() {
+	*x = LandFacets{}
+	mi := &file_metafarm_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandFacets) ProtoMessage() {}
+
+func (x *LandFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandFacets.ProtoReflect.Descriptor instead.
+func (*LandFacets) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LandFacets) GetRarity() []*FacetCount {
+	if x != nil {
+		return x.Rarity
+	}
+	return nil
+}
+
+func (x *LandFacets) GetLandType() []*FacetCount {
+	if x != nil {
+		return x.LandType
+	}
+	return nil
+}
+
+// SearchLandsResponse 土地搜索结果
+type SearchLandsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地列表
+	Lands []*LandSearchItem `protobuf:"bytes,1,rep,name=lands,proto3" json:"lands,omitempty"`
+	// 分面统计, 只在首页返回
+	Facets *LandFacets `protobuf:"bytes,2,opt,name=facets,proto3" json:"facets,omitempty"`
+	// 分页结果
+	Page          *PageInfo `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLandsResponse) Reset() {
+	*x = SearchLandsResponse{}
+	mi := &file_metafarm_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLandsResponse) ProtoMessage() {}
+
+func (x *SearchLandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLandsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLandsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchLandsResponse) GetLands() []*LandSearchItem {
+	if x != nil {
+		return x.Lands
+	}
+	return nil
+}
+
+func (x *SearchLandsResponse) GetFacets() *LandFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchLandsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// CreateMarketListingRequest 创建土地挂牌请求
+type CreateMarketListingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT ID
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// 卖家地址
+	SellerAddress string `protobuf:"bytes,2,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
+	// 售价
+	Price         float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMarketListingRequest) Reset() {
+	*x = CreateMarketListingRequest{}
+	mi := &file_metafarm_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMarketListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMarketListingRequest) ProtoMessage() {}
+
+func (x *CreateMarketListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMarketListingRequest.ProtoReflect.Descriptor instead.
+func (*CreateMarketListingRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateMarketListingRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *CreateMarketListingRequest) GetSellerAddress() string {
+	if x != nil {
+		return x.SellerAddress
+	}
+	return ""
+}
+
+func (x *CreateMarketListingRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// BuyLandRequest 购买土地请求
+type BuyLandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 市场ID
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// 挂牌ID
+	ListingId uint64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	// 买家钱包地址
+	BuyerAddress  string `protobuf:"bytes,3,opt,name=buyer_address,json=buyerAddress,proto3" json:"buyer_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyLandRequest) Reset() {
+	*x = BuyLandRequest{}
+	mi := &file_metafarm_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyLandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyLandRequest) ProtoMessage() {}
+
+func (x *BuyLandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyLandRequest.ProtoReflect.Descriptor instead.
+func (*BuyLandRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BuyLandRequest) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *BuyLandRequest) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *BuyLandRequest) GetBuyerAddress() string {
+	if x != nil {
+		return x.BuyerAddress
+	}
+	return ""
+}
+
+// FertilizeLandRequest 施肥请求
+type FertilizeLandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 肥料道具TokenID
+	ItemTokenId int64 `protobuf:"varint,3,opt,name=item_token_id,json=itemTokenId,proto3" json:"item_token_id,omitempty"`
+	// 使用次数, 默认1
+	Uses          int32 `protobuf:"varint,4,opt,name=uses,proto3" json:"uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FertilizeLandRequest) Reset() {
+	*x = FertilizeLandRequest{}
+	mi := &file_metafarm_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FertilizeLandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FertilizeLandRequest) ProtoMessage() {}
+
+func (x *FertilizeLandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FertilizeLandRequest.ProtoReflect.Descriptor instead.
+func (*FertilizeLandRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FertilizeLandRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *FertilizeLandRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *FertilizeLandRequest) GetItemTokenId() int64 {
+	if x != nil {
+		return x.ItemTokenId
+	}
+	return 0
+}
+
+func (x *FertilizeLandRequest) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+// UpdateLandLayoutRequest 更新土地布局请求
+type UpdateLandLayoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT ID
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// 用户地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 完整的分区列表, 未列出的已有分区将被删除; 字段3-8为旧版单分区参数, 已废弃不可复用
+	Zones         []*LayoutZone `protobuf:"bytes,9,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLandLayoutRequest) Reset() {
+	*x = UpdateLandLayoutRequest{}
+	mi := &file_metafarm_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLandLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLandLayoutRequest) ProtoMessage() {}
+
+func (x *UpdateLandLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLandLayoutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLandLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateLandLayoutRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *UpdateLandLayoutRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *UpdateLandLayoutRequest) GetZones() []*LayoutZone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// LayoutZone 土地分区
+type LayoutZone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分区ID, 更新时不传表示新建
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 分区面积, 须等于宽×高
+	Area int32 `protobuf:"varint,2,opt,name=area,proto3" json:"area,omitempty"`
+	// 分区类型(0-种植区,1-养殖区,2-装饰区)
+	ZoneType int32 `protobuf:"varint,3,opt,name=zone_type,json=zoneType,proto3" json:"zone_type,omitempty"`
+	// 左上角X坐标
+	PositionX int32 `protobuf:"varint,4,opt,name=position_x,json=positionX,proto3" json:"position_x,omitempty"`
+	// 左上角Y坐标
+	PositionY int32 `protobuf:"varint,5,opt,name=position_y,json=positionY,proto3" json:"position_y,omitempty"`
+	// 宽度
+	Width int32 `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	// 高度
+	Height int32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// 是否激活相邻加成
+	HasAdjacentBonus bool `protobuf:"varint,8,opt,name=has_adjacent_bonus,json=hasAdjacentBonus,proto3" json:"has_adjacent_bonus,omitempty"`
+	// 加成类型(-1-无加成,0-肥力恢复,1-产量提升)
+	BonusType int32 `protobuf:"varint,9,opt,name=bonus_type,json=bonusType,proto3" json:"bonus_type,omitempty"`
+	// 加成值(百分比)
+	BonusValue    float64 `protobuf:"fixed64,10,opt,name=bonus_value,json=bonusValue,proto3" json:"bonus_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LayoutZone) Reset() {
+	*x = LayoutZone{}
+	mi := &file_metafarm_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutZone) ProtoMessage() {}
+
+func (x *LayoutZone) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutZone.ProtoReflect.Descriptor instead.
+func (*LayoutZone) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LayoutZone) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetZoneType() int32 {
+	if x != nil {
+		return x.ZoneType
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetPositionX() int32 {
+	if x != nil {
+		return x.PositionX
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetPositionY() int32 {
+	if x != nil {
+		return x.PositionY
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetHasAdjacentBonus() bool {
+	if x != nil {
+		return x.HasAdjacentBonus
+	}
+	return false
+}
+
+func (x *LayoutZone) GetBonusType() int32 {
+	if x != nil {
+		return x.BonusType
+	}
+	return 0
+}
+
+func (x *LayoutZone) GetBonusValue() float64 {
+	if x != nil {
+		return x.BonusValue
+	}
+	return 0
+}
+
+// LandLayout 土地布局
+type LandLayout struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 分区列表
+	Zones         []*LayoutZone `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandLayout) Reset() {
+	*x = LandLayout{}
+	mi := &file_metafarm_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandLayout) ProtoMessage() {}
+
+func (x *LandLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandLayout.ProtoReflect.Descriptor instead.
+func (*LandLayout) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LandLayout) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *LandLayout) GetZones() []*LayoutZone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// PlantCropRequest 种植作物请求
+type PlantCropRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 区域ID
+	ZoneId uint64 `protobuf:"varint,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	// 作物/动物ID
+	CropAnimalId uint64 `protobuf:"varint,3,opt,name=crop_animal_id,json=cropAnimalId,proto3" json:"crop_animal_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,4,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 种植面积
+	Area          int32 `protobuf:"varint,5,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlantCropRequest) Reset() {
+	*x = PlantCropRequest{}
+	mi := &file_metafarm_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlantCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlantCropRequest) ProtoMessage() {}
+
+func (x *PlantCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlantCropRequest.ProtoReflect.Descriptor instead.
+func (*PlantCropRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlantCropRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *PlantCropRequest) GetZoneId() uint64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *PlantCropRequest) GetCropAnimalId() uint64 {
+	if x != nil {
+		return x.CropAnimalId
+	}
+	return 0
+}
+
+func (x *PlantCropRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *PlantCropRequest) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+// PlantCropResponse 种植作物响应
+type PlantCropResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 活动类型(0-种植,1-养殖)
+	ActivityType int32 `protobuf:"varint,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// 作物/动物ID
+	CropAnimalId uint64 `protobuf:"varint,3,opt,name=crop_animal_id,json=cropAnimalId,proto3" json:"crop_animal_id,omitempty"`
+	// 作物/动物名称
+	CropAnimalName string `protobuf:"bytes,4,opt,name=crop_animal_name,json=cropAnimalName,proto3" json:"crop_animal_name,omitempty"`
+	// 占用面积(㎡)
+	Area int32 `protobuf:"varint,5,opt,name=area,proto3" json:"area,omitempty"`
+	// 消耗肥力值
+	FertilityCost int32 `protobuf:"varint,6,opt,name=fertility_cost,json=fertilityCost,proto3" json:"fertility_cost,omitempty"`
+	// 开始时间
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 预计完成时间
+	ExpectedEndTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expected_end_time,json=expectedEndTime,proto3" json:"expected_end_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlantCropResponse) Reset() {
+	*x = PlantCropResponse{}
+	mi := &file_metafarm_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlantCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlantCropResponse) ProtoMessage() {}
+
+func (x *PlantCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlantCropResponse.ProtoReflect.Descriptor instead.
+func (*PlantCropResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PlantCropResponse) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *PlantCropResponse) GetActivityType() int32 {
+	if x != nil {
+		return x.ActivityType
+	}
+	return 0
+}
+
+func (x *PlantCropResponse) GetCropAnimalId() uint64 {
+	if x != nil {
+		return x.CropAnimalId
+	}
+	return 0
+}
+
+func (x *PlantCropResponse) GetCropAnimalName() string {
+	if x != nil {
+		return x.CropAnimalName
+	}
+	return ""
+}
+
+func (x *PlantCropResponse) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *PlantCropResponse) GetFertilityCost() int32 {
+	if x != nil {
+		return x.FertilityCost
+	}
+	return 0
+}
+
+func (x *PlantCropResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PlantCropResponse) GetExpectedEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedEndTime
+	}
+	return nil
+}
+
+// ListCatalogRequest 获取作物/动物目录请求
+type ListCatalogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 类型(0-作物,1-动物), 不传表示全部
+	Kind          *int32 `protobuf:"varint,1,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogRequest) Reset() {
+	*x = ListCatalogRequest{}
+	mi := &file_metafarm_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogRequest) ProtoMessage() {}
+
+func (x *ListCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCatalogRequest) GetKind() int32 {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return 0
+}
+
+// CropAnimal 作物/动物目录条目
+type CropAnimal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 作物/动物ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 类型(0-作物,1-动物)
+	Kind int32 `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 生长时长(分钟)
+	GrowthMinutes int32 `protobuf:"varint,4,opt,name=growth_minutes,json=growthMinutes,proto3" json:"growth_minutes,omitempty"`
+	// 每平方米消耗肥力
+	FertilityPerSqm int32 `protobuf:"varint,5,opt,name=fertility_per_sqm,json=fertilityPerSqm,proto3" json:"fertility_per_sqm,omitempty"`
+	// 每平方米基础产量
+	BaseYield float64 `protobuf:"fixed64,6,opt,name=base_yield,json=baseYield,proto3" json:"base_yield,omitempty"`
+	// 适宜地形, 为空表示不限
+	LandTypes []int32 `protobuf:"varint,7,rep,packed,name=land_types,json=landTypes,proto3" json:"land_types,omitempty"`
+	// 适宜分区类型, 为空表示不限
+	ZoneTypes []int32 `protobuf:"varint,8,rep,packed,name=zone_types,json=zoneTypes,proto3" json:"zone_types,omitempty"`
+	// 可种植季节(0-春,1-夏,2-秋,3-冬), 为空表示全年
+	Seasons []int32 `protobuf:"varint,9,rep,packed,name=seasons,proto3" json:"seasons,omitempty"`
+	// 解锁所需土地等级
+	UnlockLevel int32 `protobuf:"varint,10,opt,name=unlock_level,json=unlockLevel,proto3" json:"unlock_level,omitempty"`
+	// 成熟后未收获多久枯萎(分钟), 为0时使用全局配置
+	WitherMinutes int32 `protobuf:"varint,11,opt,name=wither_minutes,json=witherMinutes,proto3" json:"wither_minutes,omitempty"`
+	// 枯萎时退还种植所耗肥力的比例
+	WitherRefund float64 `protobuf:"fixed64,12,opt,name=wither_refund,json=witherRefund,proto3" json:"wither_refund,omitempty"`
+	// 动物产物名称
+	ProductName string `protobuf:"bytes,13,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	// 动物成年后的产物周期(分钟), 为0时与生长时长相同
+	CycleMinutes int32 `protobuf:"varint,14,opt,name=cycle_minutes,json=cycleMinutes,proto3" json:"cycle_minutes,omitempty"`
+	// 可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具
+	FeedCropIds []uint64 `protobuf:"varint,15,rep,packed,name=feed_crop_ids,json=feedCropIds,proto3" json:"feed_crop_ids,omitempty"`
+	// 动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖
+	OffspringArea int32 `protobuf:"varint,16,opt,name=offspring_area,json=offspringArea,proto3" json:"offspring_area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CropAnimal) Reset() {
+	*x = CropAnimal{}
+	mi := &file_metafarm_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CropAnimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CropAnimal) ProtoMessage() {}
+
+func (x *CropAnimal) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CropAnimal.ProtoReflect.Descriptor instead.
+func (*CropAnimal) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CropAnimal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CropAnimal) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetGrowthMinutes() int32 {
+	if x != nil {
+		return x.GrowthMinutes
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetFertilityPerSqm() int32 {
+	if x != nil {
+		return x.FertilityPerSqm
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetBaseYield() float64 {
+	if x != nil {
+		return x.BaseYield
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetLandTypes() []int32 {
+	if x != nil {
+		return x.LandTypes
+	}
+	return nil
+}
+
+func (x *CropAnimal) GetZoneTypes() []int32 {
+	if x != nil {
+		return x.ZoneTypes
+	}
+	return nil
+}
+
+func (x *CropAnimal) GetSeasons() []int32 {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *CropAnimal) GetUnlockLevel() int32 {
+	if x != nil {
+		return x.UnlockLevel
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetWitherMinutes() int32 {
+	if x != nil {
+		return x.WitherMinutes
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetWitherRefund() float64 {
+	if x != nil {
+		return x.WitherRefund
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CropAnimal) GetCycleMinutes() int32 {
+	if x != nil {
+		return x.CycleMinutes
+	}
+	return 0
+}
+
+func (x *CropAnimal) GetFeedCropIds() []uint64 {
+	if x != nil {
+		return x.FeedCropIds
+	}
+	return nil
+}
+
+func (x *CropAnimal) GetOffspringArea() int32 {
+	if x != nil {
+		return x.OffspringArea
+	}
+	return 0
+}
+
+// ListCatalogResponse 作物/动物目录
+type ListCatalogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上架的作物/动物
+	Items         []*CropAnimal `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogResponse) Reset() {
+	*x = ListCatalogResponse{}
+	mi := &file_metafarm_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogResponse) ProtoMessage() {}
+
+func (x *ListCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListCatalogResponse) GetItems() []*CropAnimal {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// HarvestCropRequest 收获作物请求
+type HarvestCropRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HarvestCropRequest) Reset() {
+	*x = HarvestCropRequest{}
+	mi := &file_metafarm_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HarvestCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HarvestCropRequest) ProtoMessage() {}
+
+func (x *HarvestCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HarvestCropRequest.ProtoReflect.Descriptor instead.
+func (*HarvestCropRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HarvestCropRequest) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *HarvestCropRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// HarvestCropResponse 收获作物响应
+type HarvestCropResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 作物/动物ID
+	CropAnimalId uint64 `protobuf:"varint,2,opt,name=crop_animal_id,json=cropAnimalId,proto3" json:"crop_animal_id,omitempty"`
+	// 作物/动物名称
+	CropName string `protobuf:"bytes,3,opt,name=crop_name,json=cropName,proto3" json:"crop_name,omitempty"`
+	// 产量
+	Yield int32 `protobuf:"varint,4,opt,name=yield,proto3" json:"yield,omitempty"`
+	// 获得的经验值
+	Experience    int64 `protobuf:"varint,5,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HarvestCropResponse) Reset() {
+	*x = HarvestCropResponse{}
+	mi := &file_metafarm_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HarvestCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HarvestCropResponse) ProtoMessage() {}
+
+func (x *HarvestCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HarvestCropResponse.ProtoReflect.Descriptor instead.
+func (*HarvestCropResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HarvestCropResponse) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *HarvestCropResponse) GetCropAnimalId() uint64 {
+	if x != nil {
+		return x.CropAnimalId
+	}
+	return 0
+}
+
+func (x *HarvestCropResponse) GetCropName() string {
+	if x != nil {
+		return x.CropName
+	}
+	return ""
+}
+
+func (x *HarvestCropResponse) GetYield() int32 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+func (x *HarvestCropResponse) GetExperience() int64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+// CarePlotRequest 照料地块请求
+type CarePlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 种植活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 水壶、肥料或杀虫剂道具TokenID
+	ItemTokenId int64 `protobuf:"varint,3,opt,name=item_token_id,json=itemTokenId,proto3" json:"item_token_id,omitempty"`
+	// 照料方式(water-浇水,fertilize-施肥,pesticide-除虫)
+	Action        string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CarePlotRequest) Reset() {
+	*x = CarePlotRequest{}
+	mi := &file_metafarm_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarePlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarePlotRequest) ProtoMessage() {}
+
+func (x *CarePlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarePlotRequest.ProtoReflect.Descriptor instead.
+func (*CarePlotRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CarePlotRequest) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *CarePlotRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *CarePlotRequest) GetItemTokenId() int64 {
+	if x != nil {
+		return x.ItemTokenId
+	}
+	return 0
+}
+
+func (x *CarePlotRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// Plot 种植地块的照料状态
+type Plot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 种植活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 所在分区ID
+	ZoneId uint64 `protobuf:"varint,3,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	// 作物名称
+	CropName string `protobuf:"bytes,4,opt,name=crop_name,json=cropName,proto3" json:"crop_name,omitempty"`
+	// 水分等级(0-100)
+	WaterLevel int32 `protobuf:"varint,5,opt,name=water_level,json=waterLevel,proto3" json:"water_level,omitempty"`
+	// 肥料等级(0-100)
+	FertilizerLevel int32 `protobuf:"varint,6,opt,name=fertilizer_level,json=fertilizerLevel,proto3" json:"fertilizer_level,omitempty"`
+	// 虫害等级(0-100)
+	PestLevel int32 `protobuf:"varint,7,opt,name=pest_level,json=pestLevel,proto3" json:"pest_level,omitempty"`
+	// 预计产量
+	ExpectedYield int32 `protobuf:"varint,8,opt,name=expected_yield,json=expectedYield,proto3" json:"expected_yield,omitempty"`
+	// 是否可收获
+	Harvestable   bool `protobuf:"varint,9,opt,name=harvestable,proto3" json:"harvestable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plot) Reset() {
+	*x = Plot{}
+	mi := &file_metafarm_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plot) ProtoMessage() {}
+
+func (x *Plot) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plot.ProtoReflect.Descriptor instead.
+func (*Plot) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Plot) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *Plot) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *Plot) GetZoneId() uint64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *Plot) GetCropName() string {
+	if x != nil {
+		return x.CropName
+	}
+	return ""
+}
+
+func (x *Plot) GetWaterLevel() int32 {
+	if x != nil {
+		return x.WaterLevel
+	}
+	return 0
+}
+
+func (x *Plot) GetFertilizerLevel() int32 {
+	if x != nil {
+		return x.FertilizerLevel
+	}
+	return 0
+}
+
+func (x *Plot) GetPestLevel() int32 {
+	if x != nil {
+		return x.PestLevel
+	}
+	return 0
+}
+
+func (x *Plot) GetExpectedYield() int32 {
+	if x != nil {
+		return x.ExpectedYield
+	}
+	return 0
+}
+
+func (x *Plot) GetHarvestable() bool {
+	if x != nil {
+		return x.Harvestable
+	}
+	return false
+}
+
+// ClearDeadCropsRequest 清理枯萎作物请求
+type ClearDeadCropsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,1,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearDeadCropsRequest) Reset() {
+	*x = ClearDeadCropsRequest{}
+	mi := &file_metafarm_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDeadCropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDeadCropsRequest) ProtoMessage() {}
+
+func (x *ClearDeadCropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDeadCropsRequest.ProtoReflect.Descriptor instead.
+func (*ClearDeadCropsRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ClearDeadCropsRequest) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *ClearDeadCropsRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// ClearDeadCropsResponse 清理枯萎作物响应
+type ClearDeadCropsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 清理的数量
+	Cleared       int64 `protobuf:"varint,1,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearDeadCropsResponse) Reset() {
+	*x = ClearDeadCropsResponse{}
+	mi := &file_metafarm_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDeadCropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDeadCropsResponse) ProtoMessage() {}
+
+func (x *ClearDeadCropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDeadCropsResponse.ProtoReflect.Descriptor instead.
+func (*ClearDeadCropsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ClearDeadCropsResponse) GetCleared() int64 {
+	if x != nil {
+		return x.Cleared
+	}
+	return 0
+}
+
+// Animal 养殖动物的状态
+type Animal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 养殖活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 土地NFT唯一标识
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 所在分区ID
+	ZoneId uint64 `protobuf:"varint,3,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	// 动物ID
+	AnimalId uint64 `protobuf:"varint,4,opt,name=animal_id,json=animalId,proto3" json:"animal_id,omitempty"`
+	// 动物名称
+	AnimalName string `protobuf:"bytes,5,opt,name=animal_name,json=animalName,proto3" json:"animal_name,omitempty"`
+	// 健康值(0-100)
+	Health int32 `protobuf:"varint,6,opt,name=health,proto3" json:"health,omitempty"`
+	// 不再喂养时的饿死时间
+	StarveTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starve_time,json=starveTime,proto3" json:"starve_time,omitempty"`
+	// 下次可收集产物的时间
+	NextCollectTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_collect_time,json=nextCollectTime,proto3" json:"next_collect_time,omitempty"`
+	// 已收集产物次数
+	CollectCount int32 `protobuf:"varint,9,opt,name=collect_count,json=collectCount,proto3" json:"collect_count,omitempty"`
+	// 繁殖出该群动物的养殖活动ID, 放养的为0
+	ParentActivityId uint64 `protobuf:"varint,10,opt,name=parent_activity_id,json=parentActivityId,proto3" json:"parent_activity_id,omitempty"`
+	// 代数
+	Generation    int32 `protobuf:"varint,11,opt,name=generation,proto3" json:"generation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Animal) Reset() {
+	*x = Animal{}
+	mi := &file_metafarm_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Animal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Animal) ProtoMessage() {}
+
+func (x *Animal) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Animal.ProtoReflect.Descriptor instead.
+func (*Animal) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Animal) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *Animal) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *Animal) GetZoneId() uint64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *Animal) GetAnimalId() uint64 {
+	if x != nil {
+		return x.AnimalId
+	}
+	return 0
+}
+
+func (x *Animal) GetAnimalName() string {
+	if x != nil {
+		return x.AnimalName
+	}
+	return ""
+}
+
+func (x *Animal) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *Animal) GetStarveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StarveTime
+	}
+	return nil
+}
+
+func (x *Animal) GetNextCollectTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCollectTime
+	}
+	return nil
+}
+
+func (x *Animal) GetCollectCount() int32 {
+	if x != nil {
+		return x.CollectCount
+	}
+	return 0
+}
+
+func (x *Animal) GetParentActivityId() uint64 {
+	if x != nil {
+		return x.ParentActivityId
+	}
+	return 0
+}
+
+func (x *Animal) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+// ListLandAnimalsResponse 土地上存活的养殖动物
+type ListLandAnimalsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 养殖动物
+	Animals       []*Animal `protobuf:"bytes,1,rep,name=animals,proto3" json:"animals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLandAnimalsResponse) Reset() {
+	*x = ListLandAnimalsResponse{}
+	mi := &file_metafarm_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLandAnimalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLandAnimalsResponse) ProtoMessage() {}
+
+func (x *ListLandAnimalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLandAnimalsResponse.ProtoReflect.Descriptor instead.
+func (*ListLandAnimalsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListLandAnimalsResponse) GetAnimals() []*Animal {
+	if x != nil {
+		return x.Animals
+	}
+	return nil
+}
+
+// FeedAnimalRequest 喂养动物请求, item_token_id与feed_crop_id二选一
+type FeedAnimalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 养殖活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 饲料道具TokenID
+	ItemTokenId int64 `protobuf:"varint,3,opt,name=item_token_id,json=itemTokenId,proto3" json:"item_token_id,omitempty"`
+	// 用作饲料的作物ID
+	FeedCropId uint64 `protobuf:"varint,4,opt,name=feed_crop_id,json=feedCropId,proto3" json:"feed_crop_id,omitempty"`
+	// 作物饲料数量, 默认1
+	Quantity      int32 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedAnimalRequest) Reset() {
+	*x = FeedAnimalRequest{}
+	mi := &file_metafarm_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedAnimalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedAnimalRequest) ProtoMessage() {}
+
+func (x *FeedAnimalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedAnimalRequest.ProtoReflect.Descriptor instead.
+func (*FeedAnimalRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FeedAnimalRequest) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *FeedAnimalRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *FeedAnimalRequest) GetItemTokenId() int64 {
+	if x != nil {
+		return x.ItemTokenId
+	}
+	return 0
+}
+
+func (x *FeedAnimalRequest) GetFeedCropId() uint64 {
+	if x != nil {
+		return x.FeedCropId
+	}
+	return 0
+}
+
+func (x *FeedAnimalRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// AnimalActionRequest 收集产物、繁殖等养殖操作请求
+type AnimalActionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 养殖活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 用户钱包地址
+	UserAddress   string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnimalActionRequest) Reset() {
+	*x = AnimalActionRequest{}
+	mi := &file_metafarm_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnimalActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimalActionRequest) ProtoMessage() {}
+
+func (x *AnimalActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimalActionRequest.ProtoReflect.Descriptor instead.
+func (*AnimalActionRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AnimalActionRequest) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *AnimalActionRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+// CollectProductsResponse 收集产物结果
+type CollectProductsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 收集后的动物
+	Animal *Animal `protobuf:"bytes,1,opt,name=animal,proto3" json:"animal,omitempty"`
+	// 产物名称
+	ProductName string `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	// 产量
+	Yield int32 `protobuf:"varint,3,opt,name=yield,proto3" json:"yield,omitempty"`
+	// 获得的经验值
+	Experience    int64 `protobuf:"varint,4,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectProductsResponse) Reset() {
+	*x = CollectProductsResponse{}
+	mi := &file_metafarm_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectProductsResponse) ProtoMessage() {}
+
+func (x *CollectProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectProductsResponse.ProtoReflect.Descriptor instead.
+func (*CollectProductsResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CollectProductsResponse) GetAnimal() *Animal {
+	if x != nil {
+		return x.Animal
+	}
+	return nil
+}
+
+func (x *CollectProductsResponse) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CollectProductsResponse) GetYield() int32 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+func (x *CollectProductsResponse) GetExperience() int64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+// BreedAnimalResponse 繁殖结果
+type BreedAnimalResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 繁殖后的亲代
+	Parent *Animal `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// 新出生的幼崽
+	Offspring     *Animal `protobuf:"bytes,2,opt,name=offspring,proto3" json:"offspring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreedAnimalResponse) Reset() {
+	*x = BreedAnimalResponse{}
+	mi := &file_metafarm_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedAnimalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedAnimalResponse) ProtoMessage() {}
+
+func (x *BreedAnimalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedAnimalResponse.ProtoReflect.Descriptor instead.
+func (*BreedAnimalResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BreedAnimalResponse) GetParent() *Animal {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *BreedAnimalResponse) GetOffspring() *Animal {
+	if x != nil {
+		return x.Offspring
+	}
+	return nil
+}
+
+// BatchHarvestRequest 一键收获请求
+type BatchHarvestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 收获后是否在原分区补种同一作物
+	Replant       bool `protobuf:"varint,2,opt,name=replant,proto3" json:"replant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchHarvestRequest) Reset() {
+	*x = BatchHarvestRequest{}
+	mi := &file_metafarm_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchHarvestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchHarvestRequest) ProtoMessage() {}
+
+func (x *BatchHarvestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchHarvestRequest.ProtoReflect.Descriptor instead.
+func (*BatchHarvestRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchHarvestRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *BatchHarvestRequest) GetReplant() bool {
+	if x != nil {
+		return x.Replant
+	}
+	return false
+}
+
+// BatchReplantRequest 批量补种请求
+type BatchReplantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户钱包地址
+	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// 已收获的种植活动ID, 一次最多100个
+	ActivityIds   []uint64 `protobuf:"varint,2,rep,packed,name=activity_ids,json=activityIds,proto3" json:"activity_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchReplantRequest) Reset() {
+	*x = BatchReplantRequest{}
+	mi := &file_metafarm_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReplantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReplantRequest) ProtoMessage() {}
+
+func (x *BatchReplantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReplantRequest.ProtoReflect.Descriptor instead.
+func (*BatchReplantRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BatchReplantRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *BatchReplantRequest) GetActivityIds() []uint64 {
+	if x != nil {
+		return x.ActivityIds
+	}
+	return nil
+}
+
+// BatchFarmItem 批量收获/补种中单个活动的结果
+type BatchFarmItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 原活动ID
+	ActivityId uint64 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// 土地NFT TokenID
+	LandTokenId string `protobuf:"bytes,2,opt,name=land_token_id,json=landTokenId,proto3" json:"land_token_id,omitempty"`
+	// 作物名称
+	CropName string `protobuf:"bytes,3,opt,name=crop_name,json=cropName,proto3" json:"crop_name,omitempty"`
+	// 是否已收获
+	Harvested bool `protobuf:"varint,4,opt,name=harvested,proto3" json:"harvested,omitempty"`
+	// 收获产量
+	Yield int32 `protobuf:"varint,5,opt,name=yield,proto3" json:"yield,omitempty"`
+	// 收获获得的经验值
+	Experience int64 `protobuf:"varint,6,opt,name=experience,proto3" json:"experience,omitempty"`
+	// 是否已补种
+	Replanted bool `protobuf:"varint,7,opt,name=replanted,proto3" json:"replanted,omitempty"`
+	// 补种创建的活动ID
+	NewActivityId uint64 `protobuf:"varint,8,opt,name=new_activity_id,json=newActivityId,proto3" json:"new_activity_id,omitempty"`
+	// 失败原因, 为空表示成功
+	Error         string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFarmItem) Reset() {
+	*x = BatchFarmItem{}
+	mi := &file_metafarm_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFarmItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFarmItem) ProtoMessage() {}
+
+func (x *BatchFarmItem) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFarmItem.ProtoReflect.Descriptor instead.
+func (*BatchFarmItem) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BatchFarmItem) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *BatchFarmItem) GetLandTokenId() string {
+	if x != nil {
+		return x.LandTokenId
+	}
+	return ""
+}
+
+func (x *BatchFarmItem) GetCropName() string {
+	if x != nil {
+		return x.CropName
+	}
+	return ""
+}
+
+func (x *BatchFarmItem) GetHarvested() bool {
+	if x != nil {
+		return x.Harvested
+	}
+	return false
+}
+
+func (x *BatchFarmItem) GetYield() int32 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+func (x *BatchFarmItem) GetExperience() int64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *BatchFarmItem) GetReplanted() bool {
+	if x != nil {
+		return x.Replanted
+	}
+	return false
+}
+
+func (x *BatchFarmItem) GetNewActivityId() uint64 {
+	if x != nil {
+		return x.NewActivityId
+	}
+	return 0
+}
+
+func (x *BatchFarmItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BatchFarmResponse 批量收获/补种结果
+type BatchFarmResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功的项数
+	Succeeded int32 `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// 失败的项数
+	Failed int32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// 是否还有未处理的成熟作物
+	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// 各项结果
+	Items         []*BatchFarmItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFarmResponse) Reset() {
+	*x = BatchFarmResponse{}
+	mi := &file_metafarm_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFarmResponse) ProtoMessage() {}
+
+func (x *BatchFarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFarmResponse.ProtoReflect.Descriptor instead.
+func (*BatchFarmResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BatchFarmResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchFarmResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchFarmResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *BatchFarmResponse) GetItems() []*BatchFarmItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// VerifySessionRequest 会话校验请求
+type VerifySessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 玩家的会话令牌
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySessionRequest) Reset() {
+	*x = VerifySessionRequest{}
+	mi := &file_metafarm_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySessionRequest) ProtoMessage() {}
+
+func (x *VerifySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySessionRequest.ProtoReflect.Descriptor instead.
+func (*VerifySessionRequest) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *VerifySessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// VerifySessionResponse 会话校验结果
+type VerifySessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 钱包地址
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// 会话过期时间
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySessionResponse) Reset() {
+	*x = VerifySessionResponse{}
+	mi := &file_metafarm_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySessionResponse) ProtoMessage() {}
+
+func (x *VerifySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metafarm_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySessionResponse.ProtoReflect.Descriptor instead.
+func (*VerifySessionResponse) Descriptor() ([]byte, []int) {
+	return file_metafarm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *VerifySessionResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifySessionResponse) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *VerifySessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_metafarm_proto protoreflect.FileDescriptor
+
+const file_metafarm_proto_rawDesc = "" +
+	"\n" +
+	"\x0emetafarm.proto\x12\vmetafarm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"e\n" +
+	"\vPageRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\"F\n" +
+	"\bPageInfo\x12\x1f\n" +
+	"\vnext_cursor\x18\x01 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"+\n" +
+	"\x0fMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xab\x04\n" +
+	"\n" +
+	"LandDetail\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12\x1b\n" +
+	"\tland_type\x18\x03 \x01(\x05R\blandType\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\x05R\x06rarity\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\x05R\x04area\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\x05R\x05level\x12\x1c\n" +
+	"\tfertility\x18\a \x01(\x05R\tfertility\x12%\n" +
+	"\x0especial_effect\x18\b \x01(\tR\rspecialEffect\x12F\n" +
+	"\x11last_harvest_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0flastHarvestTime\x12!\n" +
+	"\fmetadata_uri\x18\n" +
+	" \x01(\tR\vmetadataUri\x12#\n" +
+	"\rfertility_cap\x18\v \x01(\x05R\ffertilityCap\x12)\n" +
+	"\x10yield_multiplier\x18\f \x01(\x01R\x0fyieldMultiplier\x12%\n" +
+	"\x0eunlocked_zones\x18\r \x03(\x05R\runlockedZones\x12N\n" +
+	"\x15upgrade_complete_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x13upgradeCompleteTime\"\x9f\x02\n" +
+	"\x14ListUserLandsRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.metafarm.v1.PageRequestR\x04page\x12 \n" +
+	"\tland_type\x18\x03 \x01(\x05H\x00R\blandType\x88\x01\x01\x12\x1b\n" +
+	"\x06rarity\x18\x04 \x01(\x05H\x01R\x06rarity\x88\x01\x01\x12 \n" +
+	"\tmin_level\x18\x05 \x01(\x05H\x02R\bminLevel\x88\x01\x01\x12 \n" +
+	"\tmax_level\x18\x06 \x01(\x05H\x03R\bmaxLevel\x88\x01\x01B\f\n" +
+	"\n" +
+	"_land_typeB\t\n" +
+	"\a_rarityB\f\n" +
+	"\n" +
+	"_min_levelB\f\n" +
+	"\n" +
+	"_max_level\"q\n" +
+	"\x15ListUserLandsResponse\x12-\n" +
+	"\x05lands\x18\x01 \x03(\v2\x17.metafarm.v1.LandDetailR\x05lands\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.metafarm.v1.PageInfoR\x04page\":\n" +
+	"\x14GetLandDetailRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\"\x9b\x01\n" +
+	"\rZoneOccupancy\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\x04R\x06zoneId\x12\x1b\n" +
+	"\tzone_type\x18\x02 \x01(\x05R\bzoneType\x12\x12\n" +
+	"\x04area\x18\x03 \x01(\x05R\x04area\x12#\n" +
+	"\roccupied_area\x18\x04 \x01(\x05R\foccupiedArea\x12\x1b\n" +
+	"\tfree_area\x18\x05 \x01(\x05R\bfreeArea\"M\n" +
+	"\x19ListZoneOccupancyResponse\x120\n" +
+	"\x05zones\x18\x01 \x03(\v2\x1a.metafarm.v1.ZoneOccupancyR\x05zones\"j\n" +
+	"\x16ListLandHistoryRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.metafarm.v1.PageRequestR\x04page\"\xb6\x02\n" +
+	"\x10LandHistoryEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x15\n" +
+	"\x06ref_id\x18\x02 \x01(\x04R\x05refId\x129\n" +
+	"\n" +
+	"event_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\"\n" +
+	"\fcounterparty\x18\x05 \x01(\tR\fcounterparty\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1b\n" +
+	"\told_level\x18\b \x01(\x05R\boldLevel\x12\x1b\n" +
+	"\tnew_level\x18\t \x01(\x05R\bnewLevel\x12\x18\n" +
+	"\asubject\x18\n" +
+	" \x01(\tR\asubject\"}\n" +
+	"\x17ListLandHistoryResponse\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.metafarm.v1.LandHistoryEntryR\aentries\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.metafarm.v1.PageInfoR\x04page\"q\n" +
+	"\x12UpgradeLandRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"\xbb\x03\n" +
+	"\vLandUpgrade\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12\x1b\n" +
+	"\told_level\x18\x04 \x01(\x05R\boldLevel\x12\x1b\n" +
+	"\tnew_level\x18\x05 \x01(\x05R\bnewLevel\x12\x1f\n" +
+	"\vcost_tokens\x18\x06 \x01(\x04R\n" +
+	"costTokens\x12#\n" +
+	"\rrefund_tokens\x18\a \x01(\x04R\frefundTokens\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12=\n" +
+	"\fupgrade_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vupgradeTime\x12?\n" +
+	"\rcomplete_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcompleteTime\x12;\n" +
+	"\vfinish_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishTime\"\xa8\x01\n" +
+	"\x15SpeedUpUpgradeRequest\x12\x1d\n" +
+	"\n" +
+	"upgrade_id\x18\x01 \x01(\x04R\tupgradeId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12'\n" +
+	"\ritem_token_id\x18\x03 \x01(\x03H\x00R\vitemTokenId\x88\x01\x01\x12\x12\n" +
+	"\x04uses\x18\x04 \x01(\x05R\x04usesB\x10\n" +
+	"\x0e_item_token_id\"X\n" +
+	"\x14CancelUpgradeRequest\x12\x1d\n" +
+	"\n" +
+	"upgrade_id\x18\x01 \x01(\x04R\tupgradeId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\"<\n" +
+	"\x17ListUpgradeQueueRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\"P\n" +
+	"\x18ListUpgradeQueueResponse\x124\n" +
+	"\bupgrades\x18\x01 \x03(\v2\x18.metafarm.v1.LandUpgradeR\bupgrades\"\xcc\x01\n" +
+	"\x11CreateRentRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12%\n" +
+	"\x0erenter_address\x18\x02 \x01(\tR\rrenterAddress\x12'\n" +
+	"\x0frental_duration\x18\x03 \x01(\x05R\x0erentalDuration\x12 \n" +
+	"\frent_per_sqm\x18\x04 \x01(\x01R\n" +
+	"rentPerSqm\x12!\n" +
+	"\fuser_address\x18\x05 \x01(\tR\vuserAddress\"\xfe\x01\n" +
+	"\x10RentLandResponse\x12\x1b\n" +
+	"\trental_id\x18\x01 \x01(\x04R\brentalId\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12\x1d\n" +
+	"\n" +
+	"total_rent\x18\x03 \x01(\x01R\ttotalRent\x12F\n" +
+	"\x11rental_start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0frentalStartTime\x12B\n" +
+	"\x0frental_end_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rrentalEndTime\"\xc7\x03\n" +
+	"\n" +
+	"LandRental\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12%\n" +
+	"\x0erenter_address\x18\x04 \x01(\tR\rrenterAddress\x12'\n" +
+	"\x0frental_duration\x18\x05 \x01(\x05R\x0erentalDuration\x12.\n" +
+	"\x14rent_per_sqm_per_day\x18\x06 \x01(\x01R\x10rentPerSqmPerDay\x12\x1d\n" +
+	"\n" +
+	"total_rent\x18\a \x01(\x01R\ttotalRent\x12\x1d\n" +
+	"\n" +
+	"system_fee\x18\b \x01(\x01R\tsystemFee\x12\x16\n" +
+	"\x06status\x18\t \x01(\x05R\x06status\x12F\n" +
+	"\x11rental_start_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0frentalStartTime\x12B\n" +
+	"\x0frental_end_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rrentalEndTime\"\xe4\x01\n" +
+	"\x14ListRentLandsRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.metafarm.v1.PageRequestR\x04page\x12'\n" +
+	"\rland_token_id\x18\x03 \x01(\tH\x00R\vlandTokenId\x88\x01\x01\x12,\n" +
+	"\x0frental_duration\x18\x04 \x01(\x05H\x01R\x0erentalDuration\x88\x01\x01B\x10\n" +
+	"\x0e_land_token_idB\x12\n" +
+	"\x10_rental_duration\"s\n" +
+	"\x13ListRentalsResponse\x121\n" +
+	"\arentals\x18\x01 \x03(\v2\x17.metafarm.v1.LandRentalR\arentals\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.metafarm.v1.PageInfoR\x04page\"U\n" +
+	"\x13CancelRentalRequest\x12\x1b\n" +
+	"\trental_id\x18\x01 \x01(\x04R\brentalId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\"\xd1\x01\n" +
+	"\vLandListing\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12%\n" +
+	"\x0eseller_address\x18\x03 \x01(\tR\rsellerAddress\x12\x12\n" +
+	"\x04area\x18\x04 \x01(\x05R\x04area\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12=\n" +
+	"\flisting_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vlistingTime\"\x80\x02\n" +
+	"\x16ListMarketLandsRequest\x12,\n" +
+	"\x04page\x18\x01 \x01(\v2\x18.metafarm.v1.PageRequestR\x04page\x12 \n" +
+	"\tmin_price\x18\x02 \x01(\x01H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x03 \x01(\x01H\x01R\bmaxPrice\x88\x01\x01\x12\x1e\n" +
+	"\bmin_area\x18\x04 \x01(\x05H\x02R\aminArea\x88\x01\x01\x12\x1e\n" +
+	"\bmax_area\x18\x05 \x01(\x05H\x03R\amaxArea\x88\x01\x01B\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\v\n" +
+	"\t_min_areaB\v\n" +
+	"\t_max_area\"z\n" +
+	"\x17ListMarketLandsResponse\x124\n" +
+	"\blistings\x18\x01 \x03(\v2\x18.metafarm.v1.LandListingR\blistings\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.metafarm.v1.PageInfoR\x04page\"\xc5\x05\n" +
+	"\x12SearchLandsRequest\x12,\n" +
+	"\x04page\x18\x01 \x01(\v2\x18.metafarm.v1.PageRequestR\x04page\x12 \n" +
+	"\tland_type\x18\x02 \x01(\x05H\x00R\blandType\x88\x01\x01\x12\x1b\n" +
+	"\x06rarity\x18\x03 \x01(\x05H\x01R\x06rarity\x88\x01\x01\x12 \n" +
+	"\tmin_level\x18\x04 \x01(\x05H\x02R\bminLevel\x88\x01\x01\x12 \n" +
+	"\tmax_level\x18\x05 \x01(\x05H\x03R\bmaxLevel\x88\x01\x01\x12(\n" +
+	"\rmin_fertility\x18\x06 \x01(\x05H\x04R\fminFertility\x88\x01\x01\x12(\n" +
+	"\rmax_fertility\x18\a \x01(\x05H\x05R\fmaxFertility\x88\x01\x01\x12\x1e\n" +
+	"\bmin_area\x18\b \x01(\x05H\x06R\aminArea\x88\x01\x01\x12\x1e\n" +
+	"\bmax_area\x18\t \x01(\x05H\aR\amaxArea\x88\x01\x01\x12*\n" +
+	"\x0especial_effect\x18\n" +
+	" \x01(\tH\bR\rspecialEffect\x88\x01\x01\x12\x1e\n" +
+	"\bfor_sale\x18\v \x01(\bH\tR\aforSale\x88\x01\x01\x12\x1e\n" +
+	"\bfor_rent\x18\f \x01(\bH\n" +
+	"R\aforRent\x88\x01\x01\x12 \n" +
+	"\tmin_price\x18\r \x01(\x01H\vR\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x0e \x01(\x01H\fR\bmaxPrice\x88\x01\x01B\f\n" +
+	"\n" +
+	"_land_typeB\t\n" +
+	"\a_rarityB\f\n" +
+	"\n" +
+	"_min_levelB\f\n" +
+	"\n" +
+	"_max_levelB\x10\n" +
+	"\x0e_min_fertilityB\x10\n" +
+	"\x0e_max_fertilityB\v\n" +
+	"\t_min_areaB\v\n" +
+	"\t_max_areaB\x11\n" +
+	"\x0f_special_effectB\v\n" +
+	"\t_for_saleB\v\n" +
+	"\t_for_rentB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"\x97\x01\n" +
+	"\x0eLandSearchItem\x12+\n" +
+	"\x04land\x18\x01 \x01(\v2\x17.metafarm.v1.LandDetailR\x04land\x12\x1b\n" +
+	"\tmarket_id\x18\x02 \x01(\x04R\bmarketId\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x01H\x00R\x05price\x88\x01\x01\x12\x16\n" +
+	"\x06rented\x18\x04 \x01(\bR\x06rentedB\b\n" +
+	"\x06_price\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"s\n" +
+	"\n" +
+	"LandFacets\x12/\n" +
+	"\x06rarity\x18\x01 \x03(\v2\x17.metafarm.v1.FacetCountR\x06rarity\x124\n" +
+	"\tland_type\x18\x02 \x03(\v2\x17.metafarm.v1.FacetCountR\blandType\"\xa4\x01\n" +
+	"\x13SearchLandsResponse\x121\n" +
+	"\x05lands\x18\x01 \x03(\v2\x1b.metafarm.v1.LandSearchItemR\x05lands\x12/\n" +
+	"\x06facets\x18\x02 \x01(\v2\x17.metafarm.v1.LandFacetsR\x06facets\x12)\n" +
+	"\x04page\x18\x03 \x01(\v2\x15.metafarm.v1.PageInfoR\x04page\"t\n" +
+	"\x1aCreateMarketListingRequest\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12%\n" +
+	"\x0eseller_address\x18\x02 \x01(\tR\rsellerAddress\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\"q\n" +
+	"\x0eBuyLandRequest\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\x04R\bmarketId\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x04R\tlistingId\x12#\n" +
+	"\rbuyer_address\x18\x03 \x01(\tR\fbuyerAddress\"\x95\x01\n" +
+	"\x14FertilizeLandRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\"\n" +
+	"\ritem_token_id\x18\x03 \x01(\x03R\vitemTokenId\x12\x12\n" +
+	"\x04uses\x18\x04 \x01(\x05R\x04uses\"\x86\x01\n" +
+	"\x17UpdateLandLayoutRequest\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12-\n" +
+	"\x05zones\x18\t \x03(\v2\x17.metafarm.v1.LayoutZoneR\x05zones\"\xa7\x02\n" +
+	"\n" +
+	"LayoutZone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04area\x18\x02 \x01(\x05R\x04area\x12\x1b\n" +
+	"\tzone_type\x18\x03 \x01(\x05R\bzoneType\x12\x1d\n" +
+	"\n" +
+	"position_x\x18\x04 \x01(\x05R\tpositionX\x12\x1d\n" +
+	"\n" +
+	"position_y\x18\x05 \x01(\x05R\tpositionY\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12,\n" +
+	"\x12has_adjacent_bonus\x18\b \x01(\bR\x10hasAdjacentBonus\x12\x1d\n" +
+	"\n" +
+	"bonus_type\x18\t \x01(\x05R\tbonusType\x12\x1f\n" +
+	"\vbonus_value\x18\n" +
+	" \x01(\x01R\n" +
+	"bonusValue\"_\n" +
+	"\n" +
+	"LandLayout\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12-\n" +
+	"\x05zones\x18\x02 \x03(\v2\x17.metafarm.v1.LayoutZoneR\x05zones\"\xac\x01\n" +
+	"\x10PlantCropRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12\x17\n" +
+	"\azone_id\x18\x02 \x01(\x04R\x06zoneId\x12$\n" +
+	"\x0ecrop_animal_id\x18\x03 \x01(\x04R\fcropAnimalId\x12!\n" +
+	"\fuser_address\x18\x04 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\x05R\x04area\"\xe7\x02\n" +
+	"\x11PlantCropResponse\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12#\n" +
+	"\ractivity_type\x18\x02 \x01(\x05R\factivityType\x12$\n" +
+	"\x0ecrop_animal_id\x18\x03 \x01(\x04R\fcropAnimalId\x12(\n" +
+	"\x10crop_animal_name\x18\x04 \x01(\tR\x0ecropAnimalName\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\x05R\x04area\x12%\n" +
+	"\x0efertility_cost\x18\x06 \x01(\x05R\rfertilityCost\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12F\n" +
+	"\x11expected_end_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fexpectedEndTime\"6\n" +
+	"\x12ListCatalogRequest\x12\x17\n" +
+	"\x04kind\x18\x01 \x01(\x05H\x00R\x04kind\x88\x01\x01B\a\n" +
+	"\x05_kind\"\x90\x04\n" +
+	"\n" +
+	"CropAnimal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\x05R\x04kind\x12%\n" +
+	"\x0egrowth_minutes\x18\x04 \x01(\x05R\rgrowthMinutes\x12*\n" +
+	"\x11fertility_per_sqm\x18\x05 \x01(\x05R\x0ffertilityPerSqm\x12\x1d\n" +
+	"\n" +
+	"base_yield\x18\x06 \x01(\x01R\tbaseYield\x12\x1d\n" +
+	"\n" +
+	"land_types\x18\a \x03(\x05R\tlandTypes\x12\x1d\n" +
+	"\n" +
+	"zone_types\x18\b \x03(\x05R\tzoneTypes\x12\x18\n" +
+	"\aseasons\x18\t \x03(\x05R\aseasons\x12!\n" +
+	"\funlock_level\x18\n" +
+	" \x01(\x05R\vunlockLevel\x12%\n" +
+	"\x0ewither_minutes\x18\v \x01(\x05R\rwitherMinutes\x12#\n" +
+	"\rwither_refund\x18\f \x01(\x01R\fwitherRefund\x12!\n" +
+	"\fproduct_name\x18\r \x01(\tR\vproductName\x12#\n" +
+	"\rcycle_minutes\x18\x0e \x01(\x05R\fcycleMinutes\x12\"\n" +
+	"\rfeed_crop_ids\x18\x0f \x03(\x04R\vfeedCropIds\x12%\n" +
+	"\x0eoffspring_area\x18\x10 \x01(\x05R\roffspringArea\"D\n" +
+	"\x13ListCatalogResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.metafarm.v1.CropAnimalR\x05items\"X\n" +
+	"\x12HarvestCropRequest\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\"\xaf\x01\n" +
+	"\x13HarvestCropResponse\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12$\n" +
+	"\x0ecrop_animal_id\x18\x02 \x01(\x04R\fcropAnimalId\x12\x1b\n" +
+	"\tcrop_name\x18\x03 \x01(\tR\bcropName\x12\x14\n" +
+	"\x05yield\x18\x04 \x01(\x05R\x05yield\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x05 \x01(\x03R\n" +
+	"experience\"\x91\x01\n" +
+	"\x0fCarePlotRequest\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\"\n" +
+	"\ritem_token_id\x18\x03 \x01(\x03R\vitemTokenId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\"\xb5\x02\n" +
+	"\x04Plot\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12\x17\n" +
+	"\azone_id\x18\x03 \x01(\x04R\x06zoneId\x12\x1b\n" +
+	"\tcrop_name\x18\x04 \x01(\tR\bcropName\x12\x1f\n" +
+	"\vwater_level\x18\x05 \x01(\x05R\n" +
+	"waterLevel\x12)\n" +
+	"\x10fertilizer_level\x18\x06 \x01(\x05R\x0ffertilizerLevel\x12\x1d\n" +
+	"\n" +
+	"pest_level\x18\a \x01(\x05R\tpestLevel\x12%\n" +
+	"\x0eexpected_yield\x18\b \x01(\x05R\rexpectedYield\x12 \n" +
+	"\vharvestable\x18\t \x01(\bR\vharvestable\"^\n" +
+	"\x15ClearDeadCropsRequest\x12\"\n" +
+	"\rland_token_id\x18\x01 \x01(\tR\vlandTokenId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\"2\n" +
+	"\x16ClearDeadCropsResponse\x12\x18\n" +
+	"\acleared\x18\x01 \x01(\x03R\acleared\"\xb4\x03\n" +
+	"\x06Animal\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12\x17\n" +
+	"\azone_id\x18\x03 \x01(\x04R\x06zoneId\x12\x1b\n" +
+	"\tanimal_id\x18\x04 \x01(\x04R\banimalId\x12\x1f\n" +
+	"\vanimal_name\x18\x05 \x01(\tR\n" +
+	"animalName\x12\x16\n" +
+	"\x06health\x18\x06 \x01(\x05R\x06health\x12;\n" +
+	"\vstarve_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"starveTime\x12F\n" +
+	"\x11next_collect_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextCollectTime\x12#\n" +
+	"\rcollect_count\x18\t \x01(\x05R\fcollectCount\x12,\n" +
+	"\x12parent_activity_id\x18\n" +
+	" \x01(\x04R\x10parentActivityId\x12\x1e\n" +
+	"\n" +
+	"generation\x18\v \x01(\x05R\n" +
+	"generation\"H\n" +
+	"\x17ListLandAnimalsResponse\x12-\n" +
+	"\aanimals\x18\x01 \x03(\v2\x13.metafarm.v1.AnimalR\aanimals\"\xb9\x01\n" +
+	"\x11FeedAnimalRequest\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\"\n" +
+	"\ritem_token_id\x18\x03 \x01(\x03R\vitemTokenId\x12 \n" +
+	"\ffeed_crop_id\x18\x04 \x01(\x04R\n" +
+	"feedCropId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"Y\n" +
+	"\x13AnimalActionRequest\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\"\x9f\x01\n" +
+	"\x17CollectProductsResponse\x12+\n" +
+	"\x06animal\x18\x01 \x01(\v2\x13.metafarm.v1.AnimalR\x06animal\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x14\n" +
+	"\x05yield\x18\x03 \x01(\x05R\x05yield\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x04 \x01(\x03R\n" +
+	"experience\"u\n" +
+	"\x13BreedAnimalResponse\x12+\n" +
+	"\x06parent\x18\x01 \x01(\v2\x13.metafarm.v1.AnimalR\x06parent\x121\n" +
+	"\toffspring\x18\x02 \x01(\v2\x13.metafarm.v1.AnimalR\toffspring\"R\n" +
+	"\x13BatchHarvestRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x18\n" +
+	"\areplant\x18\x02 \x01(\bR\areplant\"[\n" +
+	"\x13BatchReplantRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12!\n" +
+	"\factivity_ids\x18\x02 \x03(\x04R\vactivityIds\"\xa1\x02\n" +
+	"\rBatchFarmItem\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x04R\n" +
+	"activityId\x12\"\n" +
+	"\rland_token_id\x18\x02 \x01(\tR\vlandTokenId\x12\x1b\n" +
+	"\tcrop_name\x18\x03 \x01(\tR\bcropName\x12\x1c\n" +
+	"\tharvested\x18\x04 \x01(\bR\tharvested\x12\x14\n" +
+	"\x05yield\x18\x05 \x01(\x05R\x05yield\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x06 \x01(\x03R\n" +
+	"experience\x12\x1c\n" +
+	"\treplanted\x18\a \x01(\bR\treplanted\x12&\n" +
+	"\x0fnew_activity_id\x18\b \x01(\x04R\rnewActivityId\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\x96\x01\n" +
+	"\x11BatchFarmResponse\x12\x1c\n" +
+	"\tsucceeded\x18\x01 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x120\n" +
+	"\x05items\x18\x04 \x03(\v2\x1a.metafarm.v1.BatchFarmItemR\x05items\",\n" +
+	"\x14VerifySessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x92\x01\n" +
+	"\x15VerifySessionResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12%\n" +
+	"\x0ewallet_address\x18\x02 \x01(\tR\rwalletAddress\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\xa7\x1d\n" +
+	"\vLandService\x12m\n" +
+	"\rListUserLands\x12!.metafarm.v1.ListUserLandsRequest\x1a\".metafarm.v1.ListUserLandsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/rpc/v1/lands\x12r\n" +
+	"\rGetLandDetail\x12!.metafarm.v1.GetLandDetailRequest\x1a\x17.metafarm.v1.LandDetail\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/rpc/v1/lands/{land_token_id}\x12u\n" +
+	"\tGetLayout\x12!.metafarm.v1.GetLandDetailRequest\x1a\x17.metafarm.v1.LandLayout\",\x82\xd3\xe4\x93\x02&\x12$/rpc/v1/lands/{land_token_id}/layout\x12\x8b\x01\n" +
+	"\x11ListZoneOccupancy\x12!.metafarm.v1.GetLandDetailRequest\x1a&.metafarm.v1.ListZoneOccupancyResponse\"+\x82\xd3\xe4\x93\x02%\x12#/rpc/v1/lands/{land_token_id}/zones\x12\x8b\x01\n" +
+	"\x0fListLandHistory\x12#.metafarm.v1.ListLandHistoryRequest\x1a$.metafarm.v1.ListLandHistoryResponse\"-\x82\xd3\xe4\x93\x02'\x12%/rpc/v1/lands/{land_token_id}/history\x12z\n" +
+	"\vUpgradeLand\x12\x1f.metafarm.v1.UpgradeLandRequest\x1a\x18.metafarm.v1.LandUpgrade\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/rpc/v1/lands/{land_token_id}/upgrade\x12\x80\x01\n" +
+	"\x0eSpeedUpUpgrade\x12\".metafarm.v1.SpeedUpUpgradeRequest\x1a\x18.metafarm.v1.LandUpgrade\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/rpc/v1/upgrades/{upgrade_id}/speedup\x12}\n" +
+	"\rCancelUpgrade\x12!.metafarm.v1.CancelUpgradeRequest\x1a\x18.metafarm.v1.LandUpgrade\"/\x82\xd3\xe4\x93\x02):\x01*\"$/rpc/v1/upgrades/{upgrade_id}/cancel\x12y\n" +
+	"\x10ListUpgradeQueue\x12$.metafarm.v1.ListUpgradeQueueRequest\x1a%.metafarm.v1.ListUpgradeQueueResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/rpc/v1/upgrades\x12i\n" +
+	"\fCreateRental\x12\x1e.metafarm.v1.CreateRentRequest\x1a\x1d.metafarm.v1.RentLandResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/rpc/v1/rentals\x12k\n" +
+	"\vListRentals\x12!.metafarm.v1.ListRentLandsRequest\x1a .metafarm.v1.ListRentalsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/rpc/v1/rentals\x12}\n" +
+	"\fCancelRental\x12 .metafarm.v1.CancelRentalRequest\x1a\x1c.metafarm.v1.MessageResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/rpc/v1/rentals/{rental_id}/cancel\x12n\n" +
+	"\vSearchLands\x12\x1f.metafarm.v1.SearchLandsRequest\x1a .metafarm.v1.SearchLandsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/rpc/v1/search/lands\x12}\n" +
+	"\x0fListMarketLands\x12#.metafarm.v1.ListMarketLandsRequest\x1a$.metafarm.v1.ListMarketLandsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/rpc/v1/market/listings\x12\x80\x01\n" +
+	"\x13CreateMarketListing\x12'.metafarm.v1.CreateMarketListingRequest\x1a\x1c.metafarm.v1.MessageResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/rpc/v1/market/listings\x12c\n" +
+	"\aBuyLand\x12\x1b.metafarm.v1.BuyLandRequest\x1a\x1c.metafarm.v1.MessageResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/rpc/v1/market/buy\x12y\n" +
+	"\fUpdateLayout\x12$.metafarm.v1.UpdateLandLayoutRequest\x1a\x17.metafarm.v1.LandLayout\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/rpc/v1/lands/{token_id}/layout\x12\x82\x01\n" +
+	"\rPreviewLayout\x12$.metafarm.v1.UpdateLandLayoutRequest\x1a\x17.metafarm.v1.LandLayout\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/rpc/v1/lands/{token_id}/layout/preview\x12\x7f\n" +
+	"\rFertilizeLand\x12!.metafarm.v1.FertilizeLandRequest\x1a\x17.metafarm.v1.LandDetail\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/rpc/v1/lands/{land_token_id}/fertilize\x12i\n" +
+	"\vListCatalog\x12\x1f.metafarm.v1.ListCatalogRequest\x1a .metafarm.v1.ListCatalogResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/rpc/v1/catalog\x12o\n" +
+	"\tPlantCrop\x12\x1d.metafarm.v1.PlantCropRequest\x1a\x1e.metafarm.v1.PlantCropResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/rpc/v1/activities/plant\x12\x85\x01\n" +
+	"\vHarvestCrop\x12\x1f.metafarm.v1.HarvestCropRequest\x1a .metafarm.v1.HarvestCropResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/rpc/v1/activities/{activity_id}/harvest\x12m\n" +
+	"\bCarePlot\x12\x1c.metafarm.v1.CarePlotRequest\x1a\x11.metafarm.v1.Plot\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/rpc/v1/activities/{activity_id}/care\x12\x89\x01\n" +
+	"\x0eClearDeadCrops\x12\".metafarm.v1.ClearDeadCropsRequest\x1a#.metafarm.v1.ClearDeadCropsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/rpc/v1/lands/{land_token_id}/clear\x12}\n" +
+	"\fBatchHarvest\x12 .metafarm.v1.BatchHarvestRequest\x1a\x1e.metafarm.v1.BatchFarmResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /rpc/v1/activities/batch/harvest\x12}\n" +
+	"\fBatchReplant\x12 .metafarm.v1.BatchReplantRequest\x1a\x1e.metafarm.v1.BatchFarmResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /rpc/v1/activities/batch/replant\x12\x89\x01\n" +
+	"\x0fListLandAnimals\x12!.metafarm.v1.GetLandDetailRequest\x1a$.metafarm.v1.ListLandAnimalsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/rpc/v1/lands/{land_token_id}/animals\x12s\n" +
+	"\n" +
+	"FeedAnimal\x12\x1e.metafarm.v1.FeedAnimalRequest\x1a\x13.metafarm.v1.Animal\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/rpc/v1/activities/{activity_id}/feed\x12\x94\x01\n" +
+	"\x15CollectAnimalProducts\x12 .metafarm.v1.AnimalActionRequest\x1a$.metafarm.v1.CollectProductsResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/rpc/v1/activities/{activity_id}/collect\x12\x84\x01\n" +
+	"\vBreedAnimal\x12 .metafarm.v1.AnimalActionRequest\x1a .metafarm.v1.BreedAnimalResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/rpc/v1/activities/{activity_id}/breed2\x8c\x01\n" +
+	"\x0eSessionService\x12z\n" +
+	"\rVerifySession\x12!.metafarm.v1.VerifySessionRequest\x1a\".metafarm.v1.VerifySessionResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/rpc/v1/sessions/verifyB\x1fZ\x1dMetaFarmBackend/api/rpc/pb;pbb\x06proto3"
+
+var (
+	file_metafarm_proto_rawDescOnce sync.Once
+	file_metafarm_proto_rawDescData []byte
+)
+
+func file_metafarm_proto_rawDescGZIP() []byte {
+	file_metafarm_proto_rawDescOnce.Do(func() {
+		file_metafarm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_metafarm_proto_rawDesc), len(file_metafarm_proto_rawDesc)))
+	})
+	return file_metafarm_proto_rawDescData
+}
+
+var file_metafarm_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_metafarm_proto_goTypes = []any{
+	(*PageRequest)(nil),                // 0: metafarm.v1.PageRequest
+	(*PageInfo)(nil),                   // 1: metafarm.v1.PageInfo
+	(*MessageResponse)(nil),            // 2: metafarm.v1.MessageResponse
+	(*LandDetail)(nil),                 // 3: metafarm.v1.LandDetail
+	(*ListUserLandsRequest)(nil),       // 4: metafarm.v1.ListUserLandsRequest
+	(*ListUserLandsResponse)(nil),      // 5: metafarm.v1.ListUserLandsResponse
+	(*GetLandDetailRequest)(nil),       // 6: metafarm.v1.GetLandDetailRequest
+	(*ZoneOccupancy)(nil),              // 7: metafarm.v1.ZoneOccupancy
+	(*ListZoneOccupancyResponse)(nil),  // 8: metafarm.v1.ListZoneOccupancyResponse
+	(*ListLandHistoryRequest)(nil),     // 9: metafarm.v1.ListLandHistoryRequest
+	(*LandHistoryEntry)(nil),           // 10: metafarm.v1.LandHistoryEntry
+	(*ListLandHistoryResponse)(nil),    // 11: metafarm.v1.ListLandHistoryResponse
+	(*UpgradeLandRequest)(nil),         // 12: metafarm.v1.UpgradeLandRequest
+	(*LandUpgrade)(nil),                // 13: metafarm.v1.LandUpgrade
+	(*SpeedUpUpgradeRequest)(nil),      // 14: metafarm.v1.SpeedUpUpgradeRequest
+	(*CancelUpgradeRequest)(nil),       // 15: metafarm.v1.CancelUpgradeRequest
+	(*ListUpgradeQueueRequest)(nil),    // 16: metafarm.v1.ListUpgradeQueueRequest
+	(*ListUpgradeQueueResponse)(nil),   // 17: metafarm.v1.ListUpgradeQueueResponse
+	(*CreateRentRequest)(nil),          // 18: metafarm.v1.CreateRentRequest
+	(*RentLandResponse)(nil),           // 19: metafarm.v1.RentLandResponse
+	(*LandRental)(nil),                 // 20: metafarm.v1.LandRental
+	(*ListRentLandsRequest)(nil),       // 21: metafarm.v1.ListRentLandsRequest
+	(*ListRentalsResponse)(nil),        // 22: metafarm.v1.ListRentalsResponse
+	(*CancelRentalRequest)(nil),        // 23: metafarm.v1.CancelRentalRequest
+	(*LandListing)(nil),                // 24: metafarm.v1.LandListing
+	(*ListMarketLandsRequest)(nil),     // 25: metafarm.v1.ListMarketLandsRequest
+	(*ListMarketLandsResponse)(nil),    // 26: metafarm.v1.ListMarketLandsResponse
+	(*SearchLandsRequest)(nil),         // 27: metafarm.v1.SearchLandsRequest
+	(*LandSearchItem)(nil),             // 28: metafarm.v1.LandSearchItem
+	(*FacetCount)(nil),                 // 29: metafarm.v1.FacetCount
+	(*LandFacets)(nil),                 // 30: metafarm.v1.LandFacets
+	(*SearchLandsResponse)(nil),        // 31: metafarm.v1.SearchLandsResponse
+	(*CreateMarketListingRequest)(nil), // 32: metafarm.v1.CreateMarketListingRequest
+	(*BuyLandRequest)(nil),             // 33: metafarm.v1.BuyLandRequest
+	(*FertilizeLandRequest)(nil),       // 34: metafarm.v1.FertilizeLandRequest
+	(*UpdateLandLayoutRequest)(nil),    // 35: metafarm.v1.UpdateLandLayoutRequest
+	(*LayoutZone)(nil),                 // 36: metafarm.v1.LayoutZone
+	(*LandLayout)(nil),                 // 37: metafarm.v1.LandLayout
+	(*PlantCropRequest)(nil),           // 38: metafarm.v1.PlantCropRequest
+	(*PlantCropResponse)(nil),          // 39: metafarm.v1.PlantCropResponse
+	(*ListCatalogRequest)(nil),         // 40: metafarm.v1.ListCatalogRequest
+	(*CropAnimal)(nil),                 // 41: metafarm.v1.CropAnimal
+	(*ListCatalogResponse)(nil),        // 42: metafarm.v1.ListCatalogResponse
+	(*HarvestCropRequest)(nil),         // 43: metafarm.v1.HarvestCropRequest
+	(*HarvestCropResponse)(nil),        // 44: metafarm.v1.HarvestCropResponse
+	(*CarePlotRequest)(nil),            // 45: metafarm.v1.CarePlotRequest
+	(*Plot)(nil),                       // 46: metafarm.v1.Plot
+	(*ClearDeadCropsRequest)(nil),      // 47: metafarm.v1.ClearDeadCropsRequest
+	(*ClearDeadCropsResponse)(nil),     // 48: metafarm.v1.ClearDeadCropsResponse
+	(*Animal)(nil),                     // 49: metafarm.v1.Animal
+	(*ListLandAnimalsResponse)(nil),    // 50: metafarm.v1.ListLandAnimalsResponse
+	(*FeedAnimalRequest)(nil),          // 51: metafarm.v1.FeedAnimalRequest
+	(*AnimalActionRequest)(nil),        // 52: metafarm.v1.AnimalActionRequest
+	(*CollectProductsResponse)(nil),    // 53: metafarm.v1.CollectProductsResponse
+	(*BreedAnimalResponse)(nil),        // 54: metafarm.v1.BreedAnimalResponse
+	(*BatchHarvestRequest)(nil),        // 55: metafarm.v1.BatchHarvestRequest
+	(*BatchReplantRequest)(nil),        // 56: metafarm.v1.BatchReplantRequest
+	(*BatchFarmItem)(nil),              // 57: metafarm.v1.BatchFarmItem
+	(*BatchFarmResponse)(nil),          // 58: metafarm.v1.BatchFarmResponse
+	(*VerifySessionRequest)(nil),       // 59: metafarm.v1.VerifySessionRequest
+	(*VerifySessionResponse)(nil),      // 60: metafarm.v1.VerifySessionResponse
+	(*timestamppb.Timestamp)(nil),      // 61: google.protobuf.Timestamp
+}
+var file_metafarm_proto_depIdxs = []int32{
+	61, // 0: metafarm.v1.LandDetail.last_harvest_time:type_name -> google.protobuf.Timestamp
+	61, // 1: metafarm.v1.LandDetail.upgrade_complete_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: metafarm.v1.ListUserLandsRequest.page:type_name -> metafarm.v1.PageRequest
+	3,  // 3: metafarm.v1.ListUserLandsResponse.lands:type_name -> metafarm.v1.LandDetail
+	1,  // 4: metafarm.v1.ListUserLandsResponse.page:type_name -> metafarm.v1.PageInfo
+	7,  // 5: metafarm.v1.ListZoneOccupancyResponse.zones:type_name -> metafarm.v1.ZoneOccupancy
+	0,  // 6: metafarm.v1.ListLandHistoryRequest.page:type_name -> metafarm.v1.PageRequest
+	61, // 7: metafarm.v1.LandHistoryEntry.event_time:type_name -> google.protobuf.Timestamp
+	10, // 8: metafarm.v1.ListLandHistoryResponse.entries:type_name -> metafarm.v1.LandHistoryEntry
+	1,  // 9: metafarm.v1.ListLandHistoryResponse.page:type_name -> metafarm.v1.PageInfo
+	61, // 10: metafarm.v1.LandUpgrade.upgrade_time:type_name -> google.protobuf.Timestamp
+	61, // 11: metafarm.v1.LandUpgrade.complete_time:type_name -> google.protobuf.Timestamp
+	61, // 12: metafarm.v1.LandUpgrade.finish_time:type_name -> google.protobuf.Timestamp
+	13, // 13: metafarm.v1.ListUpgradeQueueResponse.upgrades:type_name -> metafarm.v1.LandUpgrade
+	61, // 14: metafarm.v1.RentLandResponse.rental_start_time:type_name -> google.protobuf.Timestamp
+	61, // 15: metafarm.v1.RentLandResponse.rental_end_time:type_name -> google.protobuf.Timestamp
+	61, // 16: metafarm.v1.LandRental.rental_start_time:type_name -> google.protobuf.Timestamp
+	61, // 17: metafarm.v1.LandRental.rental_end_time:type_name -> google.protobuf.Timestamp
+	0,  // 18: metafarm.v1.ListRentLandsRequest.page:type_name -> metafarm.v1.PageRequest
+	20, // 19: metafarm.v1.ListRentalsResponse.rentals:type_name -> metafarm.v1.LandRental
+	1,  // 20: metafarm.v1.ListRentalsResponse.page:type_name -> metafarm.v1.PageInfo
+	61, // 21: metafarm.v1.LandListing.listing_time:type_name -> google.protobuf.Timestamp
+	0,  // 22: metafarm.v1.ListMarketLandsRequest.page:type_name -> metafarm.v1.PageRequest
+	24, // 23: metafarm.v1.ListMarketLandsResponse.listings:type_name -> metafarm.v1.LandListing
+	1,  // 24: metafarm.v1.ListMarketLandsResponse.page:type_name -> metafarm.v1.PageInfo
+	0,  // 25: metafarm.v1.SearchLandsRequest.page:type_name -> metafarm.v1.PageRequest
+	3,  // 26: metafarm.v1.LandSearchItem.land:type_name -> metafarm.v1.LandDetail
+	29, // 27: metafarm.v1.LandFacets.rarity:type_name -> metafarm.v1.FacetCount
+	29, // 28: metafarm.v1.LandFacets.land_type:type_name -> metafarm.v1.FacetCount
+	28, // 29: metafarm.v1.SearchLandsResponse.lands:type_name -> metafarm.v1.LandSearchItem
+	30, // 30: metafarm.v1.SearchLandsResponse.facets:type_name -> metafarm.v1.LandFacets
+	1,  // 31: metafarm.v1.SearchLandsResponse.page:type_name -> metafarm.v1.PageInfo
+	36, // 32: metafarm.v1.UpdateLandLayoutRequest.zones:type_name -> metafarm.v1.LayoutZone
+	36, // 33: metafarm.v1.LandLayout.zones:type_name -> metafarm.v1.LayoutZone
+	61, // 34: metafarm.v1.PlantCropResponse.start_time:type_name -> google.protobuf.Timestamp
+	61, // 35: metafarm.v1.PlantCropResponse.expected_end_time:type_name -> google.protobuf.Timestamp
+	41, // 36: metafarm.v1.ListCatalogResponse.items:type_name -> metafarm.v1.CropAnimal
+	61, // 37: metafarm.v1.Animal.starve_time:type_name -> google.protobuf.Timestamp
+	61, // 38: metafarm.v1.Animal.next_collect_time:type_name -> google.protobuf.Timestamp
+	49, // 39: metafarm.v1.ListLandAnimalsResponse.animals:type_name -> metafarm.v1.Animal
+	49, // 40: metafarm.v1.CollectProductsResponse.animal:type_name -> metafarm.v1.Animal
+	49, // 41: metafarm.v1.BreedAnimalResponse.parent:type_name -> metafarm.v1.Animal
+	49, // 42: metafarm.v1.BreedAnimalResponse.offspring:type_name -> metafarm.v1.Animal
+	57, // 43: metafarm.v1.BatchFarmResponse.items:type_name -> metafarm.v1.BatchFarmItem
+	61, // 44: metafarm.v1.VerifySessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 45: metafarm.v1.LandService.ListUserLands:input_type -> metafarm.v1.ListUserLandsRequest
+	6,  // 46: metafarm.v1.LandService.GetLandDetail:input_type -> metafarm.v1.GetLandDetailRequest
+	6,  // 47: metafarm.v1.LandService.GetLayout:input_type -> metafarm.v1.GetLandDetailRequest
+	6,  // 48: metafarm.v1.LandService.ListZoneOccupancy:input_type -> metafarm.v1.GetLandDetailRequest
+	9,  // 49: metafarm.v1.LandService.ListLandHistory:input_type -> metafarm.v1.ListLandHistoryRequest
+	12, // 50: metafarm.v1.LandService.UpgradeLand:input_type -> metafarm.v1.UpgradeLandRequest
+	14, // 51: metafarm.v1.LandService.SpeedUpUpgrade:input_type -> metafarm.v1.SpeedUpUpgradeRequest
+	15, // 52: metafarm.v1.LandService.CancelUpgrade:input_type -> metafarm.v1.CancelUpgradeRequest
+	16, // 53: metafarm.v1.LandService.ListUpgradeQueue:input_type -> metafarm.v1.ListUpgradeQueueRequest
+	18, // 54: metafarm.v1.LandService.CreateRental:input_type -> metafarm.v1.CreateRentRequest
+	21, // 55: metafarm.v1.LandService.ListRentals:input_type -> metafarm.v1.ListRentLandsRequest
+	23, // 56: metafarm.v1.LandService.CancelRental:input_type -> metafarm.v1.CancelRentalRequest
+	27, // 57: metafarm.v1.LandService.SearchLands:input_type -> metafarm.v1.SearchLandsRequest
+	25, // 58: metafarm.v1.LandService.ListMarketLands:input_type -> metafarm.v1.ListMarketLandsRequest
+	32, // 59: metafarm.v1.LandService.CreateMarketListing:input_type -> metafarm.v1.CreateMarketListingRequest
+	33, // 60: metafarm.v1.LandService.BuyLand:input_type -> metafarm.v1.BuyLandRequest
+	35, // 61: metafarm.v1.LandService.UpdateLayout:input_type -> metafarm.v1.UpdateLandLayoutRequest
+	35, // 62: metafarm.v1.LandService.PreviewLayout:input_type -> metafarm.v1.UpdateLandLayoutRequest
+	34, // 63: metafarm.v1.LandService.FertilizeLand:input_type -> metafarm.v1.FertilizeLandRequest
+	40, // 64: metafarm.v1.LandService.ListCatalog:input_type -> metafarm.v1.ListCatalogRequest
+	38, // 65: metafarm.v1.LandService.PlantCrop:input_type -> metafarm.v1.PlantCropRequest
+	43, // 66: metafarm.v1.LandService.HarvestCrop:input_type -> metafarm.v1.HarvestCropRequest
+	45, // 67: metafarm.v1.LandService.CarePlot:input_type -> metafarm.v1.CarePlotRequest
+	47, // 68: metafarm.v1.LandService.ClearDeadCrops:input_type -> metafarm.v1.ClearDeadCropsRequest
+	55, // 69: metafarm.v1.LandService.BatchHarvest:input_type -> metafarm.v1.BatchHarvestRequest
+	56, // 70: metafarm.v1.LandService.BatchReplant:input_type -> metafarm.v1.BatchReplantRequest
+	6,  // 71: metafarm.v1.LandService.ListLandAnimals:input_type -> metafarm.v1.GetLandDetailRequest
+	51, // 72: metafarm.v1.LandService.FeedAnimal:input_type -> metafarm.v1.FeedAnimalRequest
+	52, // 73: metafarm.v1.LandService.CollectAnimalProducts:input_type -> metafarm.v1.AnimalActionRequest
+	52, // 74: metafarm.v1.LandService.BreedAnimal:input_type -> metafarm.v1.AnimalActionRequest
+	59, // 75: metafarm.v1.SessionService.VerifySession:input_type -> metafarm.v1.VerifySessionRequest
+	5,  // 76: metafarm.v1.LandService.ListUserLands:output_type -> metafarm.v1.ListUserLandsResponse
+	3,  // 77: metafarm.v1.LandService.GetLandDetail:output_type -> metafarm.v1.LandDetail
+	37, // 78: metafarm.v1.LandService.GetLayout:output_type -> metafarm.v1.LandLayout
+	8,  // 79: metafarm.v1.LandService.ListZoneOccupancy:output_type -> metafarm.v1.ListZoneOccupancyResponse
+	11, // 80: metafarm.v1.LandService.ListLandHistory:output_type -> metafarm.v1.ListLandHistoryResponse
+	13, // 81: metafarm.v1.LandService.UpgradeLand:output_type -> metafarm.v1.LandUpgrade
+	13, // 82: metafarm.v1.LandService.SpeedUpUpgrade:output_type -> metafarm.v1.LandUpgrade
+	13, // 83: metafarm.v1.LandService.CancelUpgrade:output_type -> metafarm.v1.LandUpgrade
+	17, // 84: metafarm.v1.LandService.ListUpgradeQueue:output_type -> metafarm.v1.ListUpgradeQueueResponse
+	19, // 85: metafarm.v1.LandService.CreateRental:output_type -> metafarm.v1.RentLandResponse
+	22, // 86: metafarm.v1.LandService.ListRentals:output_type -> metafarm.v1.ListRentalsResponse
+	2,  // 87: metafarm.v1.LandService.CancelRental:output_type -> metafarm.v1.MessageResponse
+	31, // 88: metafarm.v1.LandService.SearchLands:output_type -> metafarm.v1.SearchLandsResponse
+	26, // 89: metafarm.v1.LandService.ListMarketLands:output_type -> metafarm.v1.ListMarketLandsResponse
+	2,  // 90: metafarm.v1.LandService.CreateMarketListing:output_type -> metafarm.v1.MessageResponse
+	2,  // 91: metafarm.v1.LandService.BuyLand:output_type -> metafarm.v1.MessageResponse
+	37, // 92: metafarm.v1.LandService.UpdateLayout:output_type -> metafarm.v1.LandLayout
+	37, // 93: metafarm.v1.LandService.PreviewLayout:output_type -> metafarm.v1.LandLayout
+	3,  // 94: metafarm.v1.LandService.FertilizeLand:output_type -> metafarm.v1.LandDetail
+	42, // 95: metafarm.v1.LandService.ListCatalog:output_type -> metafarm.v1.ListCatalogResponse
+	39, // 96: metafarm.v1.LandService.PlantCrop:output_type -> metafarm.v1.PlantCropResponse
+	44, // 97: metafarm.v1.LandService.HarvestCrop:output_type -> metafarm.v1.HarvestCropResponse
+	46, // 98: metafarm.v1.LandService.CarePlot:output_type -> metafarm.v1.Plot
+	48, // 99: metafarm.v1.LandService.ClearDeadCrops:output_type -> metafarm.v1.ClearDeadCropsResponse
+	58, // 100: metafarm.v1.LandService.BatchHarvest:output_type -> metafarm.v1.BatchFarmResponse
+	58, // 101: metafarm.v1.LandService.BatchReplant:output_type -> metafarm.v1.BatchFarmResponse
+	50, // 102: metafarm.v1.LandService.ListLandAnimals:output_type -> metafarm.v1.ListLandAnimalsResponse
+	49, // 103: metafarm.v1.LandService.FeedAnimal:output_type -> metafarm.v1.Animal
+	53, // 104: metafarm.v1.LandService.CollectAnimalProducts:output_type -> metafarm.v1.CollectProductsResponse
+	54, // 105: metafarm.v1.LandService.BreedAnimal:output_type -> metafarm.v1.BreedAnimalResponse
+	60, // 106: metafarm.v1.SessionService.VerifySession:output_type -> metafarm.v1.VerifySessionResponse
+	76, // [76:107] is the sub-list for method output_type
+	45, // [45:76] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_metafarm_proto_init() }
+func file_metafarm_proto_init() {
+	if File_metafarm_proto != nil {
+		return
+	}
+	file_metafarm_proto_msgTypes[4].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[14].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[21].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[25].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[27].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[28].OneofWrappers = []any{}
+	file_metafarm_proto_msgTypes[40].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_metafarm_proto_rawDesc), len(file_metafarm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   61,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_metafarm_proto_goTypes,
+		DependencyIndexes: file_metafarm_proto_depIdxs,
+		MessageInfos:      file_metafarm_proto_msgTypes,
+	}.Build()
+	File_metafarm_proto = out.File
+	file_metafarm_proto_goTypes = nil
+	file_metafarm_proto_depIdxs = nil
+}
@@ -0,0 +1,1397 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: metafarm.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LandService_ListUserLands_FullMethodName         = "/metafarm.v1.LandService/ListUserLands"
+	LandService_GetLandDetail_FullMethodName         = "/metafarm.v1.LandService/GetLandDetail"
+	LandService_GetLayout_FullMethodName             = "/metafarm.v1.LandService/GetLayout"
+	LandService_ListZoneOccupancy_FullMethodName     = "/metafarm.v1.LandService/ListZoneOccupancy"
+	LandService_ListLandHistory_FullMethodName       = "/metafarm.v1.LandService/ListLandHistory"
+	LandService_UpgradeLand_FullMethodName           = "/metafarm.v1.LandService/UpgradeLand"
+	LandService_SpeedUpUpgrade_FullMethodName        = "/metafarm.v1.LandService/SpeedUpUpgrade"
+	LandService_CancelUpgrade_FullMethodName         = "/metafarm.v1.LandService/CancelUpgrade"
+	LandService_ListUpgradeQueue_FullMethodName      = "/metafarm.v1.LandService/ListUpgradeQueue"
+	LandService_CreateRental_FullMethodName          = "/metafarm.v1.LandService/CreateRental"
+	LandService_ListRentals_FullMethodName           = "/metafarm.v1.LandService/ListRentals"
+	LandService_CancelRental_FullMethodName          = "/metafarm.v1.LandService/CancelRental"
+	LandService_SearchLands_FullMethodName           = "/metafarm.v1.LandService/SearchLands"
+	LandService_ListMarketLands_FullMethodName       = "/metafarm.v1.LandService/ListMarketLands"
+	LandService_CreateMarketListing_FullMethodName   = "/metafarm.v1.LandService/CreateMarketListing"
+	LandService_BuyLand_FullMethodName               = "/metafarm.v1.LandService/BuyLand"
+	LandService_UpdateLayout_FullMethodName          = "/metafarm.v1.LandService/UpdateLayout"
+	LandService_PreviewLayout_FullMethodName         = "/metafarm.v1.LandService/PreviewLayout"
+	LandService_FertilizeLand_FullMethodName         = "/metafarm.v1.LandService/FertilizeLand"
+	LandService_ListCatalog_FullMethodName           = "/metafarm.v1.LandService/ListCatalog"
+	LandService_PlantCrop_FullMethodName             = "/metafarm.v1.LandService/PlantCrop"
+	LandService_HarvestCrop_FullMethodName           = "/metafarm.v1.LandService/HarvestCrop"
+	LandService_CarePlot_FullMethodName              = "/metafarm.v1.LandService/CarePlot"
+	LandService_ClearDeadCrops_FullMethodName        = "/metafarm.v1.LandService/ClearDeadCrops"
+	LandService_BatchHarvest_FullMethodName          = "/metafarm.v1.LandService/BatchHarvest"
+	LandService_BatchReplant_FullMethodName          = "/metafarm.v1.LandService/BatchReplant"
+	LandService_ListLandAnimals_FullMethodName       = "/metafarm.v1.LandService/ListLandAnimals"
+	LandService_FeedAnimal_FullMethodName            = "/metafarm.v1.LandService/FeedAnimal"
+	LandService_CollectAnimalProducts_FullMethodName = "/metafarm.v1.LandService/CollectAnimalProducts"
+	LandService_BreedAnimal_FullMethodName           = "/metafarm.v1.LandService/BreedAnimal"
+)
+
+// LandServiceClient is the client API for LandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LandService 土地系统接口, 与/api/v1/land下的HTTP接口一一对应
+// 使用会话令牌调用时, 请求中的用户地址以会话钱包地址为准; 使用API Key调用时信任请求中的用户地址
+type LandServiceClient interface {
+	// 分页获取用户拥有的土地
+	ListUserLands(ctx context.Context, in *ListUserLandsRequest, opts ...grpc.CallOption) (*ListUserLandsResponse, error)
+	// 获取土地详情
+	GetLandDetail(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*LandDetail, error)
+	// 获取土地的分区布局
+	GetLayout(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*LandLayout, error)
+	// 获取土地各分区的占用面积与剩余面积
+	ListZoneOccupancy(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*ListZoneOccupancyResponse, error)
+	// 分页获取土地历史时间线
+	ListLandHistory(ctx context.Context, in *ListLandHistoryRequest, opts ...grpc.CallOption) (*ListLandHistoryResponse, error)
+	// 升级土地, 返回施工中的升级记录
+	UpgradeLand(ctx context.Context, in *UpgradeLandRequest, opts ...grpc.CallOption) (*LandUpgrade, error)
+	// 使用道具或MFG加速升级施工
+	SpeedUpUpgrade(ctx context.Context, in *SpeedUpUpgradeRequest, opts ...grpc.CallOption) (*LandUpgrade, error)
+	// 取消升级施工并部分退款
+	CancelUpgrade(ctx context.Context, in *CancelUpgradeRequest, opts ...grpc.CallOption) (*LandUpgrade, error)
+	// 获取用户施工中的升级队列
+	ListUpgradeQueue(ctx context.Context, in *ListUpgradeQueueRequest, opts ...grpc.CallOption) (*ListUpgradeQueueResponse, error)
+	// 创建土地租赁订单
+	CreateRental(ctx context.Context, in *CreateRentRequest, opts ...grpc.CallOption) (*RentLandResponse, error)
+	// 分页获取租客的活跃租赁订单
+	ListRentals(ctx context.Context, in *ListRentLandsRequest, opts ...grpc.CallOption) (*ListRentalsResponse, error)
+	// 取消土地租赁
+	CancelRental(ctx context.Context, in *CancelRentalRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// 按属性、出售及租赁状态分页搜索全部土地, 首页返回分面统计
+	SearchLands(ctx context.Context, in *SearchLandsRequest, opts ...grpc.CallOption) (*SearchLandsResponse, error)
+	// 分页获取待出售的土地挂牌
+	ListMarketLands(ctx context.Context, in *ListMarketLandsRequest, opts ...grpc.CallOption) (*ListMarketLandsResponse, error)
+	// 创建土地挂牌
+	CreateMarketListing(ctx context.Context, in *CreateMarketListingRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// 购买土地
+	BuyLand(ctx context.Context, in *BuyLandRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// 更新土地布局
+	UpdateLayout(ctx context.Context, in *UpdateLandLayoutRequest, opts ...grpc.CallOption) (*LandLayout, error)
+	// 预览布局调整后的分区相邻加成, 不保存
+	PreviewLayout(ctx context.Context, in *UpdateLandLayoutRequest, opts ...grpc.CallOption) (*LandLayout, error)
+	// 使用肥料恢复土地肥力
+	FertilizeLand(ctx context.Context, in *FertilizeLandRequest, opts ...grpc.CallOption) (*LandDetail, error)
+	// 获取上架的作物/动物目录
+	ListCatalog(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*ListCatalogResponse, error)
+	// 种植作物
+	PlantCrop(ctx context.Context, in *PlantCropRequest, opts ...grpc.CallOption) (*PlantCropResponse, error)
+	// 收获作物
+	HarvestCrop(ctx context.Context, in *HarvestCropRequest, opts ...grpc.CallOption) (*HarvestCropResponse, error)
+	// 浇水、施肥或除虫
+	CarePlot(ctx context.Context, in *CarePlotRequest, opts ...grpc.CallOption) (*Plot, error)
+	// 清理土地上枯萎的作物
+	ClearDeadCrops(ctx context.Context, in *ClearDeadCropsRequest, opts ...grpc.CallOption) (*ClearDeadCropsResponse, error)
+	// 收获用户所有土地上已成熟的作物, 可选在原分区补种, 每项独立成功或失败
+	BatchHarvest(ctx context.Context, in *BatchHarvestRequest, opts ...grpc.CallOption) (*BatchFarmResponse, error)
+	// 在已收获活动的原分区重新种植同一作物, 每项独立成功或失败
+	BatchReplant(ctx context.Context, in *BatchReplantRequest, opts ...grpc.CallOption) (*BatchFarmResponse, error)
+	// 获取土地上存活的养殖动物
+	ListLandAnimals(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*ListLandAnimalsResponse, error)
+	// 使用饲料道具或作物收获物喂养动物
+	FeedAnimal(ctx context.Context, in *FeedAnimalRequest, opts ...grpc.CallOption) (*Animal, error)
+	// 收集动物本周期的产物
+	CollectAnimalProducts(ctx context.Context, in *AnimalActionRequest, opts ...grpc.CallOption) (*CollectProductsResponse, error)
+	// 繁殖动物, 幼崽作为新的养殖活动放入同一分区
+	BreedAnimal(ctx context.Context, in *AnimalActionRequest, opts ...grpc.CallOption) (*BreedAnimalResponse, error)
+}
+
+type landServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLandServiceClient(cc grpc.ClientConnInterface) LandServiceClient {
+	return &landServiceClient{cc}
+}
+
+func (c *landServiceClient) ListUserLands(ctx context.Context, in *ListUserLandsRequest, opts ...grpc.CallOption) (*ListUserLandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserLandsResponse)
+	err := c.cc.Invoke(ctx, LandService_ListUserLands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) GetLandDetail(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*LandDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandDetail)
+	err := c.cc.Invoke(ctx, LandService_GetLandDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) GetLayout(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*LandLayout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandLayout)
+	err := c.cc.Invoke(ctx, LandService_GetLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListZoneOccupancy(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*ListZoneOccupancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZoneOccupancyResponse)
+	err := c.cc.Invoke(ctx, LandService_ListZoneOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListLandHistory(ctx context.Context, in *ListLandHistoryRequest, opts ...grpc.CallOption) (*ListLandHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLandHistoryResponse)
+	err := c.cc.Invoke(ctx, LandService_ListLandHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) UpgradeLand(ctx context.Context, in *UpgradeLandRequest, opts ...grpc.CallOption) (*LandUpgrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandUpgrade)
+	err := c.cc.Invoke(ctx, LandService_UpgradeLand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) SpeedUpUpgrade(ctx context.Context, in *SpeedUpUpgradeRequest, opts ...grpc.CallOption) (*LandUpgrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandUpgrade)
+	err := c.cc.Invoke(ctx, LandService_SpeedUpUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CancelUpgrade(ctx context.Context, in *CancelUpgradeRequest, opts ...grpc.CallOption) (*LandUpgrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandUpgrade)
+	err := c.cc.Invoke(ctx, LandService_CancelUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListUpgradeQueue(ctx context.Context, in *ListUpgradeQueueRequest, opts ...grpc.CallOption) (*ListUpgradeQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpgradeQueueResponse)
+	err := c.cc.Invoke(ctx, LandService_ListUpgradeQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CreateRental(ctx context.Context, in *CreateRentRequest, opts ...grpc.CallOption) (*RentLandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RentLandResponse)
+	err := c.cc.Invoke(ctx, LandService_CreateRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListRentals(ctx context.Context, in *ListRentLandsRequest, opts ...grpc.CallOption) (*ListRentalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRentalsResponse)
+	err := c.cc.Invoke(ctx, LandService_ListRentals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CancelRental(ctx context.Context, in *CancelRentalRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, LandService_CancelRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) SearchLands(ctx context.Context, in *SearchLandsRequest, opts ...grpc.CallOption) (*SearchLandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLandsResponse)
+	err := c.cc.Invoke(ctx, LandService_SearchLands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListMarketLands(ctx context.Context, in *ListMarketLandsRequest, opts ...grpc.CallOption) (*ListMarketLandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMarketLandsResponse)
+	err := c.cc.Invoke(ctx, LandService_ListMarketLands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CreateMarketListing(ctx context.Context, in *CreateMarketListingRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, LandService_CreateMarketListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) BuyLand(ctx context.Context, in *BuyLandRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, LandService_BuyLand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) UpdateLayout(ctx context.Context, in *UpdateLandLayoutRequest, opts ...grpc.CallOption) (*LandLayout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandLayout)
+	err := c.cc.Invoke(ctx, LandService_UpdateLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) PreviewLayout(ctx context.Context, in *UpdateLandLayoutRequest, opts ...grpc.CallOption) (*LandLayout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandLayout)
+	err := c.cc.Invoke(ctx, LandService_PreviewLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) FertilizeLand(ctx context.Context, in *FertilizeLandRequest, opts ...grpc.CallOption) (*LandDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LandDetail)
+	err := c.cc.Invoke(ctx, LandService_FertilizeLand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListCatalog(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*ListCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCatalogResponse)
+	err := c.cc.Invoke(ctx, LandService_ListCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) PlantCrop(ctx context.Context, in *PlantCropRequest, opts ...grpc.CallOption) (*PlantCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlantCropResponse)
+	err := c.cc.Invoke(ctx, LandService_PlantCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) HarvestCrop(ctx context.Context, in *HarvestCropRequest, opts ...grpc.CallOption) (*HarvestCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HarvestCropResponse)
+	err := c.cc.Invoke(ctx, LandService_HarvestCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CarePlot(ctx context.Context, in *CarePlotRequest, opts ...grpc.CallOption) (*Plot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Plot)
+	err := c.cc.Invoke(ctx, LandService_CarePlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ClearDeadCrops(ctx context.Context, in *ClearDeadCropsRequest, opts ...grpc.CallOption) (*ClearDeadCropsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearDeadCropsResponse)
+	err := c.cc.Invoke(ctx, LandService_ClearDeadCrops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) BatchHarvest(ctx context.Context, in *BatchHarvestRequest, opts ...grpc.CallOption) (*BatchFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFarmResponse)
+	err := c.cc.Invoke(ctx, LandService_BatchHarvest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) BatchReplant(ctx context.Context, in *BatchReplantRequest, opts ...grpc.CallOption) (*BatchFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFarmResponse)
+	err := c.cc.Invoke(ctx, LandService_BatchReplant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) ListLandAnimals(ctx context.Context, in *GetLandDetailRequest, opts ...grpc.CallOption) (*ListLandAnimalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLandAnimalsResponse)
+	err := c.cc.Invoke(ctx, LandService_ListLandAnimals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) FeedAnimal(ctx context.Context, in *FeedAnimalRequest, opts ...grpc.CallOption) (*Animal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Animal)
+	err := c.cc.Invoke(ctx, LandService_FeedAnimal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) CollectAnimalProducts(ctx context.Context, in *AnimalActionRequest, opts ...grpc.CallOption) (*CollectProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectProductsResponse)
+	err := c.cc.Invoke(ctx, LandService_CollectAnimalProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landServiceClient) BreedAnimal(ctx context.Context, in *AnimalActionRequest, opts ...grpc.CallOption) (*BreedAnimalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BreedAnimalResponse)
+	err := c.cc.Invoke(ctx, LandService_BreedAnimal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LandServiceServer is the server API for LandService service.
+// All implementations must embed UnimplementedLandServiceServer
+// for forward compatibility.
+//
+// LandService 土地系统接口, 与/api/v1/land下的HTTP接口一一对应
+// 使用会话令牌调用时, 请求中的用户地址以会话钱包地址为准; 使用API Key调用时信任请求中的用户地址
+type LandServiceServer interface {
+	// 分页获取用户拥有的土地
+	ListUserLands(context.Context, *ListUserLandsRequest) (*ListUserLandsResponse, error)
+	// 获取土地详情
+	GetLandDetail(context.Context, *GetLandDetailRequest) (*LandDetail, error)
+	// 获取土地的分区布局
+	GetLayout(context.Context, *GetLandDetailRequest) (*LandLayout, error)
+	// 获取土地各分区的占用面积与剩余面积
+	ListZoneOccupancy(context.Context, *GetLandDetailRequest) (*ListZoneOccupancyResponse, error)
+	// 分页获取土地历史时间线
+	ListLandHistory(context.Context, *ListLandHistoryRequest) (*ListLandHistoryResponse, error)
+	// 升级土地, 返回施工中的升级记录
+	UpgradeLand(context.Context, *UpgradeLandRequest) (*LandUpgrade, error)
+	// 使用道具或MFG加速升级施工
+	SpeedUpUpgrade(context.Context, *SpeedUpUpgradeRequest) (*LandUpgrade, error)
+	// 取消升级施工并部分退款
+	CancelUpgrade(context.Context, *CancelUpgradeRequest) (*LandUpgrade, error)
+	// 获取用户施工中的升级队列
+	ListUpgradeQueue(context.Context, *ListUpgradeQueueRequest) (*ListUpgradeQueueResponse, error)
+	// 创建土地租赁订单
+	CreateRental(context.Context, *CreateRentRequest) (*RentLandResponse, error)
+	// 分页获取租客的活跃租赁订单
+	ListRentals(context.Context, *ListRentLandsRequest) (*ListRentalsResponse, error)
+	// 取消土地租赁
+	CancelRental(context.Context, *CancelRentalRequest) (*MessageResponse, error)
+	// 按属性、出售及租赁状态分页搜索全部土地, 首页返回分面统计
+	SearchLands(context.Context, *SearchLandsRequest) (*SearchLandsResponse, error)
+	// 分页获取待出售的土地挂牌
+	ListMarketLands(context.Context, *ListMarketLandsRequest) (*ListMarketLandsResponse, error)
+	// 创建土地挂牌
+	CreateMarketListing(context.Context, *CreateMarketListingRequest) (*MessageResponse, error)
+	// 购买土地
+	BuyLand(context.Context, *BuyLandRequest) (*MessageResponse, error)
+	// 更新土地布局
+	UpdateLayout(context.Context, *UpdateLandLayoutRequest) (*LandLayout, error)
+	// 预览布局调整后的分区相邻加成, 不保存
+	PreviewLayout(context.Context, *UpdateLandLayoutRequest) (*LandLayout, error)
+	// 使用肥料恢复土地肥力
+	FertilizeLand(context.Context, *FertilizeLandRequest) (*LandDetail, error)
+	// 获取上架的作物/动物目录
+	ListCatalog(context.Context, *ListCatalogRequest) (*ListCatalogResponse, error)
+	// 种植作物
+	PlantCrop(context.Context, *PlantCropRequest) (*PlantCropResponse, error)
+	// 收获作物
+	HarvestCrop(context.Context, *HarvestCropRequest) (*HarvestCropResponse, error)
+	// 浇水、施肥或除虫
+	CarePlot(context.Context, *CarePlotRequest) (*Plot, error)
+	// 清理土地上枯萎的作物
+	ClearDeadCrops(context.Context, *ClearDeadCropsRequest) (*ClearDeadCropsResponse, error)
+	// 收获用户所有土地上已成熟的作物, 可选在原分区补种, 每项独立成功或失败
+	BatchHarvest(context.Context, *BatchHarvestRequest) (*BatchFarmResponse, error)
+	// 在已收获活动的原分区重新种植同一作物, 每项独立成功或失败
+	BatchReplant(context.Context, *BatchReplantRequest) (*BatchFarmResponse, error)
+	// 获取土地上存活的养殖动物
+	ListLandAnimals(context.Context, *GetLandDetailRequest) (*ListLandAnimalsResponse, error)
+	// 使用饲料道具或作物收获物喂养动物
+	FeedAnimal(context.Context, *FeedAnimalRequest) (*Animal, error)
+	// 收集动物本周期的产物
+	CollectAnimalProducts(context.Context, *AnimalActionRequest) (*CollectProductsResponse, error)
+	// 繁殖动物, 幼崽作为新的养殖活动放入同一分区
+	BreedAnimal(context.Context, *AnimalActionRequest) (*BreedAnimalResponse, error)
+	mustEmbedUnimplementedLandServiceServer()
+}
+
+// UnimplementedLandServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLandServiceServer struct{}
+
+func (UnimplementedLandServiceServer) ListUserLands(context.Context, *ListUserLandsRequest) (*ListUserLandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserLands not implemented")
+}
+func (UnimplementedLandServiceServer) GetLandDetail(context.Context, *GetLandDetailRequest) (*LandDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLandDetail not implemented")
+}
+func (UnimplementedLandServiceServer) GetLayout(context.Context, *GetLandDetailRequest) (*LandLayout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLayout not implemented")
+}
+func (UnimplementedLandServiceServer) ListZoneOccupancy(context.Context, *GetLandDetailRequest) (*ListZoneOccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZoneOccupancy not implemented")
+}
+func (UnimplementedLandServiceServer) ListLandHistory(context.Context, *ListLandHistoryRequest) (*ListLandHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLandHistory not implemented")
+}
+func (UnimplementedLandServiceServer) UpgradeLand(context.Context, *UpgradeLandRequest) (*LandUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeLand not implemented")
+}
+func (UnimplementedLandServiceServer) SpeedUpUpgrade(context.Context, *SpeedUpUpgradeRequest) (*LandUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpeedUpUpgrade not implemented")
+}
+func (UnimplementedLandServiceServer) CancelUpgrade(context.Context, *CancelUpgradeRequest) (*LandUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
+func (UnimplementedLandServiceServer) ListUpgradeQueue(context.Context, *ListUpgradeQueueRequest) (*ListUpgradeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpgradeQueue not implemented")
+}
+func (UnimplementedLandServiceServer) CreateRental(context.Context, *CreateRentRequest) (*RentLandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRental not implemented")
+}
+func (UnimplementedLandServiceServer) ListRentals(context.Context, *ListRentLandsRequest) (*ListRentalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRentals not implemented")
+}
+func (UnimplementedLandServiceServer) CancelRental(context.Context, *CancelRentalRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRental not implemented")
+}
+func (UnimplementedLandServiceServer) SearchLands(context.Context, *SearchLandsRequest) (*SearchLandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLands not implemented")
+}
+func (UnimplementedLandServiceServer) ListMarketLands(context.Context, *ListMarketLandsRequest) (*ListMarketLandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketLands not implemented")
+}
+func (UnimplementedLandServiceServer) CreateMarketListing(context.Context, *CreateMarketListingRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarketListing not implemented")
+}
+func (UnimplementedLandServiceServer) BuyLand(context.Context, *BuyLandRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLand not implemented")
+}
+func (UnimplementedLandServiceServer) UpdateLayout(context.Context, *UpdateLandLayoutRequest) (*LandLayout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLayout not implemented")
+}
+func (UnimplementedLandServiceServer) PreviewLayout(context.Context, *UpdateLandLayoutRequest) (*LandLayout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewLayout not implemented")
+}
+func (UnimplementedLandServiceServer) FertilizeLand(context.Context, *FertilizeLandRequest) (*LandDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FertilizeLand not implemented")
+}
+func (UnimplementedLandServiceServer) ListCatalog(context.Context, *ListCatalogRequest) (*ListCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalog not implemented")
+}
+func (UnimplementedLandServiceServer) PlantCrop(context.Context, *PlantCropRequest) (*PlantCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlantCrop not implemented")
+}
+func (UnimplementedLandServiceServer) HarvestCrop(context.Context, *HarvestCropRequest) (*HarvestCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HarvestCrop not implemented")
+}
+func (UnimplementedLandServiceServer) CarePlot(context.Context, *CarePlotRequest) (*Plot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CarePlot not implemented")
+}
+func (UnimplementedLandServiceServer) ClearDeadCrops(context.Context, *ClearDeadCropsRequest) (*ClearDeadCropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearDeadCrops not implemented")
+}
+func (UnimplementedLandServiceServer) BatchHarvest(context.Context, *BatchHarvestRequest) (*BatchFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchHarvest not implemented")
+}
+func (UnimplementedLandServiceServer) BatchReplant(context.Context, *BatchReplantRequest) (*BatchFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReplant not implemented")
+}
+func (UnimplementedLandServiceServer) ListLandAnimals(context.Context, *GetLandDetailRequest) (*ListLandAnimalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLandAnimals not implemented")
+}
+func (UnimplementedLandServiceServer) FeedAnimal(context.Context, *FeedAnimalRequest) (*Animal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedAnimal not implemented")
+}
+func (UnimplementedLandServiceServer) CollectAnimalProducts(context.Context, *AnimalActionRequest) (*CollectProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAnimalProducts not implemented")
+}
+func (UnimplementedLandServiceServer) BreedAnimal(context.Context, *AnimalActionRequest) (*BreedAnimalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreedAnimal not implemented")
+}
+func (UnimplementedLandServiceServer) mustEmbedUnimplementedLandServiceServer() {}
+func (UnimplementedLandServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeLandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LandServiceServer will
+// result in compilation errors.
+type UnsafeLandServiceServer interface {
+	mustEmbedUnimplementedLandServiceServer()
+}
+
+func RegisterLandServiceServer(s grpc.ServiceRegistrar, srv LandServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLandServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LandService_ServiceDesc, srv)
+}
+
+func _LandService_ListUserLands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserLandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListUserLands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListUserLands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListUserLands(ctx, req.(*ListUserLandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_GetLandDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLandDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).GetLandDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_GetLandDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).GetLandDetail(ctx, req.(*GetLandDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_GetLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLandDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).GetLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_GetLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).GetLayout(ctx, req.(*GetLandDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListZoneOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLandDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListZoneOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListZoneOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListZoneOccupancy(ctx, req.(*GetLandDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListLandHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLandHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListLandHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListLandHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListLandHistory(ctx, req.(*ListLandHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_UpgradeLand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeLandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).UpgradeLand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_UpgradeLand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).UpgradeLand(ctx, req.(*UpgradeLandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_SpeedUpUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpeedUpUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).SpeedUpUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_SpeedUpUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).SpeedUpUpgrade(ctx, req.(*SpeedUpUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CancelUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CancelUpgrade(ctx, req.(*CancelUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListUpgradeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpgradeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListUpgradeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListUpgradeQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListUpgradeQueue(ctx, req.(*ListUpgradeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CreateRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CreateRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CreateRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CreateRental(ctx, req.(*CreateRentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListRentals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRentLandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListRentals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListRentals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListRentals(ctx, req.(*ListRentLandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CancelRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CancelRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CancelRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CancelRental(ctx, req.(*CancelRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_SearchLands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).SearchLands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_SearchLands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).SearchLands(ctx, req.(*SearchLandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListMarketLands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketLandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListMarketLands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListMarketLands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListMarketLands(ctx, req.(*ListMarketLandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CreateMarketListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMarketListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CreateMarketListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CreateMarketListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CreateMarketListing(ctx, req.(*CreateMarketListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_BuyLand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyLandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).BuyLand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_BuyLand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).BuyLand(ctx, req.(*BuyLandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_UpdateLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLandLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).UpdateLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_UpdateLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).UpdateLayout(ctx, req.(*UpdateLandLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_PreviewLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLandLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).PreviewLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_PreviewLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).PreviewLayout(ctx, req.(*UpdateLandLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_FertilizeLand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FertilizeLandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).FertilizeLand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_FertilizeLand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).FertilizeLand(ctx, req.(*FertilizeLandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListCatalog(ctx, req.(*ListCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_PlantCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlantCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).PlantCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_PlantCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).PlantCrop(ctx, req.(*PlantCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_HarvestCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HarvestCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).HarvestCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_HarvestCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).HarvestCrop(ctx, req.(*HarvestCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CarePlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarePlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CarePlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CarePlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CarePlot(ctx, req.(*CarePlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ClearDeadCrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearDeadCropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ClearDeadCrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ClearDeadCrops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ClearDeadCrops(ctx, req.(*ClearDeadCropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_BatchHarvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchHarvestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).BatchHarvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_BatchHarvest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).BatchHarvest(ctx, req.(*BatchHarvestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_BatchReplant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReplantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).BatchReplant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_BatchReplant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).BatchReplant(ctx, req.(*BatchReplantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_ListLandAnimals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLandDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).ListLandAnimals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_ListLandAnimals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).ListLandAnimals(ctx, req.(*GetLandDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_FeedAnimal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedAnimalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).FeedAnimal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_FeedAnimal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).FeedAnimal(ctx, req.(*FeedAnimalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_CollectAnimalProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnimalActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).CollectAnimalProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_CollectAnimalProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).CollectAnimalProducts(ctx, req.(*AnimalActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandService_BreedAnimal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnimalActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandServiceServer).BreedAnimal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandService_BreedAnimal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandServiceServer).BreedAnimal(ctx, req.(*AnimalActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LandService_ServiceDesc is the grpc.ServiceDesc for LandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "metafarm.v1.LandService",
+	HandlerType: (*LandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUserLands",
+			Handler:    _LandService_ListUserLands_Handler,
+		},
+		{
+			MethodName: "GetLandDetail",
+			Handler:    _LandService_GetLandDetail_Handler,
+		},
+		{
+			MethodName: "GetLayout",
+			Handler:    _LandService_GetLayout_Handler,
+		},
+		{
+			MethodName: "ListZoneOccupancy",
+			Handler:    _LandService_ListZoneOccupancy_Handler,
+		},
+		{
+			MethodName: "ListLandHistory",
+			Handler:    _LandService_ListLandHistory_Handler,
+		},
+		{
+			MethodName: "UpgradeLand",
+			Handler:    _LandService_UpgradeLand_Handler,
+		},
+		{
+			MethodName: "SpeedUpUpgrade",
+			Handler:    _LandService_SpeedUpUpgrade_Handler,
+		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _LandService_CancelUpgrade_Handler,
+		},
+		{
+			MethodName: "ListUpgradeQueue",
+			Handler:    _LandService_ListUpgradeQueue_Handler,
+		},
+		{
+			MethodName: "CreateRental",
+			Handler:    _LandService_CreateRental_Handler,
+		},
+		{
+			MethodName: "ListRentals",
+			Handler:    _LandService_ListRentals_Handler,
+		},
+		{
+			MethodName: "CancelRental",
+			Handler:    _LandService_CancelRental_Handler,
+		},
+		{
+			MethodName: "SearchLands",
+			Handler:    _LandService_SearchLands_Handler,
+		},
+		{
+			MethodName: "ListMarketLands",
+			Handler:    _LandService_ListMarketLands_Handler,
+		},
+		{
+			MethodName: "CreateMarketListing",
+			Handler:    _LandService_CreateMarketListing_Handler,
+		},
+		{
+			MethodName: "BuyLand",
+			Handler:    _LandService_BuyLand_Handler,
+		},
+		{
+			MethodName: "UpdateLayout",
+			Handler:    _LandService_UpdateLayout_Handler,
+		},
+		{
+			MethodName: "PreviewLayout",
+			Handler:    _LandService_PreviewLayout_Handler,
+		},
+		{
+			MethodName: "FertilizeLand",
+			Handler:    _LandService_FertilizeLand_Handler,
+		},
+		{
+			MethodName: "ListCatalog",
+			Handler:    _LandService_ListCatalog_Handler,
+		},
+		{
+			MethodName: "PlantCrop",
+			Handler:    _LandService_PlantCrop_Handler,
+		},
+		{
+			MethodName: "HarvestCrop",
+			Handler:    _LandService_HarvestCrop_Handler,
+		},
+		{
+			MethodName: "CarePlot",
+			Handler:    _LandService_CarePlot_Handler,
+		},
+		{
+			MethodName: "ClearDeadCrops",
+			Handler:    _LandService_ClearDeadCrops_Handler,
+		},
+		{
+			MethodName: "BatchHarvest",
+			Handler:    _LandService_BatchHarvest_Handler,
+		},
+		{
+			MethodName: "BatchReplant",
+			Handler:    _LandService_BatchReplant_Handler,
+		},
+		{
+			MethodName: "ListLandAnimals",
+			Handler:    _LandService_ListLandAnimals_Handler,
+		},
+		{
+			MethodName: "FeedAnimal",
+			Handler:    _LandService_FeedAnimal_Handler,
+		},
+		{
+			MethodName: "CollectAnimalProducts",
+			Handler:    _LandService_CollectAnimalProducts_Handler,
+		},
+		{
+			MethodName: "BreedAnimal",
+			Handler:    _LandService_BreedAnimal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "metafarm.proto",
+}
+
+const (
+	SessionService_VerifySession_FullMethodName = "/metafarm.v1.SessionService/VerifySession"
+)
+
+// SessionServiceClient is the client API for SessionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SessionService 会话校验接口, 供游戏服务器校验玩家会话令牌, 仅允许API Key调用
+type SessionServiceClient interface {
+	// 校验会话令牌并返回对应的用户
+	VerifySession(ctx context.Context, in *VerifySessionRequest, opts ...grpc.CallOption) (*VerifySessionResponse, error)
+}
+
+type sessionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionServiceClient(cc grpc.ClientConnInterface) SessionServiceClient {
+	return &sessionServiceClient{cc}
+}
+
+func (c *sessionServiceClient) VerifySession(ctx context.Context, in *VerifySessionRequest, opts ...grpc.CallOption) (*VerifySessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_VerifySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionServiceServer is the server API for SessionService service.
+// All implementations must embed UnimplementedSessionServiceServer
+// for forward compatibility.
+//
+// SessionService 会话校验接口, 供游戏服务器校验玩家会话令牌, 仅允许API Key调用
+type SessionServiceServer interface {
+	// 校验会话令牌并返回对应的用户
+	VerifySession(context.Context, *VerifySessionRequest) (*VerifySessionResponse, error)
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+// UnimplementedSessionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSessionServiceServer struct{}
+
+func (UnimplementedSessionServiceServer) VerifySession(context.Context, *VerifySessionRequest) (*VerifySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySession not implemented")
+}
+func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
+func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionServiceServer will
+// result in compilation errors.
+type UnsafeSessionServiceServer interface {
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+func RegisterSessionServiceServer(s grpc.ServiceRegistrar, srv SessionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSessionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SessionService_ServiceDesc, srv)
+}
+
+func _SessionService_VerifySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).VerifySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_VerifySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).VerifySession(ctx, req.(*VerifySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "metafarm.v1.SessionService",
+	HandlerType: (*SessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifySession",
+			Handler:    _SessionService_VerifySession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "metafarm.proto",
+}
@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"MetaFarmBackend/api/rpc/pb"
+	"MetaFarmBackend/component/apikey"
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/service"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// 生成代码需要googleapis的google/api/annotations.proto, 通过-I指定其所在目录
+//go:generate protoc -I proto -I third_party/googleapis --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative --grpc-gateway_out=pb --grpc-gateway_opt=paths=source_relative metafarm.proto
+
+// 优雅停机等待进行中调用完成的最长时间
+const gracefulStopTimeout = 10 * time.Second
+
+// Server gRPC服务, 作为后台任务与HTTP服务一同启动和停止
+type Server struct {
+	addr   string
+	server *grpc.Server
+	health *health.Server
+}
+
+// NewServer 创建gRPC服务并注册土地服务、会话校验服务及健康检查
+func NewServer(cfg config.GRPCConfig, landService service.LandService, catalogService service.CatalogService, walletAuthService service.WalletAuthService, keys *apikey.Verifier) *Server {
+	auth := NewAuthenticator(walletAuthService, keys)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoveryUnaryInterceptor, auth.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(auth.StreamInterceptor()),
+	)
+	pb.RegisterLandServiceServer(server, newLandServer(landService, catalogService))
+	pb.RegisterSessionServiceServer(server, newSessionServer(walletAuthService))
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	return &Server{addr: ListenAddr(cfg.Port), server: server, health: healthServer}
+}
+
+func (s *Server) Name() string {
+	return "grpc"
+}
+
+// Run 监听端口并提供服务, ctx取消后优雅停机, 超时则强制关闭
+func (s *Server) Run(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return errors.Wrap(err, "gRPC监听端口失败")
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Infof("gRPC服务启动: %s", s.addr)
+		serveErr <- s.server.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return errors.Wrap(err, "gRPC服务异常退出")
+	case <-ctx.Done():
+	}
+
+	s.health.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(gracefulStopTimeout):
+		logger.Errorf("gRPC服务停机超时, 强制关闭")
+		s.server.Stop()
+	}
+	return nil
+}
+
+// ListenAddr 兼容"9090"与":9090"两种端口配置
+func ListenAddr(port string) string {
+	if strings.Contains(port, ":") {
+		return port
+	}
+	return ":" + port
+}
+
+// recoveryUnaryInterceptor 捕获处理过程中的panic, 避免单次调用导致进程退出
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("gRPC调用panic: %s, err: %v", info.FullMethod, r)
+			err = toStatus(errors.Errorf("panic: %v", r))
+		}
+	}()
+	return handler(ctx, req)
+}
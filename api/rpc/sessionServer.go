@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+
+	"MetaFarmBackend/api/rpc/pb"
+	"MetaFarmBackend/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionServer gRPC会话校验服务
+type sessionServer struct {
+	pb.UnimplementedSessionServiceServer
+	walletAuthService service.WalletAuthService
+}
+
+// 构造函数
+func newSessionServer(walletAuthService service.WalletAuthService) *sessionServer {
+	return &sessionServer{walletAuthService: walletAuthService}
+}
+
+// VerifySession 校验玩家会话令牌
+func (s *sessionServer) VerifySession(ctx context.Context, in *pb.VerifySessionRequest) (*pb.VerifySessionResponse, error) {
+	if in.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少会话令牌")
+	}
+	session, err := s.walletAuthService.VerifySessionToken(ctx, in.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "会话无效或已过期")
+	}
+	return &pb.VerifySessionResponse{
+		UserId:        session.UserID,
+		WalletAddress: session.WalletAddress,
+		ExpiresAt:     timestamppb.New(session.ExpiresAt),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package apikey
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+
+	"MetaFarmBackend/component/config"
+)
+
+// client 已配置的调用方, 只保存API Key摘要
+type client struct {
+	name   string
+	admin  bool
+	digest [sha256.Size]byte
+}
+
+// Verifier 校验第三方调用方的API Key, HTTP与gRPC共用
+type Verifier struct {
+	clients []client
+}
+
+// NewVerifier 根据配置创建校验器, 忽略空Key
+func NewVerifier(keys []config.APIKeyConfig) *Verifier {
+	v := &Verifier{}
+	for _, k := range keys {
+		if k.Key == "" {
+			continue
+		}
+		v.clients = append(v.clients, client{name: k.Name, admin: k.Admin, digest: sha256.Sum256([]byte(k.Key))})
+	}
+	return v
+}
+
+// Verify 校验API Key, 通过时返回调用方名称
+func (v *Verifier) Verify(key string) (string, bool) {
+	c, ok := v.match(key)
+	return c.name, ok
+}
+
+// VerifyAdmin 校验API Key且调用方允许调用管理接口
+func (v *Verifier) VerifyAdmin(key string) (string, bool) {
+	c, ok := v.match(key)
+	return c.name, ok && c.admin
+}
+
+// match 比较摘要并遍历全部调用方, 避免时序侧信道
+func (v *Verifier) match(key string) (client, bool) {
+	if key == "" {
+		return client{}, false
+	}
+	digest := sha256.Sum256([]byte(key))
+	matched, ok := client{}, false
+	for _, c := range v.clients {
+		if subtle.ConstantTimeCompare(digest[:], c.digest[:]) == 1 {
+			matched, ok = c, true
+		}
+	}
+	return matched, ok
+}
@@ -81,6 +81,11 @@ func (e *EthClient) BlockNumber(ctx context.Context) (*big.Int, error) {
 	return header.Number, nil
 }
 
+// LatestHeader 获取最新区块头
+func (e *EthClient) LatestHeader(ctx context.Context) (*types.Header, error) {
+	return e.client.HeaderByNumber(ctx, nil)
+}
+
 // GetBlockByNumber 根据区块号获取区块
 func (e *EthClient) GetBlockByNumber(ctx context.Context, number *big.Int) (interface{}, error) {
 	ethBlock, err := e.client.BlockByNumber(ctx, number)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/zksync-sdk/zksync2-go/accounts"
@@ -108,6 +109,11 @@ func (z *ZkSync2Client) BlockNumber(ctx context.Context) (*big.Int, error) {
 	return header.Number, nil
 }
 
+// LatestHeader 获取最新区块头
+func (z *ZkSync2Client) LatestHeader(ctx context.Context) (*types.Header, error) {
+	return z.client.HeaderByNumber(ctx, nil)
+}
+
 // GetBlockByNumber 根据区块号获取区块
 func (z *ZkSync2Client) GetBlockByNumber(ctx context.Context, number *big.Int) (interface{}, error) {
 	zkBlock, err := z.client.BlockByNumber(ctx, number)
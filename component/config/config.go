@@ -9,9 +9,24 @@ type ProjectConfig struct {
 }
 
 type ApiConfig struct {
-	Port       string `mapstructure:"port"`
-	MaxNum     int    `mapstructure:"max_num"`
-	SessionTTL int    `mapstructure:"session_ttl"`
+	Port          string `mapstructure:"port"`
+	MaxNum        int    `mapstructure:"max_num"`
+	SessionTTL    int    `mapstructure:"session_ttl"`
+	AllowDegraded bool   `mapstructure:"allow_degraded"` // 链节点不可用时是否以降级模式启动
+	LegacySunset  string `mapstructure:"legacy_sunset"`  // 旧版未带版本号路径的下线日期(YYYY-MM-DD)
+
+	ReadTimeout     int `mapstructure:"read_timeout"`     // 读取请求超时(秒)
+	WriteTimeout    int `mapstructure:"write_timeout"`    // 写响应超时(秒)
+	IdleTimeout     int `mapstructure:"idle_timeout"`     // keep-alive空闲超时(秒)
+	ShutdownTimeout int `mapstructure:"shutdown_timeout"` // 优雅停机等待时间(秒)
+}
+
+// HealthConfig 健康检查配置
+type HealthConfig struct {
+	CheckTimeout  int `mapstructure:"check_timeout"`    // 单项依赖检查超时(毫秒)
+	L1MaxBlockAge int `mapstructure:"l1_max_block_age"` // L1最新区块允许的最大延迟(秒)
+	L2MaxBlockAge int `mapstructure:"l2_max_block_age"` // L2最新区块允许的最大延迟(秒)
+	CheckInterval int `mapstructure:"check_interval"`   // 后台刷新依赖状态的间隔(秒), 链相关接口按最近一次结果判断链是否可用
 }
 
 type LogConfig struct {
@@ -89,6 +104,100 @@ type MetadataParse struct {
 	BridgeAddress string `mapstructure:"bridge_address"`
 }
 
+// RateLimitConfig 限流配置
+type RateLimitConfig struct {
+	Enabled bool            `mapstructure:"enabled"` // 是否启用限流
+	Rules   []RateLimitRule `mapstructure:"rules"`   // 按路由前缀配置的限流规则, 未命中时使用api.max_num作为默认规则
+}
+
+// RateLimitRule 令牌桶限流规则
+type RateLimitRule struct {
+	Name     string  `mapstructure:"name"`     // 规则名称(用于区分令牌桶)
+	Prefix   string  `mapstructure:"prefix"`   // 路由前缀
+	Capacity int     `mapstructure:"capacity"` // 桶容量(允许的突发请求数)
+	Rate     float64 `mapstructure:"rate"`     // 每秒补充的令牌数
+}
+
+// IdempotencyConfig 幂等键配置
+type IdempotencyConfig struct {
+	TTL         int `mapstructure:"ttl"`          // 首次执行结果保留时长(秒)
+	LockTimeout int `mapstructure:"lock_timeout"` // 执行中状态的过期时间(秒), 执行期间自动续期, 超时后允许重试
+	WaitTimeout int `mapstructure:"wait_timeout"` // 并发重复请求等待首个请求完成的最长时间(毫秒)
+}
+
+// SwaggerConfig 接口文档配置
+type SwaggerConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`  // 是否在/swagger提供接口文档
+	Validate string `mapstructure:"validate"` // 契约校验模式(off-关闭,log-仅记录,strict-违反契约时返回错误), 用于测试环境
+}
+
+// EventsConfig 实时事件推送配置
+type EventsConfig struct {
+	Backlog        int `mapstructure:"backlog"`          // 每个用户保留的最近事件数, 用于断线重连后补发
+	Retention      int `mapstructure:"retention"`        // 事件保留时长(秒)
+	Heartbeat      int `mapstructure:"heartbeat"`        // 连接心跳间隔(秒)
+	ScanInterval   int `mapstructure:"scan_interval"`    // 扫描作物成熟、租约到期的间隔(秒)
+	RentalEndingIn int `mapstructure:"rental_ending_in"` // 租约到期前多久推送即将到期事件(秒)
+}
+
+// LandConfig 土地玩法配置
+type LandConfig struct {
+	UpgradeScanInterval   int     `mapstructure:"upgrade_scan_interval"`   // 扫描到期升级施工的间隔(秒)
+	ConstructionYieldRate float64 `mapstructure:"construction_yield_rate"` // 施工期间的产量比例
+	CancelRefundRate      float64 `mapstructure:"cancel_refund_rate"`      // 取消升级时退还MFG的比例
+	SpeedUpMFGPerMinute   uint64  `mapstructure:"speedup_mfg_per_minute"`  // MFG加速每分钟剩余施工时间的价格
+
+	FertilityRegenPerHour float64            `mapstructure:"fertility_regen_per_hour"` // 普通土地每小时恢复的肥力
+	RarityFertilityCap    int                `mapstructure:"rarity_fertility_cap"`     // 每级稀有度增加的肥力上限
+	RarityRegenBonus      float64            `mapstructure:"rarity_regen_bonus"`       // 每级稀有度增加的肥力恢复比例
+	SpecialEffectRegen    map[string]float64 `mapstructure:"special_effect_regen"`     // 特殊效果对肥力恢复速度的倍率
+
+	RarityYieldBonus   float64            `mapstructure:"rarity_yield_bonus"`   // 每级稀有度增加的产量比例
+	SpecialEffectYield map[string]float64 `mapstructure:"special_effect_yield"` // 特殊效果对产量的倍率
+	ExperiencePerYield float64            `mapstructure:"experience_per_yield"` // 每单位产量获得的经验值
+	WitherGraceMinutes int                `mapstructure:"wither_grace_minutes"` // 作物成熟后未收获多久枯萎(分钟), 目录中未配置时使用
+
+	WaterDecayPerHour        float64 `mapstructure:"water_decay_per_hour"`       // 地块每小时流失的水分
+	PestGrowthPerHour        float64 `mapstructure:"pest_growth_per_hour"`       // 地块每小时增长的虫害
+	WaterCooldownMinutes     int     `mapstructure:"water_cooldown_minutes"`     // 浇水冷却时间(分钟)
+	FertilizeCooldownMinutes int     `mapstructure:"fertilize_cooldown_minutes"` // 地块施肥冷却时间(分钟)
+	PesticideCooldownMinutes int     `mapstructure:"pesticide_cooldown_minutes"` // 除虫冷却时间(分钟)
+
+	AnimalHealthDecayPerHour float64 `mapstructure:"animal_health_decay_per_hour"` // 动物每小时下降的健康值, 降到0时饿死
+	FeedHealthPerProduce     int     `mapstructure:"feed_health_per_produce"`      // 每单位作物饲料恢复的动物健康值
+	BreedMinHealth           int     `mapstructure:"breed_min_health"`             // 繁殖所需的最低健康值
+	BreedHealthCost          int     `mapstructure:"breed_health_cost"`            // 每次繁殖消耗的健康值
+	BreedCooldownMinutes     int     `mapstructure:"breed_cooldown_minutes"`       // 繁殖冷却时间(分钟)
+
+	AdjacencyRules []AdjacencyRule `mapstructure:"adjacency_rules"` // 分区相邻加成规则, 按顺序匹配
+
+	DetailCacheTTL int `mapstructure:"detail_cache_ttl"` // 土地详情缓存时长(秒), 0表示不缓存
+
+	CatalogFile string `mapstructure:"catalog_file"` // 作物/动物目录初始数据文件, 目录表为空时导入
+}
+
+// AdjacencyRule 分区相邻加成规则: ZoneType类型的分区与NeighborType类型的分区相邻时获得加成
+type AdjacencyRule struct {
+	ZoneType     int8    `mapstructure:"zone_type"`     // 获得加成的分区类型(0-种植区,1-养殖区,2-装饰区)
+	NeighborType int8    `mapstructure:"neighbor_type"` // 相邻分区类型
+	BonusType    int8    `mapstructure:"bonus_type"`    // 加成类型(0-肥力恢复,1-产量提升)
+	BonusValue   float64 `mapstructure:"bonus_value"`   // 加成值(百分比)
+}
+
+// GRPCConfig gRPC服务配置, 供游戏服务器调用
+type GRPCConfig struct {
+	Enabled bool   `mapstructure:"enabled"` // 是否启用gRPC服务
+	Port    string `mapstructure:"port"`    // gRPC监听端口, 与HTTP端口分开
+	Gateway bool   `mapstructure:"gateway"` // 是否在HTTP服务的/rpc/v1下提供grpc-gateway转换的JSON接口
+}
+
+// APIKeyConfig 第三方调用方的API Key
+type APIKeyConfig struct {
+	Name  string `mapstructure:"name"`  // 调用方名称, 用于日志与限流
+	Key   string `mapstructure:"key"`   // API Key
+	Admin bool   `mapstructure:"admin"` // 是否允许调用管理接口, 如维护作物/动物目录
+}
+
 type Config struct {
 	Project  ProjectConfig    `mapstructure:"project_cfg"`
 	API      ApiConfig        `mapstructure:"api"`
@@ -103,6 +212,22 @@ type Config struct {
 	Ethereum EthereumConfig `mapstructure:"ethereum"`
 	// zkSync配置
 	ZkSync ZkSyncConfig `mapstructure:"zksync"`
+	// 健康检查配置
+	Health HealthConfig `mapstructure:"health"`
+	// 限流配置
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	// 幂等键配置
+	Idempotency IdempotencyConfig `mapstructure:"idempotency"`
+	// 接口文档配置
+	Swagger SwaggerConfig `mapstructure:"swagger"`
+	// 实时事件推送配置
+	Events EventsConfig `mapstructure:"events"`
+	// 土地玩法配置
+	Land LandConfig `mapstructure:"land"`
+	// gRPC服务配置
+	GRPC GRPCConfig `mapstructure:"grpc"`
+	// 第三方调用方API Key
+	APIKeys []APIKeyConfig `mapstructure:"api_keys"`
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -131,10 +256,17 @@ func defaultConfig() *Config {
 			Name: "MetaFarm",
 		},
 		API: ApiConfig{
-	Port:       ":80",
-	MaxNum:     500,
-	SessionTTL: 86400,
-},
+			Port:          ":80",
+			MaxNum:        500,
+			SessionTTL:    86400,
+			AllowDegraded: true,
+			LegacySunset:  "2027-06-30",
+
+			ReadTimeout:     15,
+			WriteTimeout:    30,
+			IdleTimeout:     120,
+			ShutdownTimeout: 30,
+		},
 		Log: LogConfig{
 			Compress:    false,
 			LeepDays:    7,
@@ -186,5 +318,80 @@ func defaultConfig() *Config {
 			TraitNameTags:  []string{"trait_type"},
 			TraitValueTags: []string{"value"},
 		},
+		Health: HealthConfig{
+			CheckTimeout:  2000,
+			L1MaxBlockAge: 120,
+			L2MaxBlockAge: 60,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Rules: []RateLimitRule{
+				{Name: "land_activity", Prefix: "/api/v1/land/activity", Capacity: 10, Rate: 0.5},
+				{Name: "land_activity", Prefix: "/api/land/land/activity", Capacity: 10, Rate: 0.5},
+				{Name: "land_write", Prefix: "/api/v1/land/market", Capacity: 10, Rate: 0.5},
+				{Name: "land_write", Prefix: "/api/land/land/market", Capacity: 10, Rate: 0.5},
+				{Name: "land_read", Prefix: "/api/v1/land", Capacity: 120, Rate: 20},
+				{Name: "land_read", Prefix: "/api/land", Capacity: 120, Rate: 20},
+			},
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:         86400,
+			LockTimeout: 30,
+			WaitTimeout: 10000,
+		},
+		Swagger: SwaggerConfig{
+			Enabled:  true,
+			Validate: "off",
+		},
+		Events: EventsConfig{
+			Backlog:        200,
+			Retention:      259200,
+			Heartbeat:      25,
+			ScanInterval:   30,
+			RentalEndingIn: 86400,
+		},
+		Land: LandConfig{
+			UpgradeScanInterval:   30,
+			ConstructionYieldRate: 0.5,
+			CancelRefundRate:      0.5,
+			SpeedUpMFGPerMinute:   2,
+
+			FertilityRegenPerHour: 2,
+			RarityFertilityCap:    10,
+			RarityRegenBonus:      0.1,
+			SpecialEffectRegen:    map[string]float64{"湿润土地": 1.5, "黄金土地": 2},
+
+			RarityYieldBonus:   0.1,
+			SpecialEffectYield: map[string]float64{"黄金土地": 1.5},
+			ExperiencePerYield: 1,
+			WitherGraceMinutes: 1440,
+
+			WaterDecayPerHour:        4,
+			PestGrowthPerHour:        2,
+			WaterCooldownMinutes:     30,
+			FertilizeCooldownMinutes: 60,
+			PesticideCooldownMinutes: 60,
+
+			AnimalHealthDecayPerHour: 2,
+			FeedHealthPerProduce:     5,
+			BreedMinHealth:           80,
+			BreedHealthCost:          30,
+			BreedCooldownMinutes:     1440,
+
+			AdjacencyRules: []AdjacencyRule{
+				{ZoneType: 0, NeighborType: 1, BonusType: 0, BonusValue: 20},
+				{ZoneType: 0, NeighborType: 2, BonusType: 1, BonusValue: 10},
+				{ZoneType: 1, NeighborType: 2, BonusType: 1, BonusValue: 5},
+			},
+
+			DetailCacheTTL: 60,
+
+			CatalogFile: "component/config/catalog.json",
+		},
+		GRPC: GRPCConfig{
+			Enabled: true,
+			Port:    ":9090",
+			Gateway: true,
+		},
 	}
 }
@@ -4,83 +4,198 @@ import (
 	"context"
 	"time"
 
+	"MetaFarmBackend/api/rpc"
+	"MetaFarmBackend/component/apikey"
 	"MetaFarmBackend/component/blockchain"
 	"MetaFarmBackend/component/cache"
 	"MetaFarmBackend/component/config"
 	"MetaFarmBackend/component/db"
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/health"
+	"MetaFarmBackend/component/idempotency"
+	"MetaFarmBackend/component/lifecycle"
 	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/ratelimit"
 	"MetaFarmBackend/component/redis"
 	"MetaFarmBackend/dao"
 	"MetaFarmBackend/service"
+
+	"github.com/pkg/errors"
 )
 
 type AppContext struct {
+	Config            *config.Config
 	Cache             *cache.CacheService
 	Dao               *dao.Dao
 	WalletAuthService service.WalletAuthService
 	LandService       service.LandService
+	CatalogService    service.CatalogService
 	EthClient         *blockchain.EthClient
 	ZkSyncClient      *blockchain.ZkSync2Client
 	ZkBridge          *blockchain.ZkSyncBridge
+	Health            *health.Checker
+	Lifecycle         *lifecycle.Lifecycle
+	RateLimiter       *ratelimit.Limiter
+	Idempotency       *idempotency.Store
+	Events            *events.Bus
+	APIKeys           *apikey.Verifier
 }
 
 func NewAppContext(config *config.Config) (*AppContext, error) {
 
 	//初始化日志
 	if err := logger.InitLogger(config); err != nil {
-		// 处理错误
-		panic(err)
+		return nil, errors.Wrap(err, "初始化日志失败")
 	}
 
+	//初始化生命周期管理
+	lc := lifecycle.New(config)
+
 	//初始化gorm
 	db, err := db.InitDB(config)
 	if err != nil {
-		// 处理错误
-		panic(err)
+		return nil, errors.Wrap(err, "初始化数据库失败")
 	}
+	lc.OnStop("mysql", func() error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	})
 
 	//初始化redis
 	redis, err := redis.InitRedis(config)
 	if err != nil {
-		// 处理错误
-		panic(err)
+		return nil, errors.Wrap(err, "初始化redis失败")
 	}
+	// go-zero按地址全局复用redis连接池且未暴露关闭方法, 连接随进程退出释放, 因此不注册停机钩子
 	//初始化缓存
 	cache := cache.NewCacheService(redis)
 
 	d := dao.NewDao(context.Background(), db, redis)
 	//初始化表
 	dao.InitTable()
+	if err := d.SeedLandUpgradeRules(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "初始化土地升级规则失败")
+	}
+	if err := d.BackfillLandLevelEffects(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "回填土地等级效果失败")
+	}
+	if err := d.SeedCropAnimals(context.Background(), config.Land.CatalogFile); err != nil {
+		return nil, errors.Wrap(err, "初始化作物/动物目录失败")
+	}
+
+	//初始化事件推送, 订阅任务在HTTP服务之前启动, 停机开始时先断开长连接
+	bus, err := events.NewBus(config, redis)
+	if err != nil {
+		return nil, errors.Wrap(err, "初始化事件推送失败")
+	}
+	lc.Register(bus)
+	lc.OnShutdown(bus.Shutdown)
+	lc.OnStop("events", bus.Close)
 
 	//初始化服务
 	walletAuthService := service.NewWalletAuthService(d, time.Duration(config.API.SessionTTL)*time.Second)
-	landService := service.NewLandService(d)
+	landService := service.NewLandService(d, bus, config.Land)
+	catalogService := service.NewCatalogService(d)
 
-	// 初始化以太坊客户端
-	ethClient, err := blockchain.NewEthClient(config.Ethereum.RPCURL, config.Ethereum.PrivateKey)
-	if err != nil {
-		panic(err)
+	// 定时扫描作物成熟、租约即将到期并推送事件, 超过收获宽限期的作物标记为枯萎, 健康值耗尽的动物标记为饿死
+	farmEventService := service.NewFarmEventService(d, bus, time.Duration(config.Events.RentalEndingIn)*time.Second)
+	scanInterval := time.Duration(config.Events.ScanInterval) * time.Second
+	if scanInterval <= 0 {
+		scanInterval = 30 * time.Second
 	}
+	lc.Register(lifecycle.NewTicker("crop-ready-notifier", scanInterval, farmEventService.NotifyCropsReady))
+	lc.Register(lifecycle.NewTicker("rental-ending-notifier", scanInterval, farmEventService.NotifyRentalsEnding))
+	lc.Register(lifecycle.NewTicker("crop-wither-sweeper", scanInterval, landService.WitherExpiredCrops))
+	lc.Register(lifecycle.NewTicker("animal-starve-sweeper", scanInterval, landService.StarveHungryAnimals))
 
-	// 初始化zkSync客户端
-	zkSyncClient, err := blockchain.NewZkSync2Client(config.ZkSync.RPCURL, config.ZkSync.PrivateKey, ethClient.GetClient())
-	if err != nil {
-		panic(err)
+	// 定时完成到期的土地升级施工
+	upgradeInterval := time.Duration(config.Land.UpgradeScanInterval) * time.Second
+	if upgradeInterval <= 0 {
+		upgradeInterval = 30 * time.Second
 	}
+	lc.Register(lifecycle.NewTicker("land-upgrade-completer", upgradeInterval, landService.CompleteDueUpgrades))
 
-	// 初始化zkSync桥接
-	zkBridge, err := blockchain.NewZkSyncBridge(ethClient, zkSyncClient, config.ZkSync.BridgeAddress)
+	// 游戏服务器通过API Key或玩家会话令牌调用的gRPC服务
+	apiKeys := apikey.NewVerifier(config.APIKeys)
+	if config.GRPC.Enabled {
+		lc.Register(rpc.NewServer(config.GRPC, landService, catalogService, walletAuthService, apiKeys))
+	}
+
+	// 初始化链客户端, 允许降级时链节点不可用不影响启动
+	ethClient, zkSyncClient, zkBridge, err := initChainClients(config)
 	if err != nil {
-		panic(err)
+		if !config.API.AllowDegraded {
+			return nil, errors.Wrap(err, "初始化链客户端失败")
+		}
+		logger.Warnf("链客户端初始化失败, 以降级模式启动(链相关功能不可用): %v", err)
+	} else {
+		lc.OnStop("zksync", func() error {
+			zkSyncClient.Close()
+			return nil
+		})
+		lc.OnStop("ethereum", func() error {
+			ethClient.Close()
+			return nil
+		})
+	}
+
+	// 启动时检查一次依赖, 之后定时刷新, 链相关接口按最近一次结果判断是否降级
+	checker := health.NewChecker(config, db, redis, ethClient, zkSyncClient)
+	checker.Refresh(context.Background())
+	healthInterval := time.Duration(config.Health.CheckInterval) * time.Second
+	if healthInterval <= 0 {
+		healthInterval = 15 * time.Second
 	}
+	lc.Register(lifecycle.NewTicker("health-checker", healthInterval, checker.Refresh))
 
 	return &AppContext{
+		Config:            config,
 		Cache:             cache,
 		Dao:               d,
 		WalletAuthService: walletAuthService,
 		LandService:       landService,
+		CatalogService:    catalogService,
 		EthClient:         ethClient,
 		ZkSyncClient:      zkSyncClient,
 		ZkBridge:          zkBridge,
+		Health:            checker,
+		Lifecycle:         lc,
+		RateLimiter:       ratelimit.NewLimiter(config, redis),
+		Idempotency:       idempotency.NewStore(config, redis),
+		Events:            bus,
+		APIKeys:           apiKeys,
 	}, nil
 }
+
+// ChainEnabled 链相关功能是否可用: 链客户端已初始化且最近一次健康检查中L1/L2均正常
+func (a *AppContext) ChainEnabled() bool {
+	return a.EthClient != nil && a.ZkSyncClient != nil && a.ZkBridge != nil && a.Health.ChainAvailable()
+}
+
+// initChainClients 初始化L1/L2客户端及桥接服务
+func initChainClients(config *config.Config) (*blockchain.EthClient, *blockchain.ZkSync2Client, *blockchain.ZkSyncBridge, error) {
+	// 初始化以太坊客户端
+	ethClient, err := blockchain.NewEthClient(config.Ethereum.RPCURL, config.Ethereum.PrivateKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 初始化zkSync客户端
+	zkSyncClient, err := blockchain.NewZkSync2Client(config.ZkSync.RPCURL, config.ZkSync.PrivateKey, ethClient.GetClient())
+	if err != nil {
+		ethClient.Close()
+		return nil, nil, nil, err
+	}
+
+	// 初始化zkSync桥接
+	zkBridge, err := blockchain.NewZkSyncBridge(ethClient, zkSyncClient, config.ZkSync.BridgeAddress)
+	if err != nil {
+		zkSyncClient.Close()
+		ethClient.Close()
+		return nil, nil, nil, err
+	}
+	return ethClient, zkSyncClient, zkBridge, nil
+}
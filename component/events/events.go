@@ -0,0 +1,320 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/redis"
+
+	"github.com/pkg/errors"
+	goredis "github.com/redis/go-redis/v9"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 事件类型
+const (
+	TypeCropReady       = "crop_ready"       // 作物成熟
+	TypeCropWithered    = "crop_withered"    // 作物枯萎
+	TypeAnimalStarved   = "animal_starved"   // 动物饿死
+	TypeLandSold        = "land_sold"        // 土地售出
+	TypeRentalStarted   = "rental_started"   // 租赁开始
+	TypeRentalEnding    = "rental_ending"    // 租赁即将到期
+	TypeRentalCancelled = "rental_cancelled" // 租赁取消
+	TypeBalanceChanged  = "balance_changed"  // 余额变动
+	TypeUpgradeFinished = "upgrade_finished" // 土地升级施工完成
+	// TypeReset 请求的Last-Event-ID早于保留的最早事件, 客户端应重新拉取完整状态
+	TypeReset = "reset"
+)
+
+const (
+	channel       = "farm:events"
+	notifyPrefix  = "events:notified:"
+	subscriberBuf = 64
+)
+
+// publishScript 分配用户内递增的事件ID, 写入用户事件日志并广播到所有实例
+// 消息格式为"<id>:<payload>", 同时作为有序集合成员保证唯一
+var publishScript = zeroredis.NewScript(`local id = redis.call('INCR', KEYS[1])
+local msg = id .. ':' .. ARGV[1]
+redis.call('ZADD', KEYS[2], id, msg)
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+redis.call('PUBLISH', ARGV[4], msg)
+return id`)
+
+// Event 推送给用户的事件
+type Event struct {
+	ID          uint64          `json:"id"`                        // 用户内递增的事件ID, 作为Last-Event-ID
+	Type        string          `json:"type"`                      // 事件类型
+	UserAddress string          `json:"userAddress"`               // 接收用户钱包地址
+	Data        json.RawMessage `json:"data" swaggertype:"object"` // 事件内容
+	CreateTime  int64           `json:"createTime"`                // 事件时间(unix毫秒)
+}
+
+// Subscription 本实例上的一个用户连接
+type Subscription struct {
+	bus  *Bus
+	user string
+	ch   chan *Event
+	once sync.Once
+}
+
+// C 实时事件通道, 连接消费过慢时会被关闭, 客户端需按Last-Event-ID重连
+func (s *Subscription) C() <-chan *Event {
+	return s.ch
+}
+
+// Close 取消订阅
+func (s *Subscription) Close() {
+	s.bus.remove(s)
+}
+
+// Bus 基于redis的事件总线: 有序集合保存每个用户最近的事件用于补发, pub/sub在多实例间分发
+// 钱包地址统一按小写处理
+type Bus struct {
+	store     *redis.Store
+	client    goredis.UniversalClient
+	backlog   int
+	retention time.Duration
+
+	mu       sync.RWMutex
+	subs     map[string]map[*Subscription]struct{}
+	closing  chan struct{}
+	shutdown sync.Once
+}
+
+// NewBus 创建事件总线, go-zero未提供订阅接口, pub/sub使用独立的go-redis连接
+func NewBus(cfg *config.Config, store *redis.Store) (*Bus, error) {
+	if cfg.Kv == nil || len(cfg.Kv.Redis) == 0 {
+		return nil, errors.New("redis config is nil")
+	}
+	var addrs []string
+	for _, node := range cfg.Kv.Redis {
+		addrs = append(addrs, node.Host)
+	}
+	client := goredis.NewUniversalClient(&goredis.UniversalOptions{
+		Addrs:      addrs,
+		Password:   cfg.Kv.Redis[0].Pass,
+		MasterName: cfg.Kv.Redis[0].MasterName,
+	})
+
+	backlog := cfg.Events.Backlog
+	if backlog <= 0 {
+		backlog = 200
+	}
+	retention := time.Duration(cfg.Events.Retention) * time.Second
+	if retention <= 0 {
+		retention = 72 * time.Hour
+	}
+	return &Bus{
+		store:     store,
+		client:    client,
+		backlog:   backlog,
+		retention: retention,
+		subs:      make(map[string]map[*Subscription]struct{}),
+		closing:   make(chan struct{}),
+	}, nil
+}
+
+// Publish 向用户推送事件, data为事件内容
+func (b *Bus) Publish(ctx context.Context, userAddress, eventType string, data interface{}) error {
+	userAddress = strings.ToLower(userAddress)
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "序列化事件失败")
+	}
+	payload, err := json.Marshal(&Event{
+		Type:        eventType,
+		UserAddress: userAddress,
+		Data:        raw,
+		CreateTime:  time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "序列化事件失败")
+	}
+
+	seqKey, logKey := keys(userAddress)
+	_, err = b.store.Redis.ScriptRunCtx(ctx, publishScript, []string{seqKey, logKey},
+		string(payload), b.backlog, int(b.retention.Seconds()), channel)
+	return errors.Wrap(err, "发布事件失败")
+}
+
+// PublishOnce 同一dedupeKey在保留期内只推送一次, 用于定时扫描产生的事件
+func (b *Bus) PublishOnce(ctx context.Context, dedupeKey, userAddress, eventType string, data interface{}) error {
+	ok, err := b.store.Redis.SetnxExCtx(ctx, notifyPrefix+dedupeKey, "1", int(b.retention.Seconds()))
+	if err != nil {
+		return errors.Wrap(err, "写入事件去重标记失败")
+	}
+	if !ok {
+		return nil
+	}
+	if err := b.Publish(ctx, userAddress, eventType, data); err != nil {
+		// 发布失败时清除标记, 下次扫描重试
+		b.store.Redis.DelCtx(ctx, notifyPrefix+dedupeKey)
+		return err
+	}
+	return nil
+}
+
+// Since 读取lastID之后的事件, 如果其中部分事件已被淘汰, 首个事件为TypeReset
+func (b *Bus) Since(ctx context.Context, userAddress string, lastID uint64) ([]*Event, error) {
+	userAddress = strings.ToLower(userAddress)
+	seqKey, logKey := keys(userAddress)
+	seq, err := b.store.Redis.GetCtx(ctx, seqKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "读取事件序号失败")
+	}
+	latest, _ := strconv.ParseUint(seq, 10, 64)
+	if latest <= lastID {
+		return nil, nil
+	}
+
+	pairs, err := b.store.Redis.ZrangebyscoreWithScoresCtx(ctx, logKey, int64(lastID)+1, math.MaxInt64)
+	if err != nil {
+		return nil, errors.Wrap(err, "读取历史事件失败")
+	}
+
+	var result []*Event
+	if len(pairs) == 0 || uint64(pairs[0].Score) > lastID+1 {
+		result = append(result, &Event{
+			ID:          lastID,
+			Type:        TypeReset,
+			UserAddress: userAddress,
+			Data:        json.RawMessage("{}"),
+			CreateTime:  time.Now().UnixMilli(),
+		})
+	}
+	for _, p := range pairs {
+		e, err := decode(p.Key)
+		if err != nil {
+			logger.Errorf("解析历史事件失败: %v", err)
+			continue
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+// Subscribe 在本实例注册用户连接, 应先订阅再调用Since补发, 避免两者之间的事件丢失
+func (b *Bus) Subscribe(userAddress string) *Subscription {
+	userAddress = strings.ToLower(userAddress)
+	s := &Subscription{
+		bus:  b,
+		user: userAddress,
+		ch:   make(chan *Event, subscriberBuf),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subs[userAddress] == nil {
+		b.subs[userAddress] = make(map[*Subscription]struct{})
+	}
+	b.subs[userAddress][s] = struct{}{}
+	return s
+}
+
+// Closing 停机开始时关闭, 长连接据此主动断开, 避免阻塞HTTP服务排空
+func (b *Bus) Closing() <-chan struct{} {
+	return b.closing
+}
+
+// Shutdown 通知所有长连接断开
+func (b *Bus) Shutdown() {
+	b.shutdown.Do(func() {
+		close(b.closing)
+	})
+}
+
+// Close 关闭订阅连接
+func (b *Bus) Close() error {
+	return b.client.Close()
+}
+
+// Name 后台任务名称
+func (b *Bus) Name() string {
+	return "events-subscriber"
+}
+
+// Run 订阅redis广播并分发到本实例的连接, 断线由go-redis自动重连
+func (b *Bus) Run(ctx context.Context) error {
+	pubsub := b.client.Subscribe(ctx, channel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			e, err := decode(msg.Payload)
+			if err != nil {
+				logger.Errorf("解析广播事件失败: %v", err)
+				continue
+			}
+			b.dispatch(e)
+		}
+	}
+}
+
+// dispatch 将事件投递给用户在本实例上的所有连接, 缓冲已满的连接直接关闭
+func (b *Bus) dispatch(e *Event) {
+	b.mu.RLock()
+	var slow []*Subscription
+	for s := range b.subs[e.UserAddress] {
+		select {
+		case s.ch <- e:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, s := range slow {
+		logger.Warnf("事件连接消费过慢, 已断开: user=%s", s.user)
+		b.remove(s)
+	}
+}
+
+func (b *Bus) remove(s *Subscription) {
+	s.once.Do(func() {
+		b.mu.Lock()
+		delete(b.subs[s.user], s)
+		if len(b.subs[s.user]) == 0 {
+			delete(b.subs, s.user)
+		}
+		b.mu.Unlock()
+		close(s.ch)
+	})
+}
+
+// keys 用户的事件序号与事件日志, 使用hash tag保证集群模式下位于同一slot
+func keys(userAddress string) (string, string) {
+	return fmt.Sprintf("events:{%s}:seq", userAddress), fmt.Sprintf("events:{%s}:log", userAddress)
+}
+
+func decode(msg string) (*Event, error) {
+	idx := strings.IndexByte(msg, ':')
+	if idx <= 0 {
+		return nil, errors.Errorf("事件格式错误: %s", msg)
+	}
+	id, err := strconv.ParseUint(msg[:idx], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "事件ID格式错误")
+	}
+	var e Event
+	if err := json.Unmarshal([]byte(msg[idx+1:]), &e); err != nil {
+		return nil, errors.Wrap(err, "事件内容格式错误")
+	}
+	e.ID = id
+	return &e, nil
+}
@@ -0,0 +1,45 @@
+package events
+
+import "time"
+
+// CropData 作物成熟/枯萎事件内容
+type CropData struct {
+	ActivityID      uint64    `json:"activityId"`                // 活动ID
+	LandTokenID     string    `json:"landTokenId"`               // 土地NFT TokenID
+	CropAnimalID    uint64    `json:"cropAnimalId"`              // 作物/动物ID
+	ExpectedEndTime time.Time `json:"expectedEndTime"`           // 预计成熟时间
+	FertilityRefund int       `json:"fertilityRefund,omitempty"` // 枯萎时退还的肥力
+}
+
+// LandSoldData 土地售出事件内容, 同时推送给卖家和买家
+type LandSoldData struct {
+	MarketID      uint64  `json:"marketId"`      // 市场挂牌ID
+	LandTokenID   string  `json:"landTokenId"`   // 土地NFT TokenID
+	Price         float64 `json:"price"`         // 成交价
+	SellerAddress string  `json:"sellerAddress"` // 卖家钱包地址
+	BuyerAddress  string  `json:"buyerAddress"`  // 买家钱包地址
+}
+
+// RentalData 租赁开始/即将到期/取消事件内容, 同时推送给所有者和租客
+type RentalData struct {
+	RentalID      uint64    `json:"rentalId"`      // 租赁订单ID
+	LandTokenID   string    `json:"landTokenId"`   // 土地NFT TokenID
+	OwnerAddress  string    `json:"ownerAddress"`  // 所有者钱包地址
+	RenterAddress string    `json:"renterAddress"` // 租客钱包地址
+	RentalEndTime time.Time `json:"rentalEndTime"` // 租赁结束时间
+}
+
+// BalanceData 余额变动事件内容
+type BalanceData struct {
+	Asset   string  `json:"asset"`   // 资产类型
+	Delta   float64 `json:"delta"`   // 变动数量, 负数为扣减
+	Balance float64 `json:"balance"` // 变动后余额
+	Reason  string  `json:"reason"`  // 变动原因
+}
+
+// UpgradeData 土地升级施工完成事件内容
+type UpgradeData struct {
+	UpgradeID   uint64 `json:"upgradeId"`   // 升级记录ID
+	LandTokenID string `json:"landTokenId"` // 土地NFT TokenID
+	NewLevel    int8   `json:"newLevel"`    // 升级后等级
+}
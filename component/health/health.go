@@ -0,0 +1,209 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"MetaFarmBackend/component/blockchain"
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/redis"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// 依赖状态
+const (
+	StatusUp       = "up"       // 正常
+	StatusDown     = "down"     // 不可用
+	StatusDegraded = "degraded" // 降级(核心依赖正常,链相关功能不可用)
+	StatusOK       = "ok"       // 全部正常
+)
+
+// 依赖名称
+const (
+	DependencyMySQL = "mysql"
+	DependencyRedis = "redis"
+	DependencyL1    = "l1"
+	DependencyL2    = "l2"
+)
+
+// DependencyStatus 单个依赖的检查结果
+type DependencyStatus struct {
+	Status      string `json:"status"`                // 状态(up/down)
+	Critical    bool   `json:"critical"`              // 是否为核心依赖(核心依赖不可用时服务不可用)
+	LatencyMs   int64  `json:"latencyMs"`             // 检查耗时(毫秒)
+	BlockNumber uint64 `json:"blockNumber,omitempty"` // 最新区块高度(仅链依赖)
+	BlockAgeSec int64  `json:"blockAgeSec,omitempty"` // 最新区块距今秒数(仅链依赖)
+	Error       string `json:"error,omitempty"`       // 错误信息
+}
+
+// Report 就绪检查报告
+type Report struct {
+	Status       string                       `json:"status"`       // 整体状态(ok/degraded/down)
+	ChainEnabled bool                         `json:"chainEnabled"` // 链相关功能是否可用
+	CheckedAt    time.Time                    `json:"checkedAt"`    // 检查时间
+	Dependencies map[string]*DependencyStatus `json:"dependencies"` // 各依赖检查结果
+}
+
+// headerSource 能获取最新区块头的链客户端
+type headerSource interface {
+	LatestHeader(ctx context.Context) (*types.Header, error)
+}
+
+// Checker 依赖健康检查器
+type Checker struct {
+	db            *gorm.DB
+	kvStore       *redis.Store
+	l1            headerSource
+	l2            headerSource
+	timeout       time.Duration
+	l1MaxAge      time.Duration
+	l2MaxAge      time.Duration
+	allowDegraded bool
+	last          atomic.Pointer[Report] // 最近一次检查结果
+}
+
+// NewChecker 创建健康检查器, l1/l2为nil表示链客户端未初始化(降级启动)
+func NewChecker(cfg *config.Config, db *gorm.DB, kvStore *redis.Store, l1 *blockchain.EthClient, l2 *blockchain.ZkSync2Client) *Checker {
+	c := &Checker{
+		db:            db,
+		kvStore:       kvStore,
+		timeout:       time.Duration(cfg.Health.CheckTimeout) * time.Millisecond,
+		l1MaxAge:      time.Duration(cfg.Health.L1MaxBlockAge) * time.Second,
+		l2MaxAge:      time.Duration(cfg.Health.L2MaxBlockAge) * time.Second,
+		allowDegraded: cfg.API.AllowDegraded,
+	}
+	if l1 != nil {
+		c.l1 = l1
+	}
+	if l2 != nil {
+		c.l2 = l2
+	}
+	if c.timeout <= 0 {
+		c.timeout = 2 * time.Second
+	}
+	return c
+}
+
+// Readiness 并发检查所有依赖并汇总结果, 结果同时作为ChainAvailable的依据
+func (c *Checker) Readiness(ctx context.Context) *Report {
+	checks := map[string]func(context.Context) *DependencyStatus{
+		DependencyMySQL: c.checkDB,
+		DependencyRedis: c.checkRedis,
+		DependencyL1: func(ctx context.Context) *DependencyStatus {
+			return c.checkChain(ctx, c.l1, c.l1MaxAge)
+		},
+		DependencyL2: func(ctx context.Context) *DependencyStatus {
+			return c.checkChain(ctx, c.l2, c.l2MaxAge)
+		},
+	}
+
+	report := &Report{
+		CheckedAt:    time.Now(),
+		Dependencies: make(map[string]*DependencyStatus, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(context.Context) *DependencyStatus) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			start := time.Now()
+			status := check(checkCtx)
+			status.LatencyMs = time.Since(start).Milliseconds()
+
+			mu.Lock()
+			report.Dependencies[name] = status
+			mu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	report.Status = StatusOK
+	report.ChainEnabled = true
+	for name, dep := range report.Dependencies {
+		if dep.Status == StatusUp {
+			continue
+		}
+		if name == DependencyL1 || name == DependencyL2 {
+			report.ChainEnabled = false
+		}
+		if dep.Critical {
+			report.Status = StatusDown
+			continue
+		}
+		if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+	c.last.Store(report)
+	return report
+}
+
+// Refresh 重新检查依赖并缓存结果, 供后台定时任务调用
+func (c *Checker) Refresh(ctx context.Context) error {
+	c.Readiness(ctx)
+	return nil
+}
+
+// ChainAvailable 按最近一次检查结果判断链相关功能是否可用, 尚未检查过时视为不可用
+func (c *Checker) ChainAvailable() bool {
+	report := c.last.Load()
+	return report != nil && report.ChainEnabled
+}
+
+func (c *Checker) checkDB(ctx context.Context) *DependencyStatus {
+	status := &DependencyStatus{Status: StatusUp, Critical: true}
+	sqlDB, err := c.db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func (c *Checker) checkRedis(ctx context.Context) *DependencyStatus {
+	status := &DependencyStatus{Status: StatusUp, Critical: true}
+	if c.kvStore == nil || c.kvStore.Redis == nil || !c.kvStore.Redis.PingCtx(ctx) {
+		status.Status = StatusDown
+		status.Error = "redis ping failed"
+	}
+	return status
+}
+
+// checkChain 检查链节点可用性及最新区块新鲜度
+func (c *Checker) checkChain(ctx context.Context, source headerSource, maxAge time.Duration) *DependencyStatus {
+	status := &DependencyStatus{Status: StatusUp, Critical: !c.allowDegraded}
+	if source == nil {
+		status.Status = StatusDown
+		status.Error = "client not initialized"
+		return status
+	}
+
+	header, err := source.LatestHeader(ctx)
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = errors.Wrap(err, "获取最新区块失败").Error()
+		return status
+	}
+
+	age := time.Since(time.Unix(int64(header.Time), 0))
+	status.BlockNumber = header.Number.Uint64()
+	status.BlockAgeSec = int64(age.Seconds())
+	if maxAge > 0 && age > maxAge {
+		status.Status = StatusDown
+		status.Error = "latest block is stale"
+	}
+	return status
+}
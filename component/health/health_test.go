@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type fakeChain struct {
+	err error
+}
+
+func (f *fakeChain) LatestHeader(ctx context.Context) (*types.Header, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &types.Header{Number: big.NewInt(100), Time: uint64(time.Now().Unix())}, nil
+}
+
+func TestChainAvailable(t *testing.T) {
+	sqlDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer sqlDB.Close()
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		l1, l2        headerSource
+		allowDegraded bool
+		want          bool
+	}{
+		{name: "链节点正常", l1: &fakeChain{}, l2: &fakeChain{}, allowDegraded: true, want: true},
+		{name: "L2不可用", l1: &fakeChain{}, l2: &fakeChain{err: errors.New("rpc down")}, allowDegraded: true},
+		{name: "客户端未初始化", l1: &fakeChain{}, allowDegraded: true},
+		{name: "不允许降级时链节点不可用", l1: &fakeChain{err: errors.New("rpc down")}, l2: &fakeChain{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Checker{db: db, l1: tt.l1, l2: tt.l2, timeout: time.Second, allowDegraded: tt.allowDegraded}
+			if c.ChainAvailable() {
+				t.Fatal("chain available before any check")
+			}
+			if err := c.Refresh(context.Background()); err != nil {
+				t.Fatalf("refresh: %v", err)
+			}
+			if got := c.ChainAvailable(); got != tt.want {
+				t.Fatalf("ChainAvailable = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
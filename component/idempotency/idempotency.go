@@ -0,0 +1,198 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/redis"
+
+	"github.com/pkg/errors"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "idempotency:"
+
+// 记录状态
+const (
+	StatusProcessing = "processing" // 首个请求执行中
+	StatusDone       = "done"       // 已完成, 可重放
+)
+
+// renewScript 幂等键仍为执行中的同一记录时延长过期时间, 已完成或已被释放的键不续期
+var renewScript = zeroredis.NewScript(`if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+return 0`)
+
+// ErrWaitTimeout 等待并发请求完成超时
+var ErrWaitTimeout = errors.New("等待相同幂等键的请求完成超时")
+
+// Record 幂等记录
+type Record struct {
+	Fingerprint string `json:"fingerprint"` // 请求指纹(方法+路径+请求体摘要)
+	Status      string `json:"status"`      // 状态
+	Code        int    `json:"code"`        // 首次执行的HTTP状态码
+	ContentType string `json:"contentType"` // 首次执行的响应类型
+	Body        []byte `json:"body"`        // 首次执行的响应体
+}
+
+// Store 基于redis的幂等记录存储
+type Store struct {
+	kv          *redis.Store
+	ttl         time.Duration
+	lockTimeout time.Duration
+	waitTimeout time.Duration
+}
+
+// NewStore 创建幂等记录存储
+func NewStore(cfg *config.Config, kv *redis.Store) *Store {
+	return &Store{
+		kv:          kv,
+		ttl:         time.Duration(cfg.Idempotency.TTL) * time.Second,
+		lockTimeout: time.Duration(cfg.Idempotency.LockTimeout) * time.Second,
+		waitTimeout: time.Duration(cfg.Idempotency.WaitTimeout) * time.Millisecond,
+	}
+}
+
+// Fingerprint 计算请求指纹
+func Fingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// acquireAttempts 幂等键恰好过期时重新占用的最大次数
+const acquireAttempts = 3
+
+// processingValue 执行中状态的记录
+func processingValue(fingerprint string) (string, error) {
+	value, err := json.Marshal(&Record{Fingerprint: fingerprint, Status: StatusProcessing})
+	if err != nil {
+		return "", errors.Wrap(err, "序列化幂等记录失败")
+	}
+	return string(value), nil
+}
+
+// Acquire 尝试占用幂等键, 成功返回(nil, true); 已存在时返回现有记录
+func (s *Store) Acquire(ctx context.Context, key, fingerprint string) (*Record, bool, error) {
+	value, err := processingValue(fingerprint)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 记录在占用失败与读取之间恰好过期时重新尝试占用
+	for i := 0; i < acquireAttempts; i++ {
+		ok, err := s.kv.Redis.SetnxExCtx(ctx, keyPrefix+key, value, int(s.lockTimeout.Seconds()))
+		if err != nil {
+			return nil, false, errors.Wrap(err, "占用幂等键失败")
+		}
+		if ok {
+			return nil, true, nil
+		}
+
+		record, err := s.get(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if record != nil {
+			return record, false, nil
+		}
+	}
+	return nil, false, errors.New("占用幂等键失败: 重试次数过多")
+}
+
+// KeepAlive 在首个请求执行期间每隔lockTimeout的三分之一续期执行中状态, 避免执行时间超过lockTimeout后
+// 重复请求再次执行; 返回的函数停止续期, 须在保存结果或释放幂等键之前调用
+func (s *Store) KeepAlive(ctx context.Context, key, fingerprint string) (stop func()) {
+	value, err := processingValue(fingerprint)
+	if err != nil {
+		logger.Errorf("幂等键续期失败: %v, key: %s", err, key)
+		return func() {}
+	}
+	interval := max(s.lockTimeout/3, time.Second)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			if _, err := s.kv.Redis.ScriptRunCtx(ctx, renewScript, []string{keyPrefix + key}, value, int(s.lockTimeout.Seconds())); err != nil {
+				logger.Errorf("幂等键续期失败: %v, key: %s", err, key)
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// Wait 等待执行中的请求完成, 用于串行化并发的重复请求
+func (s *Store) Wait(ctx context.Context, key string) (*Record, error) {
+	deadline := time.Now().Add(s.waitTimeout)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		record, err := s.get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil || record.Status == StatusDone {
+			return record, nil
+		}
+	}
+	return nil, ErrWaitTimeout
+}
+
+// Complete 保存首次执行结果, 在配置的窗口期内重放
+func (s *Store) Complete(ctx context.Context, key string, record *Record) error {
+	record.Status = StatusDone
+	value, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "序列化幂等记录失败")
+	}
+	return errors.Wrap(s.kv.Redis.SetexCtx(ctx, keyPrefix+key, string(value), int(s.ttl.Seconds())), "保存幂等记录失败")
+}
+
+// Release 释放幂等键, 首次执行失败时允许客户端重试
+func (s *Store) Release(ctx context.Context, key string) error {
+	_, err := s.kv.Redis.DelCtx(ctx, keyPrefix+key)
+	return errors.Wrap(err, "释放幂等键失败")
+}
+
+func (s *Store) get(ctx context.Context, key string) (*Record, error) {
+	value, err := s.kv.Redis.GetCtx(ctx, keyPrefix+key)
+	if err != nil {
+		return nil, errors.Wrap(err, "读取幂等记录失败")
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	var record Record
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return nil, errors.Wrap(err, "解析幂等记录失败")
+	}
+	return &record, nil
+}
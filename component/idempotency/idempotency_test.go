@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"MetaFarmBackend/component/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func TestKeepAlive(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := &Store{kv: &redis.Store{Redis: zeroredis.New(mr.Addr())}, lockTimeout: 3 * time.Second, ttl: time.Hour}
+	ctx := context.Background()
+
+	if _, ok, err := s.Acquire(ctx, "k", "fp"); err != nil || !ok {
+		t.Fatalf("acquire: ok=%v err=%v", ok, err)
+	}
+	stop := s.KeepAlive(ctx, "k", "fp")
+
+	// 执行时间超过lockTimeout时执行中状态不过期
+	mr.FastForward(2 * time.Second)
+	time.Sleep(1200 * time.Millisecond)
+	if ttl := mr.TTL(keyPrefix + "k"); ttl != 3*time.Second {
+		t.Fatalf("ttl after renewal = %s, want 3s", ttl)
+	}
+	mr.FastForward(2 * time.Second)
+	if _, ok, _ := s.Acquire(ctx, "k", "fp"); ok {
+		t.Fatal("duplicate request acquired a key that is still processing")
+	}
+
+	// 停止续期后保存结果, 不再按执行中状态续期
+	stop()
+	if err := s.Complete(ctx, "k", &Record{Fingerprint: "fp", Code: 200}); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if ttl := mr.TTL(keyPrefix + "k"); ttl != time.Hour {
+		t.Fatalf("ttl after complete = %s, want 1h", ttl)
+	}
+}
@@ -0,0 +1,186 @@
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/logger"
+
+	"github.com/pkg/errors"
+)
+
+// Worker 后台任务, Run阻塞运行直到ctx被取消
+type Worker interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+// closer 停机时需要释放的资源
+type closer struct {
+	name string
+	fn   func() error
+}
+
+// runningWorker 运行中的后台任务
+type runningWorker struct {
+	worker Worker
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Lifecycle 应用生命周期管理: HTTP服务、后台任务及资源释放
+type Lifecycle struct {
+	cfg           config.ApiConfig
+	mu            sync.Mutex
+	workers       []Worker
+	closers       []closer
+	running       []*runningWorker
+	shutdownHooks []func()
+}
+
+// New 创建生命周期管理器
+func New(cfg *config.Config) *Lifecycle {
+	return &Lifecycle{cfg: cfg.API}
+}
+
+// Register 注册后台任务, 停机时按注册的逆序停止
+func (l *Lifecycle) Register(w Worker) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.workers = append(l.workers, w)
+}
+
+// OnStop 注册停机时需要关闭的资源, 在所有后台任务停止后按注册的逆序关闭
+func (l *Lifecycle) OnStop(name string, fn func() error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closers = append(l.closers, closer{name: name, fn: fn})
+}
+
+// OnShutdown 注册停机开始时的回调, 用于通知WebSocket/SSE等长连接主动断开, 避免阻塞HTTP请求排空
+func (l *Lifecycle) OnShutdown(fn func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.shutdownHooks = append(l.shutdownHooks, fn)
+}
+
+// Run 启动HTTP服务和后台任务, 阻塞直到收到SIGINT/SIGTERM或服务异常退出, 然后按序停机
+func (l *Lifecycle) Run(handler http.Handler) error {
+	server := &http.Server{
+		Addr:         listenAddr(l.cfg.Port),
+		Handler:      handler,
+		ReadTimeout:  seconds(l.cfg.ReadTimeout),
+		WriteTimeout: seconds(l.cfg.WriteTimeout),
+		IdleTimeout:  seconds(l.cfg.IdleTimeout),
+	}
+	l.mu.Lock()
+	for _, fn := range l.shutdownHooks {
+		server.RegisterOnShutdown(fn)
+	}
+	l.mu.Unlock()
+
+	l.startWorkers()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Infof("HTTP服务启动: %s", server.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+		close(serveErr)
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	var runErr error
+	select {
+	case sig := <-quit:
+		logger.Infof("收到信号 %s, 开始优雅停机", sig)
+	case err := <-serveErr:
+		runErr = errors.Wrap(err, "HTTP服务异常退出")
+		logger.Errorf("%v, 开始停机", runErr)
+	}
+
+	l.shutdown(server)
+	return runErr
+}
+
+// startWorkers 启动所有已注册的后台任务
+func (l *Lifecycle) startWorkers() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, w := range l.workers {
+		ctx, cancel := context.WithCancel(context.Background())
+		rw := &runningWorker{worker: w, cancel: cancel, done: make(chan struct{})}
+		l.running = append(l.running, rw)
+		go func() {
+			defer close(rw.done)
+			logger.Infof("后台任务启动: %s", rw.worker.Name())
+			if err := rw.worker.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Errorf("后台任务异常退出: %s, err: %v", rw.worker.Name(), err)
+			}
+		}()
+	}
+}
+
+// shutdown 依次排空HTTP请求、停止后台任务、关闭资源
+func (l *Lifecycle) shutdown(server *http.Server) {
+	timeout := seconds(l.cfg.ShutdownTimeout)
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// 1. 停止接收新请求并等待进行中的请求完成
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Errorf("HTTP服务停机超时: %v", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 2. 逆序停止后台任务
+	for i := len(l.running) - 1; i >= 0; i-- {
+		rw := l.running[i]
+		rw.cancel()
+		select {
+		case <-rw.done:
+			logger.Infof("后台任务已停止: %s", rw.worker.Name())
+		case <-ctx.Done():
+			logger.Errorf("后台任务停止超时: %s", rw.worker.Name())
+		}
+	}
+
+	// 3. 逆序关闭资源
+	for i := len(l.closers) - 1; i >= 0; i-- {
+		c := l.closers[i]
+		if err := c.fn(); err != nil {
+			logger.Errorf("关闭资源失败: %s, err: %v", c.name, err)
+			continue
+		}
+		logger.Infof("资源已关闭: %s", c.name)
+	}
+	logger.Info("服务已停止")
+}
+
+// listenAddr 兼容"80"与":80"两种端口配置
+func listenAddr(port string) string {
+	if strings.Contains(port, ":") {
+		return port
+	}
+	return ":" + port
+}
+
+func seconds(n int) time.Duration {
+	return time.Duration(n) * time.Second
+}
@@ -0,0 +1,39 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/component/logger"
+)
+
+// tickerWorker 按固定间隔执行的后台任务
+type tickerWorker struct {
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) error
+}
+
+// NewTicker 创建定时任务, 每个间隔执行一次fn, 单次失败只记录日志不退出
+func NewTicker(name string, interval time.Duration, fn func(ctx context.Context) error) Worker {
+	return &tickerWorker{name: name, interval: interval, fn: fn}
+}
+
+func (w *tickerWorker) Name() string {
+	return w.name
+}
+
+func (w *tickerWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.fn(ctx); err != nil {
+				logger.Errorf("定时任务执行失败: %s, err: %v", w.name, err)
+			}
+		}
+	}
+}
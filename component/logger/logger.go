@@ -17,9 +17,10 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// 调用InitLogger之前(如单元测试中)日志被丢弃
 var (
-	Logger *zap.Logger
-	sugar  *zap.SugaredLogger
+	Logger = zap.NewNop()
+	sugar  = Logger.Sugar()
 )
 
 func InitLogger(cfg *config.Config) error {
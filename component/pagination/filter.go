@@ -0,0 +1,51 @@
+package pagination
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Filter 类型化筛选条件, 列名只能由代码给定, 取值为空时条件不生效
+type Filter func(db *gorm.DB) *gorm.DB
+
+// Eq 等值筛选
+func Eq[T any](column string, value *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if value == nil {
+			return db
+		}
+		return db.Where(fmt.Sprintf("%s = ?", column), *value)
+	}
+}
+
+// In 多值筛选
+func In[T any](column string, values []T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(values) == 0 {
+			return db
+		}
+		return db.Where(fmt.Sprintf("%s IN ?", column), values)
+	}
+}
+
+// Range 闭区间筛选, min/max任一为空时只限制另一端
+func Range[T any](column string, min, max *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if min != nil {
+			db = db.Where(fmt.Sprintf("%s >= ?", column), *min)
+		}
+		if max != nil {
+			db = db.Where(fmt.Sprintf("%s <= ?", column), *max)
+		}
+		return db
+	}
+}
+
+// Where 依次应用筛选条件
+func Where(db *gorm.DB, filters ...Filter) *gorm.DB {
+	for _, f := range filters {
+		db = f(db)
+	}
+	return db
+}
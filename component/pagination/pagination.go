@@ -0,0 +1,201 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultLimit = 20  // 默认每页条数
+	MaxLimit     = 100 // 每页条数上限
+)
+
+var (
+	// ErrInvalidQuery 分页参数不合法, 调用方据此返回400
+	ErrInvalidQuery = errors.New("无效的分页参数")
+	// ErrInvalidCursor 游标无效或与当前排序不匹配
+	ErrInvalidCursor = errors.Wrap(ErrInvalidQuery, "无效的分页游标")
+)
+
+// Spec 列表接口的分页规格: 允许排序的字段白名单及默认排序
+type Spec struct {
+	Fields       map[string]string // 对外排序字段 -> 数据库列
+	DefaultField string            // 默认排序字段
+	DefaultDesc  bool              // 默认是否倒序
+	IDColumn     string            // 主键列, 用于排序值相同时的稳定排序, 默认"id"
+}
+
+// Query 解析后的分页查询
+type Query struct {
+	Limit    int
+	Field    string
+	Column   string
+	Desc     bool
+	IDColumn string
+	after    *cursor
+}
+
+// Result 分页结果
+type Result struct {
+	NextCursor string `json:"next_cursor"` // 下一页游标, 为空表示没有更多数据
+	HasMore    bool   `json:"has_more"`    // 是否还有更多数据
+}
+
+// cursor 游标内容, 对客户端不透明
+type cursor struct {
+	Field string          `json:"f"`           // 排序字段
+	Desc  bool            `json:"d"`           // 是否倒序
+	Value json.RawMessage `json:"v"`           // 上一页最后一条记录的排序值
+	Time  bool            `json:"t,omitempty"` // 排序值是否为时间
+	ID    uint64          `json:"id"`          // 上一页最后一条记录的主键
+}
+
+// Parse 校验并解析分页参数, 排序字段不在白名单内或游标与排序不一致时返回错误
+func (s Spec) Parse(rawCursor string, limit int, sort, order string) (*Query, error) {
+	q := &Query{
+		Limit:    limit,
+		Field:    s.DefaultField,
+		Desc:     s.DefaultDesc,
+		IDColumn: s.IDColumn,
+	}
+	if q.IDColumn == "" {
+		q.IDColumn = "id"
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultLimit
+	}
+	if q.Limit > MaxLimit {
+		q.Limit = MaxLimit
+	}
+	if sort != "" {
+		q.Field = sort
+	}
+	switch order {
+	case "":
+	case "asc":
+		q.Desc = false
+	case "desc":
+		q.Desc = true
+	default:
+		return nil, errors.Wrapf(ErrInvalidQuery, "不支持的排序方向: %s", order)
+	}
+
+	column, ok := s.Fields[q.Field]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidQuery, "不支持的排序字段: %s", q.Field)
+	}
+	q.Column = column
+
+	if rawCursor != "" {
+		c, err := decodeCursor(rawCursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.Field != q.Field || c.Desc != q.Desc {
+			return nil, ErrInvalidCursor
+		}
+		q.after = c
+	}
+	return q, nil
+}
+
+// Apply 为查询追加游标条件、排序及limit(多取一条用于判断是否还有下一页)
+func (q *Query) Apply(db *gorm.DB) *gorm.DB {
+	op, dir := ">", "ASC"
+	if q.Desc {
+		op, dir = "<", "DESC"
+	}
+
+	if q.after != nil {
+		value, err := q.after.value()
+		if err != nil {
+			db.AddError(err)
+			return db
+		}
+		if q.Column == q.IDColumn {
+			db = db.Where(fmt.Sprintf("%s %s ?", q.IDColumn, op), q.after.ID)
+		} else {
+			db = db.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", q.Column, op, q.Column, q.IDColumn, op), value, value, q.after.ID)
+		}
+	}
+
+	if q.Column != q.IDColumn {
+		db = db.Order(fmt.Sprintf("%s %s", q.Column, dir))
+	}
+	return db.Order(fmt.Sprintf("%s %s", q.IDColumn, dir)).Limit(q.Limit + 1)
+}
+
+// Paginate 截取本页数据并生成下一页游标, key返回记录在排序字段上的值和主键
+func Paginate[T any](q *Query, items []T, key func(item T, field string) (interface{}, uint64)) ([]T, *Result, error) {
+	result := &Result{}
+	if len(items) <= q.Limit {
+		return items, result, nil
+	}
+
+	items = items[:q.Limit]
+	value, id := key(items[len(items)-1], q.Field)
+	next, err := encodeCursor(q.Field, q.Desc, value, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.NextCursor = next
+	result.HasMore = true
+	return items, result, nil
+}
+
+func encodeCursor(field string, desc bool, value interface{}, id uint64) (string, error) {
+	c := cursor{Field: field, Desc: desc, ID: id}
+	if t, ok := value.(time.Time); ok {
+		c.Time = true
+		value = t.UTC().Format(time.RFC3339Nano)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.Wrap(err, "生成分页游标失败")
+	}
+	c.Value = raw
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "生成分页游标失败")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(raw string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// value 还原排序值, 时间类型按UTC时间还原以便数据库正确比较
+func (c *cursor) value() (interface{}, error) {
+	if c.Time {
+		var s string
+		if err := json.Unmarshal(c.Value, &s); err != nil {
+			return nil, ErrInvalidCursor
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return t, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(c.Value, &v); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return v, nil
+}
@@ -0,0 +1,201 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var testSpec = Spec{
+	Fields: map[string]string{
+		"id":         "id",
+		"price":      "price",
+		"createTime": "create_time",
+	},
+	DefaultField: "price",
+}
+
+type testItem struct {
+	ID         uint64
+	Price      float64
+	CreateTime time.Time
+}
+
+func (t testItem) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "price":
+		return t.Price, t.ID
+	case "createTime":
+		return t.CreateTime, t.ID
+	}
+	return t.ID, t.ID
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		sort      string
+		order     string
+		wantLimit int
+		wantCol   string
+		wantDesc  bool
+		wantErr   bool
+	}{
+		{name: "默认值", wantLimit: DefaultLimit, wantCol: "price"},
+		{name: "超过上限", limit: 500, wantLimit: MaxLimit, wantCol: "price"},
+		{name: "指定排序", limit: 10, sort: "createTime", order: "desc", wantLimit: 10, wantCol: "create_time", wantDesc: true},
+		{name: "不支持的排序字段", sort: "owner", wantErr: true},
+		{name: "不支持的排序方向", order: "up", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := testSpec.Parse("", tt.limit, tt.sort, tt.order)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidQuery) {
+					t.Fatalf("err = %v, want ErrInvalidQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if q.Limit != tt.wantLimit || q.Column != tt.wantCol || q.Desc != tt.wantDesc || q.IDColumn != "id" {
+				t.Fatalf("got limit=%d column=%s desc=%v idColumn=%s", q.Limit, q.Column, q.Desc, q.IDColumn)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.FixedZone("CST", 8*3600))
+	tests := []struct {
+		name      string
+		sort      string
+		wantValue interface{}
+	}{
+		{name: "数值", sort: "price", wantValue: 12.5},
+		{name: "时间", sort: "createTime", wantValue: created.UTC()},
+		{name: "主键", sort: "id", wantValue: float64(2)},
+	}
+	items := []testItem{
+		{ID: 1, Price: 10, CreateTime: created.Add(-time.Hour)},
+		{ID: 2, Price: 12.5, CreateTime: created},
+		{ID: 3, Price: 20, CreateTime: created.Add(time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := testSpec.Parse("", 2, tt.sort, "")
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			page, result, err := Paginate(q, items, testItem.pageKey)
+			if err != nil {
+				t.Fatalf("paginate: %v", err)
+			}
+			if len(page) != 2 || !result.HasMore || result.NextCursor == "" {
+				t.Fatalf("got %d items, result %+v", len(page), result)
+			}
+
+			next, err := testSpec.Parse(result.NextCursor, 2, tt.sort, "")
+			if err != nil {
+				t.Fatalf("parse cursor: %v", err)
+			}
+			if next.after.ID != 2 {
+				t.Fatalf("cursor id = %d, want 2", next.after.ID)
+			}
+			value, err := next.after.value()
+			if err != nil {
+				t.Fatalf("cursor value: %v", err)
+			}
+			if tm, ok := tt.wantValue.(time.Time); ok {
+				if got, ok := value.(time.Time); !ok || !got.Equal(tm) {
+					t.Fatalf("cursor value = %v, want %v", value, tm)
+				}
+				return
+			}
+			if value != tt.wantValue {
+				t.Fatalf("cursor value = %v, want %v", value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestPaginateLastPage(t *testing.T) {
+	q, _ := testSpec.Parse("", 5, "", "")
+	page, result, err := Paginate(q, []testItem{{ID: 1}, {ID: 2}}, testItem.pageKey)
+	if err != nil {
+		t.Fatalf("paginate: %v", err)
+	}
+	if len(page) != 2 || result.HasMore || result.NextCursor != "" {
+		t.Fatalf("got %d items, result %+v", len(page), result)
+	}
+}
+
+func TestParseInvalidCursor(t *testing.T) {
+	q, _ := testSpec.Parse("", 1, "price", "")
+	_, result, _ := Paginate(q, []testItem{{ID: 1, Price: 1}, {ID: 2, Price: 2}}, testItem.pageKey)
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   string
+		order  string
+	}{
+		{name: "无法解码", cursor: "%%%", sort: "price"},
+		{name: "不是JSON", cursor: "bm90LWpzb24", sort: "price"},
+		{name: "排序字段不一致", cursor: result.NextCursor, sort: "id"},
+		{name: "排序方向不一致", cursor: result.NextCursor, sort: "price", order: "desc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testSpec.Parse(tt.cursor, 1, tt.sort, tt.order)
+			if !errors.Is(err, ErrInvalidCursor) || !errors.Is(err, ErrInvalidQuery) {
+				t.Fatalf("err = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "test:test@tcp(127.0.0.1:3306)/test", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	first, _ := testSpec.Parse("", 2, "price", "desc")
+	_, result, _ := Paginate(first, []testItem{{ID: 9, Price: 30}, {ID: 8, Price: 20}, {ID: 7, Price: 10}}, testItem.pageKey)
+	second, _ := testSpec.Parse(result.NextCursor, 2, "price", "desc")
+	byID, _ := testSpec.Parse("", 2, "id", "")
+
+	tests := []struct {
+		name     string
+		q        *Query
+		wantSQL  string
+		wantVars int
+	}{
+		{name: "首页", q: first, wantSQL: "SELECT * FROM `items` ORDER BY price DESC,id DESC LIMIT ?"},
+		{name: "游标页", q: second, wantSQL: "SELECT * FROM `items` WHERE (price < ? OR (price = ? AND id < ?)) ORDER BY price DESC,id DESC LIMIT ?", wantVars: 3},
+		{name: "按主键排序", q: byID, wantSQL: "SELECT * FROM `items` ORDER BY id ASC LIMIT ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var items []testItem
+			stmt := tt.q.Apply(db.Table("items")).Find(&items).Statement
+			if got := stmt.SQL.String(); got != tt.wantSQL {
+				t.Fatalf("sql = %s\nwant  %s", got, tt.wantSQL)
+			}
+			// 最后一个参数为limit, 比每页条数多取一条
+			if got := stmt.Vars[len(stmt.Vars)-1]; got != tt.q.Limit+1 {
+				t.Fatalf("limit = %v, want %d", got, tt.q.Limit+1)
+			}
+			if len(stmt.Vars)-1 != tt.wantVars {
+				t.Fatalf("vars = %v", stmt.Vars)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/convert"
+	"MetaFarmBackend/component/redis"
+
+	"github.com/pkg/errors"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "ratelimit:"
+
+// tokenBucketScript 令牌桶lua脚本, 使用redis服务器时间保证多实例共享同一时钟
+// 返回 {是否放行, 剩余令牌, 需等待毫秒数, 桶补满毫秒数}
+var tokenBucketScript = zeroredis.NewScript(`local key = KEYS[1]
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local requested = tonumber(ARGV[3])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local data = redis.call('HMGET', key, 'tokens', 'ts')
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local retry = 0
+if tokens >= requested then
+	tokens = tokens - requested
+	allowed = 1
+else
+	retry = math.ceil((requested - tokens) * 1000 / rate)
+end
+redis.call('HSET', key, 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', key, math.ceil(capacity * 1000 / rate) + 1000)
+return {allowed, math.floor(tokens), retry, math.ceil((capacity - tokens) * 1000 / rate)}`)
+
+// Rule 令牌桶规则
+type Rule struct {
+	Name     string  // 规则名称
+	Prefix   string  // 路由前缀
+	Capacity int     // 桶容量
+	Rate     float64 // 每秒补充令牌数
+}
+
+// Result 单次取令牌结果
+type Result struct {
+	Allowed    bool          // 是否放行
+	Limit      int           // 桶容量
+	Remaining  int           // 剩余令牌
+	RetryAfter time.Duration // 被拒绝时需等待的时间
+	Reset      time.Duration // 桶补满所需时间
+}
+
+// Limiter 基于redis的分布式令牌桶限流器
+type Limiter struct {
+	store       *redis.Store
+	enabled     bool
+	rules       []Rule
+	defaultRule Rule
+}
+
+// NewLimiter 创建限流器, 规则按前缀长度倒序匹配, api.max_num作为默认规则的每分钟配额
+func NewLimiter(cfg *config.Config, store *redis.Store) *Limiter {
+	l := &Limiter{
+		store:   store,
+		enabled: cfg.RateLimit.Enabled,
+	}
+	for _, r := range cfg.RateLimit.Rules {
+		if r.Capacity <= 0 || r.Rate <= 0 {
+			continue
+		}
+		l.rules = append(l.rules, Rule{Name: r.Name, Prefix: r.Prefix, Capacity: r.Capacity, Rate: r.Rate})
+	}
+	sort.SliceStable(l.rules, func(i, j int) bool {
+		return len(l.rules[i].Prefix) > len(l.rules[j].Prefix)
+	})
+
+	maxNum := cfg.API.MaxNum
+	if maxNum <= 0 {
+		maxNum = 500
+	}
+	l.defaultRule = Rule{Name: "default", Prefix: "/", Capacity: maxNum, Rate: float64(maxNum) / 60}
+	return l
+}
+
+// Enabled 是否启用限流
+func (l *Limiter) Enabled() bool {
+	return l.enabled
+}
+
+// Match 根据请求路径匹配规则
+func (l *Limiter) Match(path string) Rule {
+	for _, r := range l.rules {
+		if strings.HasPrefix(path, r.Prefix) {
+			return r
+		}
+	}
+	return l.defaultRule
+}
+
+// Take 从identity对应的令牌桶中取一个令牌
+func (l *Limiter) Take(ctx context.Context, rule Rule, identity string) (*Result, error) {
+	key := fmt.Sprintf("%s%s:%s", keyPrefix, rule.Name, identity)
+	resp, err := l.store.Redis.ScriptRunCtx(ctx, tokenBucketScript, []string{key}, rule.Capacity, rule.Rate, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "执行限流脚本失败")
+	}
+
+	values, ok := resp.([]interface{})
+	if !ok || len(values) != 4 {
+		return nil, errors.Errorf("限流脚本返回值异常: %v", resp)
+	}
+
+	return &Result{
+		Allowed:    convert.ToInt64(values[0]) == 1,
+		Limit:      rule.Capacity,
+		Remaining:  int(convert.ToInt64(values[1])),
+		RetryAfter: time.Duration(convert.ToInt64(values[2])) * time.Millisecond,
+		Reset:      time.Duration(convert.ToInt64(values[3])) * time.Millisecond,
+	}, nil
+}
+
+// Seconds 将时长向上取整为秒, 用于响应头
+func Seconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
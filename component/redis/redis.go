@@ -32,7 +32,10 @@ func InitRedis(cfg *config.Config) (*Store, error) {
 		})
 	}
 
-	rd := redis.MustNewRedis(kvConf[0].RedisConf)
+	rd, err := redis.NewRedis(kvConf[0].RedisConf)
+	if err != nil {
+		return nil, err
+	}
 	store := &Store{
 		Store: kv.NewStore(kvConf),
 		Redis: rd,
@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 动物健康值的取值范围
+const (
+	AnimalHealthMin = 0   // 健康值下限, 降到下限时动物饿死
+	AnimalHealthMax = 100 // 健康值上限, 放养及出生时为满值
+)
+
+// BreedingAnimal 养殖动物表结构体, 每个养殖活动对应一群动物, 记录健康、喂养、产物周期及繁殖状态
+type BreedingAnimal struct {
+	ID               uint64     `gorm:"primaryKey;column:id" json:"id"`                                   // 主键ID
+	ActivityID       uint64     `gorm:"column:activity_id;uniqueIndex" json:"activity_id"`                // 养殖活动ID
+	LandTokenID      string     `gorm:"column:land_token_id;index" json:"land_token_id"`                  // 土地NFT TokenID
+	ZoneID           uint64     `gorm:"column:zone_id;index" json:"zone_id"`                              // 所在分区ID
+	OwnerAddress     string     `gorm:"column:owner_address;type:varchar(42);index" json:"owner_address"` // 所有者钱包地址
+	AnimalID         uint64     `gorm:"column:animal_id" json:"animal_id"`                                // 动物ID
+	AnimalName       string     `gorm:"column:animal_name;type:varchar(50)" json:"animal_name"`           // 动物名称
+	Health           int        `gorm:"column:health;default:100" json:"health"`                          // 健康值, 不喂养时随时间下降
+	HealthSettledAt  time.Time  `gorm:"column:health_settled_at" json:"health_settled_at"`                // 健康值结算时间
+	StarveTime       time.Time  `gorm:"column:starve_time;index" json:"starve_time"`                      // 不再喂养时的饿死时间
+	LastFeedTime     *time.Time `gorm:"column:last_feed_time" json:"last_feed_time"`                      // 最后喂养时间
+	NextCollectTime  time.Time  `gorm:"column:next_collect_time" json:"next_collect_time"`                // 下次可收集产物的时间
+	CollectCount     int        `gorm:"column:collect_count;default:0" json:"collect_count"`              // 已收集产物次数
+	LastBreedTime    *time.Time `gorm:"column:last_breed_time" json:"last_breed_time"`                    // 最后繁殖时间
+	ParentActivityID uint64     `gorm:"column:parent_activity_id;default:0" json:"parent_activity_id"`    // 繁殖出该群动物的养殖活动ID, 放养的为0
+	Generation       int        `gorm:"column:generation;default:1" json:"generation"`                    // 代数, 放养的为第1代
+	IsAlive          int8       `gorm:"column:is_alive;default:1;index" json:"is_alive"`                  // 是否存活(0:否, 1:是), 饿死后为0
+	CreateTime       time.Time  `gorm:"column:create_time" json:"create_time"`                            // 创建时间
+	UpdateTime       time.Time  `gorm:"column:update_time;autoUpdateTime" json:"update_time"`             // 更新时间
+}
+
+func (BreedingAnimal) TableName() string {
+	return "breeding_animal"
+}
+
+// NewBreedingAnimal 为养殖活动创建动物记录, 成年后开始第一个产物周期; starveAfter为满健康值时不喂养饿死所需的时长
+func NewBreedingAnimal(activity *LandActivity, starveAfter time.Duration) *BreedingAnimal {
+	now := time.Now()
+	return &BreedingAnimal{
+		ActivityID:      activity.ID,
+		LandTokenID:     activity.LandTokenID,
+		ZoneID:          activity.ZoneID,
+		OwnerAddress:    activity.OwnerAddress,
+		AnimalID:        activity.CropAnimalID,
+		AnimalName:      activity.CropAnimalName,
+		Health:          AnimalHealthMax,
+		HealthSettledAt: now,
+		StarveTime:      now.Add(starveAfter),
+		NextCollectTime: activity.ExpectedEndTime,
+		Generation:      1,
+		IsAlive:         1,
+		CreateTime:      now,
+		UpdateTime:      now,
+	}
+}
+
+// GetBreedingAnimalsByLandTokenID 获取土地上存活的养殖动物
+func (dao *Dao) GetBreedingAnimalsByLandTokenID(ctx context.Context, landTokenID string) ([]*BreedingAnimal, error) {
+	var animals []*BreedingAnimal
+	err := dao.DB.WithContext(ctx).Where("land_token_id = ? AND is_alive = 1", landTokenID).Order("id").Find(&animals).Error
+	return animals, err
+}
+
+// LockBreedingAnimal 在事务中锁定养殖活动对应的动物
+func (dao *Dao) LockBreedingAnimal(ctx context.Context, tx *gorm.DB, activityID uint64) (*BreedingAnimal, error) {
+	var animal BreedingAnimal
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("activity_id = ?", activityID).First(&animal).Error
+	return &animal, err
+}
+
+// GetStarvedAnimals 查询已到饿死时间仍存活的动物
+func (dao *Dao) GetStarvedAnimals(ctx context.Context, now time.Time, limit int) ([]*BreedingAnimal, error) {
+	var animals []*BreedingAnimal
+	err := dao.DB.WithContext(ctx).Where("is_alive = 1 AND starve_time <= ?", now).
+		Order("starve_time ASC").Limit(limit).Find(&animals).Error
+	return animals, err
+}
+
+// CreateBreedingAnimal 创建养殖动物记录
+func (dao *Dao) CreateBreedingAnimal(ctx context.Context, tx *gorm.DB, animal *BreedingAnimal) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Create(animal).Error
+}
+
+// UpdateBreedingAnimal 更新养殖动物记录
+func (dao *Dao) UpdateBreedingAnimal(ctx context.Context, tx *gorm.DB, animal *BreedingAnimal) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Save(animal).Error
+}
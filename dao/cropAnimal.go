@@ -0,0 +1,247 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 季节枚举, 按月份划分: 3-5月春, 6-8月夏, 9-11月秋, 12-2月冬
+const (
+	SeasonSpring int8 = iota // 0: 春
+	SeasonSummer             // 1: 夏
+	SeasonAutumn             // 2: 秋
+	SeasonWinter             // 3: 冬
+)
+
+// CropAnimal 作物/动物目录表结构体, 定义种植与养殖规则
+// 适宜地形、分区类型及季节为逗号分隔的枚举值, 为空表示不限
+type CropAnimal struct {
+	ID              uint64    `gorm:"primaryKey;column:id" json:"id"`                              // 主键ID, 即作物/动物ID
+	Name            string    `gorm:"column:name;type:varchar(50);uniqueIndex" json:"name"`        // 名称
+	Kind            int8      `gorm:"column:kind;index" json:"kind"`                               // 类型, 与活动类型一致(0-作物,1-动物)
+	GrowthMinutes   int       `gorm:"column:growth_minutes" json:"growth_minutes"`                 // 生长时长(分钟)
+	FertilityPerSqm int       `gorm:"column:fertility_per_sqm" json:"fertility_per_sqm"`           // 每平方米消耗肥力
+	BaseYield       float64   `gorm:"column:base_yield;type:decimal(10,2)" json:"base_yield"`      // 每平方米基础产量
+	LandTypes       string    `gorm:"column:land_types;type:varchar(32)" json:"land_types"`        // 适宜地形(0-平原,1-湿地,2-山地)
+	ZoneTypes       string    `gorm:"column:zone_types;type:varchar(32)" json:"zone_types"`        // 适宜分区类型(0-种植区,1-养殖区,2-装饰区)
+	Seasons         string    `gorm:"column:seasons;type:varchar(16)" json:"seasons"`              // 可种植季节(0-春,1-夏,2-秋,3-冬)
+	UnlockLevel     int8      `gorm:"column:unlock_level;default:1" json:"unlock_level"`           // 解锁所需土地等级
+	WitherMinutes   int       `gorm:"column:wither_minutes" json:"wither_minutes"`                 // 作物成熟后未收获多久枯萎(分钟), 为0时使用全局配置
+	WitherRefund    float64   `gorm:"column:wither_refund;type:decimal(4,2)" json:"wither_refund"` // 枯萎时退还种植所耗肥力的比例, 为0时全部损失
+	ProductName     string    `gorm:"column:product_name;type:varchar(50)" json:"product_name"`    // 动物产物名称, 如鸡蛋、牛奶
+	CycleMinutes    int       `gorm:"column:cycle_minutes" json:"cycle_minutes"`                   // 动物成年后的产物周期(分钟), 为0时与生长时长相同
+	FeedCropIDs     string    `gorm:"column:feed_crop_ids;type:varchar(64)" json:"feed_crop_ids"`  // 可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具
+	OffspringArea   int       `gorm:"column:offspring_area" json:"offspring_area"`                 // 动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖
+	Enabled         bool      `gorm:"column:enabled" json:"enabled"`                               // 是否上架
+	CreateTime      time.Time `gorm:"column:create_time" json:"create_time"`                       // 创建时间
+	UpdateTime      time.Time `gorm:"column:update_time" json:"update_time"`                       // 更新时间
+}
+
+func (CropAnimal) TableName() string {
+	return "crop_animal"
+}
+
+// GrowthDuration 生长时长
+func (c *CropAnimal) GrowthDuration() time.Duration {
+	return time.Duration(c.GrowthMinutes) * time.Minute
+}
+
+// WitherGrace 成熟后的收获宽限期, 目录未配置时使用defaultGrace
+func (c *CropAnimal) WitherGrace(defaultGrace time.Duration) time.Duration {
+	if c.WitherMinutes > 0 {
+		return time.Duration(c.WitherMinutes) * time.Minute
+	}
+	return defaultGrace
+}
+
+// ProductCycle 动物的产物周期, 目录未配置时与生长时长相同
+func (c *CropAnimal) ProductCycle() time.Duration {
+	if c.CycleMinutes > 0 {
+		return time.Duration(c.CycleMinutes) * time.Minute
+	}
+	return c.GrowthDuration()
+}
+
+// ProductLabel 动物产物名称, 目录未配置时使用动物名称
+func (c *CropAnimal) ProductLabel() string {
+	if c.ProductName != "" {
+		return c.ProductName
+	}
+	return c.Name
+}
+
+// AcceptsFeed 是否可用该作物的收获物喂养
+func (c *CropAnimal) AcceptsFeed(cropID uint64) bool {
+	for _, id := range ParseIDList(c.FeedCropIDs) {
+		if id == cropID {
+			return true
+		}
+	}
+	return false
+}
+
+// SuitsLandType 是否适宜该地形
+func (c *CropAnimal) SuitsLandType(landType int8) bool {
+	return allowsInt8(c.LandTypes, landType)
+}
+
+// SuitsZoneType 是否适宜该分区类型
+func (c *CropAnimal) SuitsZoneType(zoneType int8) bool {
+	return allowsInt8(c.ZoneTypes, zoneType)
+}
+
+// InSeason 指定时间是否在可种植季节内
+func (c *CropAnimal) InSeason(t time.Time) bool {
+	return allowsInt8(c.Seasons, SeasonOf(t))
+}
+
+// SeasonOf 时间所在的季节
+func SeasonOf(t time.Time) int8 {
+	// 12-2月为0、3-5月为1, 依次类推, 再平移使春季为0
+	return int8((t.Month()%12/3 + 3) % 4)
+}
+
+// ParseInt8List 解析逗号分隔的枚举值, 忽略无法解析的项
+func ParseInt8List(csv string) []int8 {
+	var values []int8
+	for _, item := range strings.Split(csv, ",") {
+		if value, err := strconv.Atoi(strings.TrimSpace(item)); err == nil {
+			values = append(values, int8(value))
+		}
+	}
+	return values
+}
+
+// FormatInt8List 将枚举值格式化为逗号分隔的字符串
+func FormatInt8List(values []int8) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, strconv.Itoa(int(value)))
+	}
+	return strings.Join(items, ",")
+}
+
+// ParseIDList 解析逗号分隔的ID, 忽略无法解析的项
+func ParseIDList(csv string) []uint64 {
+	var ids []uint64
+	for _, item := range strings.Split(csv, ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(item), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// FormatIDList 将ID格式化为逗号分隔的字符串
+func FormatIDList(ids []uint64) string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, strconv.FormatUint(id, 10))
+	}
+	return strings.Join(items, ",")
+}
+
+// allowsInt8 逗号分隔的枚举值为空表示不限, 否则需包含value
+func allowsInt8(csv string, value int8) bool {
+	if strings.TrimSpace(csv) == "" {
+		return true
+	}
+	for _, v := range ParseInt8List(csv) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCropAnimalByID 根据ID获取作物/动物
+func (dao *Dao) GetCropAnimalByID(ctx context.Context, id uint64) (*CropAnimal, error) {
+	var item CropAnimal
+	err := dao.DB.WithContext(ctx).Where("id = ?", id).First(&item).Error
+	return &item, err
+}
+
+// GetCropAnimalsByIDs 批量获取作物/动物, 按ID索引
+func (dao *Dao) GetCropAnimalsByIDs(ctx context.Context, ids []uint64) (map[uint64]*CropAnimal, error) {
+	var items []*CropAnimal
+	if err := dao.DB.WithContext(ctx).Where("id IN ?", ids).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]*CropAnimal, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	return byID, nil
+}
+
+// ListCropAnimals 获取作物/动物目录, kind为空表示全部类型, enabledOnly为true时只返回上架的条目
+func (dao *Dao) ListCropAnimals(ctx context.Context, kind *int8, enabledOnly bool) ([]*CropAnimal, error) {
+	var items []*CropAnimal
+	db := dao.DB.WithContext(ctx)
+	if kind != nil {
+		db = db.Where("kind = ?", *kind)
+	}
+	if enabledOnly {
+		db = db.Where("enabled = ?", true)
+	}
+	err := db.Order("kind, unlock_level, id").Find(&items).Error
+	return items, err
+}
+
+// CreateCropAnimal 创建作物/动物
+func (dao *Dao) CreateCropAnimal(ctx context.Context, item *CropAnimal) error {
+	now := time.Now()
+	item.CreateTime = now
+	item.UpdateTime = now
+	return dao.DB.WithContext(ctx).Create(item).Error
+}
+
+// UpdateCropAnimal 更新作物/动物
+func (dao *Dao) UpdateCropAnimal(ctx context.Context, item *CropAnimal) error {
+	item.UpdateTime = time.Now()
+	return dao.DB.WithContext(ctx).Save(item).Error
+}
+
+// DeleteCropAnimal 删除作物/动物, 已有的种植记录保留名称不受影响
+func (dao *Dao) DeleteCropAnimal(ctx context.Context, id uint64) (bool, error) {
+	result := dao.DB.WithContext(ctx).Where("id = ?", id).Delete(&CropAnimal{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// SeedCropAnimals 目录表为空时从JSON文件导入初始数据, path为空时跳过
+func (dao *Dao) SeedCropAnimals(ctx context.Context, path string) error {
+	if path == "" {
+		return nil
+	}
+	var count int64
+	if err := dao.DB.WithContext(ctx).Model(&CropAnimal{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "读取作物/动物目录文件失败: %s", path)
+	}
+	var items []*CropAnimal
+	if err := json.Unmarshal(data, &items); err != nil {
+		return errors.Wrapf(err, "解析作物/动物目录文件失败: %s", path)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, item := range items {
+		item.CreateTime = now
+		item.UpdateTime = now
+	}
+	return dao.DB.WithContext(ctx).Create(items).Error
+}
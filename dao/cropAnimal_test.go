@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSeasonOf(t *testing.T) {
+	tests := []struct {
+		month time.Month
+		want  int8
+	}{
+		{time.March, 0}, {time.April, 0}, {time.May, 0},
+		{time.June, 1}, {time.July, 1}, {time.August, 1},
+		{time.September, 2}, {time.October, 2}, {time.November, 2},
+		{time.December, 3}, {time.January, 3}, {time.February, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.month.String(), func(t *testing.T) {
+			if got := SeasonOf(time.Date(2026, tt.month, 15, 12, 0, 0, 0, time.UTC)); got != tt.want {
+				t.Fatalf("SeasonOf(%s) = %d, want %d", tt.month, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCropAnimalSuits(t *testing.T) {
+	summer := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		crop     CropAnimal
+		landType int8
+		zoneType int8
+		want     bool
+	}{
+		{name: "未配置表示不限", crop: CropAnimal{}, landType: 2, zoneType: 1, want: true},
+		{name: "全部满足", crop: CropAnimal{LandTypes: "0,1", ZoneTypes: "0", Seasons: "0, 1"}, landType: 1, zoneType: 0, want: true},
+		{name: "地形不适宜", crop: CropAnimal{LandTypes: "0,1"}, landType: 2, want: false},
+		{name: "分区不适宜", crop: CropAnimal{ZoneTypes: "1"}, zoneType: 0, want: false},
+		{name: "不在季节内", crop: CropAnimal{Seasons: "2,3"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.crop.SuitsLandType(tt.landType) && tt.crop.SuitsZoneType(tt.zoneType) && tt.crop.InSeason(summer)
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8ListRoundTrip(t *testing.T) {
+	tests := []struct {
+		csv  string
+		want []int8
+		out  string
+	}{
+		{csv: "", want: nil, out: ""},
+		{csv: "0", want: []int8{0}, out: "0"},
+		{csv: " 0, 2 ,x,1", want: []int8{0, 2, 1}, out: "0,2,1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.csv, func(t *testing.T) {
+			got := ParseInt8List(tt.csv)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseInt8List(%q) = %v, want %v", tt.csv, got, tt.want)
+			}
+			if out := FormatInt8List(got); out != tt.out {
+				t.Fatalf("FormatInt8List(%v) = %q, want %q", got, out, tt.out)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package dao
 
 import (
 	"context"
+	"strings"
 
 	"MetaFarmBackend/component/redis"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Dao is show dao.
@@ -22,3 +24,14 @@ func NewDao(ctx context.Context, db *gorm.DB, kvStore *redis.Store) *Dao {
 		KvStore: kvStore,
 	}
 }
+
+// caseExpr 生成按column取值的CASE表达式, pairs依次为column的值及对应的结果, 用于一条UPDATE为多行设置不同的值
+func caseExpr(column string, pairs ...interface{}) clause.Expr {
+	var sql strings.Builder
+	sql.WriteString("CASE " + column)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		sql.WriteString(" WHEN ? THEN ?")
+	}
+	sql.WriteString(" END")
+	return gorm.Expr(sql.String(), pairs...)
+}
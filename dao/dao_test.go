@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"MetaFarmBackend/component/pagination"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func newDryRunDao(t *testing.T) *Dao {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "test:test@tcp(127.0.0.1:3306)/test", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return NewDao(context.Background(), db, nil)
+}
+
+func TestHarvestLandActivitiesSingleUpdate(t *testing.T) {
+	d := newDryRunDao(t)
+	var stmt *gorm.Statement
+	tx := d.DB.Session(&gorm.Session{})
+	if err := tx.Callback().Update().After("gorm:update").Register("test:capture", func(db *gorm.DB) { stmt = db.Statement }); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if err := d.HarvestLandActivities(context.Background(), tx, map[uint64]int{9: 30, 3: 12}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	want := "UPDATE `land_activity` SET `actual_end_time`=?,`status`=?,`update_time`=?,`yield`=CASE id WHEN ? THEN ? WHEN ? THEN ? END WHERE id IN (?,?) AND status = ?"
+	if got := stmt.SQL.String(); got != want {
+		t.Fatalf("sql = %s\nwant  %s", got, want)
+	}
+	if got := stmt.Vars[3:7]; !reflect.DeepEqual(got, []interface{}{uint64(3), 12, uint64(9), 30}) {
+		t.Fatalf("case vars = %v", got)
+	}
+}
+
+func TestUpdateFertilitiesSingleUpdate(t *testing.T) {
+	d := newDryRunDao(t)
+	var stmt *gorm.Statement
+	tx := d.DB.Session(&gorm.Session{})
+	if err := tx.Callback().Update().After("gorm:update").Register("test:capture", func(db *gorm.DB) { stmt = db.Statement }); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	settled := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	lands := []*LandInfo{
+		{LandTokenID: "a", Fertility: 40, FertilityUpdateTime: &settled},
+		{LandTokenID: "b", Fertility: 70, FertilityUpdateTime: &settled},
+	}
+	if err := d.UpdateFertilities(context.Background(), tx, lands); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	want := "UPDATE `land_info` SET `fertility`=CASE land_token_id WHEN ? THEN ? WHEN ? THEN ? END,`fertility_update_time`=CASE land_token_id WHEN ? THEN ? WHEN ? THEN ? END,`update_time`=? WHERE land_token_id IN (?,?)"
+	if got := stmt.SQL.String(); got != want {
+		t.Fatalf("sql = %s\nwant  %s", got, want)
+	}
+}
+
+func TestFertilityRegenExpr(t *testing.T) {
+	regen := FertilityRegen{PerHour: 2, RarityBonus: 0.1, RarityCap: 10, SpecialEffect: map[string]float64{"黄金土地": 2, "湿润'土地": 1.5}}
+	want := "(CASE WHEN land_info.fertility >= (land_info.fertility_cap + land_info.rarity * 10) THEN land_info.fertility" +
+		" ELSE LEAST((land_info.fertility_cap + land_info.rarity * 10), land_info.fertility + FLOOR(" +
+		"GREATEST(TIMESTAMPDIFF(MICROSECOND, COALESCE(land_info.fertility_update_time, land_info.update_time), NOW(6)), 0)" +
+		" * 2 * (1 + land_info.rarity * 0.1)" +
+		" * (1 + COALESCE((SELECT SUM(land_layout.bonus_value) FROM land_layout WHERE land_layout.land_token_id = land_info.land_token_id AND land_layout.has_adjacent_bonus = 1 AND land_layout.bonus_type = 0), 0) / 100)" +
+		" * (CASE land_info.special_effect WHEN '湿润''土地' THEN 1.5 WHEN '黄金土地' THEN 2 ELSE 1 END) / 3600000000)) END)"
+	if got := regen.Expr(); got != want {
+		t.Fatalf("expr = %s\nwant   %s", got, want)
+	}
+	if got := (FertilityRegen{PerHour: 2}).Expr(); !strings.Contains(got, " * (1) / 3600000000") {
+		t.Fatalf("expr without special effects = %s", got)
+	}
+}
+
+func TestSearchLandsByCurrentFertility(t *testing.T) {
+	d := newDryRunDao(t)
+	var stmt *gorm.Statement
+	if err := d.DB.Callback().Query().After("gorm:query").Register("test:capture", func(db *gorm.DB) { stmt = db.Statement }); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	regen := FertilityRegen{PerHour: 2, RarityCap: 10}
+	q, err := LandSearchPageSpec(regen).Parse("", 10, "fertility", "desc")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	minFertility := 50
+	if _, _, err := d.SearchLands(context.Background(), LandSearchFilter{Regen: regen, MinFertility: &minFertility}, q); err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	sql, expr := stmt.SQL.String(), regen.Expr()
+	for _, want := range []string{expr + " AS current_fertility", expr + " >= ?", "ORDER BY " + expr + " DESC"} {
+		if !strings.Contains(sql, want) {
+			t.Fatalf("sql missing %q:\n%s", want, sql)
+		}
+	}
+
+	// 游标取当前肥力, 与返回值一致
+	results := make([]*LandSearchResult, 11)
+	for i := range results {
+		results[i] = &LandSearchResult{LandInfo: LandInfo{ID: uint64(i + 1), Fertility: 80}, CurrentFertility: 95}
+		results[i].Fertility = results[i].CurrentFertility
+	}
+	_, page, err := pagination.Paginate(q, results, (*LandSearchResult).pageKey)
+	if err != nil {
+		t.Fatalf("paginate: %v", err)
+	}
+	next, err := LandSearchPageSpec(regen).Parse(page.NextCursor, 10, "fertility", "desc")
+	if err != nil {
+		t.Fatalf("parse cursor: %v", err)
+	}
+	if got := d.DB.ToSQL(func(tx *gorm.DB) *gorm.DB { return next.Apply(tx.Model(&LandInfo{})).Find(&[]*LandInfo{}) }); !strings.Contains(got, "("+expr+" < 95 OR ("+expr+" = 95 AND land_info.id < 10))") {
+		t.Fatalf("cursor condition not on current fertility:\n%s", got)
+	}
+}
@@ -17,8 +17,12 @@ func InitTable() {
 	db.DB.AutoMigrate(&LandMarket{})
 	db.DB.AutoMigrate(&LandRental{})
 	db.DB.AutoMigrate(&LandUpgrade{})
+	db.DB.AutoMigrate(&LandUpgradeRule{})
+	db.DB.AutoMigrate(&CropAnimal{})
+	db.DB.AutoMigrate(&UserProduce{})
 	db.DB.AutoMigrate(&MarketListings{})
 	db.DB.AutoMigrate(&PlotPlanting{})
+	db.DB.AutoMigrate(&BreedingAnimal{})
 	db.DB.AutoMigrate(&TransactionRecords{})
 
 }
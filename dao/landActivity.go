@@ -2,9 +2,11 @@ package dao
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // 土地活动类型枚举
@@ -18,21 +20,27 @@ const (
 	ActivityStatusGrowing   int8 = iota // 0: 生长中
 	ActivityStatusHarvested             // 1: 已收获
 	ActivityStatusDead                  // 2: 枯萎
+	ActivityStatusCleared               // 3: 枯萎后已清理
 )
 
 // LandActivity 土地活动记录表结构体
 type LandActivity struct {
 	ID              uint64     `gorm:"primaryKey;column:id"`                        // 主键ID
 	LandTokenID     string     `gorm:"column:land_token_id;index"`                  // 土地NFT TokenID
+	ZoneID          uint64     `gorm:"column:zone_id;index"`                        // 所在分区ID
 	OwnerAddress    string     `gorm:"column:owner_address;type:varchar(42);index"` // 所有者钱包地址
 	ActivityType    int8       `gorm:"column:activity_type;index"`                  // 活动类型(0-种植,1-养殖)
 	CropAnimalID    uint64     `gorm:"column:crop_animal_id"`                       // 作物/动物ID
 	CropAnimalName  string     `gorm:"column:crop_animal_name;type:varchar(50)"`    // 作物/动物名称
 	Area            int        `gorm:"column:area"`                                 // 占用面积(㎡)
 	FertilityCost   int        `gorm:"column:fertility_cost"`                       // 消耗肥力值
+	PlantFertility  int        `gorm:"column:plant_fertility;default:100"`          // 种植时的土地肥力, 影响收获产量
+	BaseYield       float64    `gorm:"column:base_yield;type:decimal(10,2)"`        // 种植时目录中的每平方米基础产量
+	Yield           int        `gorm:"column:yield;default:0"`                      // 收获产量
 	Start_time      time.Time  `gorm:"column:start_time"`                           // 开始时间
 	ExpectedEndTime time.Time  `gorm:"column:expected_end_time"`                    // 预计结束时间
-	Status          int8       `gorm:"column:status;default:0;index"`               // 状态(0-进行中,1-已完成,2-已中断)
+	Status          int8       `gorm:"column:status;default:0;index"`               // 状态(0-生长中,1-已收获,2-枯萎,3-已清理)
+	WitherTime      *time.Time `gorm:"column:wither_time;index"`                    // 未收获时的枯萎时间
 	ActualEndTime   *time.Time `gorm:"column:actual_end_time"`                      // 实际结束时间
 	CreateTime      time.Time  `gorm:"column:create_time"`                          // 创建时间
 	UpdateTime      time.Time  `gorm:"column:update_time"`                          // 更新时间
@@ -42,9 +50,10 @@ func (LandActivity) TableName() string {
 	return "land_activity"
 }
 
-func NewLandActivity(landTokenID string, ownerAddress string, activityType int8, cropAnimalID uint64, name string, area, fertilityCost int, durationHours int) *LandActivity {
+// NewLandActivity 创建进行中的种植/养殖活动, growth为生长时长
+func NewLandActivity(landTokenID string, ownerAddress string, activityType int8, cropAnimalID uint64, name string, area, fertilityCost int, growth time.Duration) *LandActivity {
 	now := time.Now()
-	endTime := now.Add(time.Duration(durationHours) * time.Hour)
+	endTime := now.Add(growth)
 	return &LandActivity{
 		LandTokenID:     landTokenID,
 		OwnerAddress:    ownerAddress,
@@ -61,12 +70,33 @@ func NewLandActivity(landTokenID string, ownerAddress string, activityType int8,
 	}
 }
 
+// WitherDeadline 枯萎时间, 早期记录没有枯萎时间时按成熟时间加defaultGrace计算
+func (a *LandActivity) WitherDeadline(defaultGrace time.Duration) time.Time {
+	if a.WitherTime != nil {
+		return *a.WitherTime
+	}
+	return a.ExpectedEndTime.Add(defaultGrace)
+}
+
 func (dao *Dao) GetLandActivityByID(ctx context.Context, activityID uint64) (*LandActivity, error) {
 	var activity LandActivity
 	err := dao.DB.WithContext(ctx).Where("id = ?", activityID).First(&activity).Error
 	return &activity, err
 }
 
+// GetLandActivitiesByIDs 批量获取活动, 按活动ID索引
+func (dao *Dao) GetLandActivitiesByIDs(ctx context.Context, activityIDs []uint64) (map[uint64]*LandActivity, error) {
+	var activities []*LandActivity
+	if err := dao.DB.WithContext(ctx).Where("id IN ?", activityIDs).Find(&activities).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]*LandActivity, len(activities))
+	for _, activity := range activities {
+		byID[activity.ID] = activity
+	}
+	return byID, nil
+}
+
 func (dao *Dao) GetActiveByTokenID(ctx context.Context, landTokenID string) ([]*LandActivity, error) {
 	var activities []*LandActivity
 	err := dao.DB.WithContext(ctx).Where("land_token_id = ? AND status = 0", landTokenID).Find(&activities).Error
@@ -88,9 +118,129 @@ func (dao *Dao) UpdateLandActivityStatus(ctx context.Context, tx *gorm.DB, activ
 	return tx.WithContext(ctx).Model(&LandActivity{}).Where("id = ?", activityID).Updates(updateData).Error
 }
 
+// HarvestLandActivity 将生长中的活动标记为已收获并记录产量, 活动已不在生长中时不更新并返回false
+func (dao *Dao) HarvestLandActivity(ctx context.Context, tx *gorm.DB, activityID uint64, yield int) (bool, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	now := time.Now()
+	result := tx.WithContext(ctx).Model(&LandActivity{}).
+		Where("id = ? AND status = ?", activityID, ActivityStatusGrowing).
+		Updates(map[string]interface{}{
+			"status":          ActivityStatusHarvested,
+			"yield":           yield,
+			"actual_end_time": now,
+			"update_time":     now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// LockGrowingActivityIDs 在事务中按ID顺序锁定仍在生长中的活动, 返回锁定的活动ID
+func (dao *Dao) LockGrowingActivityIDs(ctx context.Context, tx *gorm.DB, activityIDs []uint64) ([]uint64, error) {
+	var locked []uint64
+	err := tx.WithContext(ctx).Model(&LandActivity{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND status = ?", activityIDs, ActivityStatusGrowing).
+		Order("id").Pluck("id", &locked).Error
+	return locked, err
+}
+
+// HarvestLandActivities 用一条UPDATE将生长中的活动批量标记为已收获, yields为各活动ID对应的产量;
+// 调用方应先用LockGrowingActivityIDs锁定活动, 保证各活动都会被更新
+func (dao *Dao) HarvestLandActivities(ctx context.Context, tx *gorm.DB, yields map[uint64]int) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	if len(yields) == 0 {
+		return nil
+	}
+	activityIDs := make([]uint64, 0, len(yields))
+	for id := range yields {
+		activityIDs = append(activityIDs, id)
+	}
+	sort.Slice(activityIDs, func(i, j int) bool { return activityIDs[i] < activityIDs[j] })
+	pairs := make([]interface{}, 0, 2*len(activityIDs))
+	for _, id := range activityIDs {
+		pairs = append(pairs, id, yields[id])
+	}
+	now := time.Now()
+	return tx.WithContext(ctx).Model(&LandActivity{}).
+		Where("id IN ? AND status = ?", activityIDs, ActivityStatusGrowing).
+		Updates(map[string]interface{}{
+			"status":          ActivityStatusHarvested,
+			"yield":           caseExpr("id", pairs...),
+			"actual_end_time": now,
+			"update_time":     now,
+		}).Error
+}
+
 func (dao *Dao) CreateLandActivity(ctx context.Context, tx *gorm.DB, activity *LandActivity) error {
 	if tx == nil {
 		tx = dao.DB
 	}
 	return tx.WithContext(ctx).Create(activity).Error
 }
+
+// CreateLandActivities 批量创建活动, 创建后回填各活动ID
+func (dao *Dao) CreateLandActivities(ctx context.Context, tx *gorm.DB, activities []*LandActivity) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Create(activities).Error
+}
+
+// GetHarvestableActivities 查询用户已成熟且未过枯萎时间的作物, 早期没有枯萎时间的记录按成熟时间加defaultGrace判断
+func (dao *Dao) GetHarvestableActivities(ctx context.Context, ownerAddress string, now time.Time, defaultGrace time.Duration, limit int) ([]*LandActivity, error) {
+	var activities []*LandActivity
+	err := dao.DB.WithContext(ctx).
+		Where("owner_address = ? AND status = ? AND activity_type = ? AND expected_end_time <= ?", ownerAddress, ActivityStatusGrowing, ActivityTypePlanting, now).
+		Where("wither_time > ? OR (wither_time IS NULL AND expected_end_time > ?)", now, now.Add(-defaultGrace)).
+		Order("expected_end_time ASC, id ASC").Limit(limit).Find(&activities).Error
+	return activities, err
+}
+
+// GetMaturedActivities 查询在[since, until]内成熟且仍在生长中的活动, 用于推送作物成熟事件
+func (dao *Dao) GetMaturedActivities(ctx context.Context, since, until time.Time, limit int) ([]*LandActivity, error) {
+	var activities []*LandActivity
+	err := dao.DB.WithContext(ctx).
+		Where("status = ? AND expected_end_time > ? AND expected_end_time <= ?", ActivityStatusGrowing, since, until).
+		Order("expected_end_time ASC").Limit(limit).Find(&activities).Error
+	return activities, err
+}
+
+// GetWitheredActivities 查询已过枯萎时间仍在生长中的活动, 早期没有枯萎时间的种植记录按成熟时间加defaultGrace判断;
+// 养殖活动没有枯萎时间, 不会枯萎
+func (dao *Dao) GetWitheredActivities(ctx context.Context, now time.Time, defaultGrace time.Duration, limit int) ([]*LandActivity, error) {
+	var activities []*LandActivity
+	err := dao.DB.WithContext(ctx).
+		Where("status = ?", ActivityStatusGrowing).
+		Where("wither_time <= ? OR (wither_time IS NULL AND activity_type = ? AND expected_end_time <= ?)", now, ActivityTypePlanting, now.Add(-defaultGrace)).
+		Order("expected_end_time ASC").Limit(limit).Find(&activities).Error
+	return activities, err
+}
+
+// WitherLandActivity 将生长中的活动标记为枯萎, 活动已不在生长中时不更新并返回false
+func (dao *Dao) WitherLandActivity(ctx context.Context, tx *gorm.DB, activityID uint64) (bool, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	now := time.Now()
+	result := tx.WithContext(ctx).Model(&LandActivity{}).
+		Where("id = ? AND status = ?", activityID, ActivityStatusGrowing).
+		Updates(map[string]interface{}{
+			"status":          ActivityStatusDead,
+			"actual_end_time": now,
+			"update_time":     now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ClearDeadActivities 清理土地上枯萎的活动以释放占用的面积, 返回清理的数量
+func (dao *Dao) ClearDeadActivities(ctx context.Context, landTokenID string) (int64, error) {
+	result := dao.DB.WithContext(ctx).Model(&LandActivity{}).
+		Where("land_token_id = ? AND status = ?", landTokenID, ActivityStatusDead).
+		Updates(map[string]interface{}{
+			"status":      ActivityStatusCleared,
+			"update_time": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const landDetailKeyPrefix = "land:detail:"
+
+// LandDetail 土地详情快照, 由土地及其分区、生长中的活动、生效的租赁、待出售的挂牌和最近一次完成的升级组成;
+// 肥力恢复、剩余生长时间等随时间变化的数据在读取时计算, 快照本身可以缓存
+type LandDetail struct {
+	Land        *LandInfo       `json:"land"`        // 土地信息
+	Layouts     []*LandLayout   `json:"layouts"`     // 分区, 按分区ID排序
+	Activities  []*LandActivity `json:"activities"`  // 生长中的种植/养殖活动
+	Rental      *LandRental     `json:"rental"`      // 生效的租赁, 没有时为空
+	Listing     *LandMarket     `json:"listing"`     // 待出售的挂牌, 没有时为空
+	LastUpgrade *LandUpgrade    `json:"lastUpgrade"` // 最近一次完成的升级, 没有时为空
+}
+
+func landDetailKey(tokenID string) string {
+	return landDetailKeyPrefix + tokenID
+}
+
+// GetCachedLandDetail 读取缓存的土地详情快照, 未缓存时返回nil
+func (dao *Dao) GetCachedLandDetail(ctx context.Context, tokenID string) (*LandDetail, error) {
+	value, err := dao.KvStore.GetCtx(ctx, landDetailKey(tokenID))
+	if err != nil || value == "" {
+		return nil, err
+	}
+	var detail LandDetail
+	if err := json.Unmarshal([]byte(value), &detail); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+// CacheLandDetail 缓存土地详情快照, seconds为过期时间(秒)
+func (dao *Dao) CacheLandDetail(ctx context.Context, detail *LandDetail, seconds int) error {
+	value, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	return dao.KvStore.SetexCtx(ctx, landDetailKey(detail.Land.LandTokenID), string(value), seconds)
+}
+
+// DeleteCachedLandDetails 删除土地详情缓存, 在修改土地相关数据的事务提交后调用
+func (dao *Dao) DeleteCachedLandDetails(ctx context.Context, tokenIDs ...string) error {
+	keys := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		keys = append(keys, landDetailKey(tokenID))
+	}
+	_, err := dao.KvStore.DelCtx(ctx, keys...)
+	return err
+}
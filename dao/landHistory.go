@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"MetaFarmBackend/component/pagination"
+)
+
+// 土地历史事件类型
+const (
+	HistoryKindMint     = "mint"     // 铸造
+	HistoryKindUpgrade  = "upgrade"  // 升级
+	HistoryKindListing  = "listing"  // 挂牌
+	HistoryKindSale     = "sale"     // 成交, 所有权转移
+	HistoryKindRental   = "rental"   // 租赁
+	HistoryKindActivity = "activity" // 种植/养殖
+)
+
+// historyKindShift 事件ID = 来源编号<<48 + 来源表主键, 保证合并后的时间线中ID唯一
+const historyKindShift = 1 << 48
+
+// LandHistoryEntry 土地历史时间线中的一条事件
+type LandHistoryEntry struct {
+	EventID      uint64    `gorm:"column:event_id" json:"-"`                          // 时间线内唯一ID, 用于分页
+	Kind         string    `gorm:"column:kind" json:"kind"`                           // 事件类型(mint/upgrade/listing/sale/rental/activity)
+	RefID        uint64    `gorm:"column:ref_id" json:"ref_id"`                       // 来源记录ID
+	EventTime    time.Time `gorm:"column:event_time" json:"event_time"`               // 事件时间
+	Actor        string    `gorm:"column:actor" json:"actor,omitempty"`               // 发起方地址: 升级/挂牌/种植为所有者, 成交为买家, 租赁为租客
+	Counterparty string    `gorm:"column:counterparty" json:"counterparty,omitempty"` // 对手方地址: 成交为卖家, 租赁为所有者
+	Amount       float64   `gorm:"column:amount" json:"amount,omitempty"`             // 金额: 升级消耗、挂牌/成交价格、租金
+	Status       int8      `gorm:"column:status" json:"status"`                       // 来源记录状态
+	OldLevel     int8      `gorm:"column:old_level" json:"old_level,omitempty"`       // 升级前等级
+	NewLevel     int8      `gorm:"column:new_level" json:"new_level,omitempty"`       // 升级后等级
+	Subject      string    `gorm:"column:subject" json:"subject,omitempty"`           // 作物/动物名称
+}
+
+// LandHistoryPageSpec 土地历史只按事件时间排序, 默认倒序
+var LandHistoryPageSpec = pagination.Spec{
+	Fields: map[string]string{
+		"time": "event_time",
+	},
+	DefaultField: "time",
+	DefaultDesc:  true,
+	IDColumn:     "event_id",
+}
+
+// pageKey 返回事件时间及唯一ID, 用于生成游标
+func (e *LandHistoryEntry) pageKey(string) (interface{}, uint64) {
+	return e.EventTime, e.EventID
+}
+
+// historySelect 各来源统一的列, 来源没有的列以常量补齐
+func historySelect(kindNo int, kind, timeCol, actor, counterparty, amount, status, oldLevel, newLevel, subject string) string {
+	return fmt.Sprintf("id + %d AS event_id, '%s' AS kind, id AS ref_id, %s AS event_time, %s AS actor, %s AS counterparty, %s AS amount, %s AS status, %s AS old_level, %s AS new_level, %s AS subject",
+		kindNo*historyKindShift, kind, timeCol, actor, counterparty, amount, status, oldLevel, newLevel, subject)
+}
+
+// GetLandHistory 分页获取土地的历史时间线: 铸造、升级、挂牌、成交、租赁及种植养殖记录按时间合并
+func (dao *Dao) GetLandHistory(ctx context.Context, landTokenID string, q *pagination.Query) ([]*LandHistoryEntry, *pagination.Result, error) {
+	db := dao.DB.WithContext(ctx)
+	sources := []interface{}{
+		db.Model(&LandInfo{}).
+			Select(historySelect(1, HistoryKindMint, "create_time", "''", "''", "0", "0", "0", "0", "''")).
+			Where("land_token_id = ?", landTokenID),
+		db.Model(&LandUpgrade{}).
+			Select(historySelect(2, HistoryKindUpgrade, "upgrade_time", "owner_address", "''", "cost_tokens", "status", "old_level", "new_level", "''")).
+			Where("land_token_id = ?", landTokenID),
+		db.Model(&LandMarket{}).
+			Select(historySelect(3, HistoryKindListing, "listing_time", "seller_address", "''", "price", "status", "0", "0", "''")).
+			Where("land_token_id = ?", landTokenID),
+		db.Model(&LandMarket{}).
+			Select(historySelect(4, HistoryKindSale, "transaction_time", "buyer_address", "seller_address", "price", "status", "0", "0", "''")).
+			Where("land_token_id = ? AND status = ? AND transaction_time IS NOT NULL", landTokenID, MarketStatusSold),
+		db.Model(&LandRental{}).
+			Select(historySelect(5, HistoryKindRental, "rental_start_time", "renter_address", "owner_address", "total_rent", "status", "0", "0", "''")).
+			Where("land_token_id = ?", landTokenID),
+		db.Model(&LandActivity{}).
+			Select(historySelect(6, HistoryKindActivity, "start_time", "owner_address", "''", "0", "status", "0", "0", "crop_animal_name")).
+			Where("land_token_id = ?", landTokenID),
+	}
+
+	var entries []*LandHistoryEntry
+	history := db.Table("(? UNION ALL ? UNION ALL ? UNION ALL ? UNION ALL ? UNION ALL ?) AS history", sources...)
+	if err := q.Apply(history).Find(&entries).Error; err != nil {
+		return nil, nil, err
+	}
+	return pagination.Paginate(q, entries, (*LandHistoryEntry).pageKey)
+}
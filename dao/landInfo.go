@@ -2,26 +2,39 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"MetaFarmBackend/component/pagination"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // LandInfo 土地信息表结构体
 type LandInfo struct {
-	ID              uint64     `gorm:"primaryKey;column:id"`                        // 主键ID
-	LandTokenID     string     `gorm:"column:land_token_id;uniqueIndex"`            // 土地NFT TokenID
-	OwnerAddress    string     `gorm:"column:owner_address;type:varchar(42);index"` // 所有者钱包地址
-	LandType        int8       `gorm:"column:land_type"`                            // 地形类型(0-平原,1-湿地,2-山地)
-	Rarity          int8       `gorm:"column:rarity;default:0;index"`               // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
-	Area            int        `gorm:"column:area;default:100"`                     // 土地面积(㎡)
-	Level           int8       `gorm:"column:level;default:1;index"`                // 土地等级(1-10级)
-	Fertility       int        `gorm:"column:fertility;default:100;index"`          // 土地肥力值(0-100)
-	SpecialEffect   string     `gorm:"column:special_effect;type:varchar(100)"`     // 特殊效果(如"湿润土地"、"黄金土地")
-	LastHarvestTime *time.Time `gorm:"column:last_harvest_time"`                    // 最后收获时间
-	MetadataURI     string     `gorm:"column:metadata_uri;type:varchar(255)"`       // 元数据URI
-	CreateTime      time.Time  `gorm:"column:create_time"`                          // 创建时间
-	UpdateTime      time.Time  `gorm:"column:update_time"`                          // 更新时间
+	ID                  uint64     `gorm:"primaryKey;column:id"`                                                 // 主键ID
+	LandTokenID         string     `gorm:"column:land_token_id;uniqueIndex"`                                     // 土地NFT TokenID
+	OwnerAddress        string     `gorm:"column:owner_address;type:varchar(42);index"`                          // 所有者钱包地址
+	LandType            int8       `gorm:"column:land_type;index:idx_type_rarity_level,priority:1"`              // 地形类型(0-平原,1-湿地,2-山地)
+	Rarity              int8       `gorm:"column:rarity;default:0;index;index:idx_type_rarity_level,priority:2"` // 稀有度(0-普通,1-稀有,2-史诗,3-传说)
+	Area                int        `gorm:"column:area;default:100"`                                              // 土地面积(㎡)
+	Level               int8       `gorm:"column:level;default:1;index;index:idx_type_rarity_level,priority:3"`  // 土地等级(1-10级)
+	Fertility           int        `gorm:"column:fertility;default:100;index"`                                   // 土地肥力值(0-肥力上限), 为上次结算时的值
+	FertilityUpdateTime *time.Time `gorm:"column:fertility_update_time" extensions:"x-nullable"`                 // 肥力结算时间, 之后的恢复在读取时按时间计算, 为空时以更新时间为准
+	FertilityCap        int        `gorm:"column:fertility_cap;default:100"`                                     // 肥力上限, 随等级提升
+	YieldMultiplier     float64    `gorm:"column:yield_multiplier;type:decimal(5,2);default:1.00"`               // 产量倍率, 随等级提升
+	UnlockedZones       string     `gorm:"column:unlocked_zones;type:varchar(32);default:'0'"`                   // 已解锁的分区类型(逗号分隔, 0-种植区,1-养殖区,2-装饰区)
+	UpgradeCompleteTime *time.Time `gorm:"column:upgrade_complete_time" extensions:"x-nullable"`                 // 升级施工完成时间, 为空表示未在施工
+	SpecialEffect       string     `gorm:"column:special_effect;type:varchar(100);index"`                        // 特殊效果(如"湿润土地"、"黄金土地")
+	LastHarvestTime     *time.Time `gorm:"column:last_harvest_time" extensions:"x-nullable"`                     // 最后收获时间
+	MetadataURI         string     `gorm:"column:metadata_uri;type:varchar(255)"`                                // 元数据URI
+	CreateTime          time.Time  `gorm:"column:create_time"`                                                   // 创建时间
+	UpdateTime          time.Time  `gorm:"column:update_time"`                                                   // 更新时间
 }
 
 func (LandInfo) TableName() string {
@@ -31,16 +44,68 @@ func (LandInfo) TableName() string {
 func NewLandInfo(landTokenID string, ownerAddress string, landType int8, rarity int8) *LandInfo {
 	now := time.Now()
 	return &LandInfo{
-		LandTokenID:   landTokenID,
-		OwnerAddress:  ownerAddress,
-		LandType:      landType,
-		Rarity:        rarity,
-		Area:          100,
-		Level:         1,
-		Fertility:     100,
-		SpecialEffect: "",
-		CreateTime:    now,
-		UpdateTime:    now,
+		LandTokenID:         landTokenID,
+		OwnerAddress:        ownerAddress,
+		LandType:            landType,
+		Rarity:              rarity,
+		Area:                100,
+		Level:               1,
+		Fertility:           100,
+		FertilityUpdateTime: &now,
+		FertilityCap:        100,
+		YieldMultiplier:     1,
+		UnlockedZones:       "0",
+		SpecialEffect:       "",
+		CreateTime:          now,
+		UpdateTime:          now,
+	}
+}
+
+// UnderConstruction 土地是否正在升级施工
+func (l *LandInfo) UnderConstruction() bool {
+	return l.UpgradeCompleteTime != nil
+}
+
+// Zones 已解锁的分区类型
+func (l *LandInfo) Zones() []int8 {
+	return ParseInt8List(l.UnlockedZones)
+}
+
+// GridSize 土地网格的边长, 土地视为边长为⌊√面积⌋的正方形, 分区须位于网格内
+func (l *LandInfo) GridSize() int {
+	size := int(math.Sqrt(float64(l.Area)))
+	for (size+1)*(size+1) <= l.Area {
+		size++
+	}
+	for size > 0 && size*size > l.Area {
+		size--
+	}
+	return size
+}
+
+// ZoneUnlocked 分区类型是否已解锁
+func (l *LandInfo) ZoneUnlocked(zoneType int8) bool {
+	for _, z := range l.Zones() {
+		if z == zoneType {
+			return true
+		}
+	}
+	return false
+}
+
+// UnlockZones 追加解锁分区类型, zoneTypes为逗号分隔的分区类型
+func (l *LandInfo) UnlockZones(zoneTypes string) {
+	for _, z := range strings.Split(zoneTypes, ",") {
+		z = strings.TrimSpace(z)
+		zoneType, err := strconv.Atoi(z)
+		if err != nil || l.ZoneUnlocked(int8(zoneType)) {
+			continue
+		}
+		if l.UnlockedZones == "" {
+			l.UnlockedZones = z
+		} else {
+			l.UnlockedZones += "," + z
+		}
 	}
 }
 
@@ -50,10 +115,131 @@ func (dao *Dao) GetLandInfoByTokenID(ctx context.Context, tokenID string) (*Land
 	return &land, err
 }
 
-func (dao *Dao) GetLandsByOwner(ctx context.Context, ownerAddress string) ([]*LandInfo, error) {
+// FertilityRegen 肥力恢复参数, 与服务层结算肥力的规则一致, 用于在查询中计算土地当前肥力
+type FertilityRegen struct {
+	PerHour       float64            // 普通土地每小时恢复的肥力
+	RarityBonus   float64            // 每级稀有度增加的恢复比例
+	RarityCap     int                // 每级稀有度增加的肥力上限
+	SpecialEffect map[string]float64 // 特殊效果对恢复速度的倍率
+}
+
+// Expr 土地当前肥力的SQL表达式: 上次结算的肥力加上结算后按时间恢复的整数量, 不超过肥力上限, 已达到上限时保持不变;
+// 参数由配置给定并直接写入表达式, 以便同一表达式用于筛选、排序及游标条件
+func (r FertilityRegen) Expr() string {
+	fertilityCap := fmt.Sprintf("(land_info.fertility_cap + land_info.rarity * %d)", r.RarityCap)
+	effects := make([]string, 0, len(r.SpecialEffect))
+	for effect := range r.SpecialEffect {
+		effects = append(effects, effect)
+	}
+	sort.Strings(effects)
+	multiplier := "1"
+	if len(effects) > 0 {
+		var b strings.Builder
+		b.WriteString("CASE land_info.special_effect")
+		for _, effect := range effects {
+			fmt.Fprintf(&b, " WHEN %s THEN %s", quoteSQL(effect), formatFloat(r.SpecialEffect[effect]))
+		}
+		b.WriteString(" ELSE 1 END")
+		multiplier = b.String()
+	}
+	layoutBonus := fmt.Sprintf("COALESCE((SELECT SUM(land_layout.bonus_value) FROM land_layout WHERE land_layout.land_token_id = land_info.land_token_id AND land_layout.has_adjacent_bonus = 1 AND land_layout.bonus_type = %d), 0)", BonusTypeFertility)
+	rate := fmt.Sprintf("%s * (1 + land_info.rarity * %s) * (1 + %s / 100) * (%s)", formatFloat(r.PerHour), formatFloat(r.RarityBonus), layoutBonus, multiplier)
+	elapsed := "GREATEST(TIMESTAMPDIFF(MICROSECOND, COALESCE(land_info.fertility_update_time, land_info.update_time), NOW(6)), 0)"
+	return fmt.Sprintf("(CASE WHEN land_info.fertility >= %[1]s THEN land_info.fertility ELSE LEAST(%[1]s, land_info.fertility + FLOOR(%[2]s * %[3]s / 3600000000)) END)",
+		fertilityCap, elapsed, rate)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// quoteSQL 将字符串转为SQL字符串字面量
+func quoteSQL(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(s) + "'"
+}
+
+// LandInfoPageSpec 土地列表可排序字段, 按肥力排序使用当前肥力
+func LandInfoPageSpec(regen FertilityRegen) pagination.Spec {
+	return pagination.Spec{
+		Fields: map[string]string{
+			"id":         "id",
+			"level":      "level",
+			"rarity":     "rarity",
+			"fertility":  regen.Expr(),
+			"area":       "area",
+			"createTime": "create_time",
+		},
+		DefaultField: "id",
+	}
+}
+
+// LandInfoFilter 土地列表筛选条件
+type LandInfoFilter struct {
+	Regen    FertilityRegen // 肥力恢复参数, 用于计算返回的当前肥力
+	LandType *int8          // 地形类型
+	Rarity   *int8          // 稀有度
+	MinLevel *int8          // 最低等级
+	MaxLevel *int8          // 最高等级
+}
+
+func (f LandInfoFilter) filters() []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Eq("land_type", f.LandType),
+		pagination.Eq("rarity", f.Rarity),
+		pagination.Range("level", f.MinLevel, f.MaxLevel),
+	}
+}
+
+// landWithFertility 附带当前肥力的土地查询结果
+type landWithFertility struct {
+	LandInfo
+	CurrentFertility int `gorm:"column:current_fertility"`
+}
+
+// pageKey 返回土地在排序字段上的值, 用于生成游标
+func (l *LandInfo) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "level":
+		return l.Level, l.ID
+	case "rarity":
+		return l.Rarity, l.ID
+	case "fertility":
+		return l.Fertility, l.ID
+	case "area":
+		return l.Area, l.ID
+	case "createTime":
+		return l.CreateTime, l.ID
+	}
+	return l.ID, l.ID
+}
+
+// GetLandInfosByTokenIDs 批量获取土地信息, 按TokenID索引
+func (dao *Dao) GetLandInfosByTokenIDs(ctx context.Context, tokenIDs []string) (map[string]*LandInfo, error) {
 	var lands []*LandInfo
-	err := dao.DB.WithContext(ctx).Where("owner_address = ?", ownerAddress).Find(&lands).Error
-	return lands, err
+	if err := dao.DB.WithContext(ctx).Where("land_token_id IN ?", tokenIDs).Find(&lands).Error; err != nil {
+		return nil, err
+	}
+	byTokenID := make(map[string]*LandInfo, len(lands))
+	for _, land := range lands {
+		byTokenID[land.LandTokenID] = land
+	}
+	return byTokenID, nil
+}
+
+// GetLandsByOwner 分页获取用户拥有的土地, 返回的肥力为查询时的当前肥力
+func (dao *Dao) GetLandsByOwner(ctx context.Context, ownerAddress string, filter LandInfoFilter, q *pagination.Query) ([]*LandInfo, *pagination.Result, error) {
+	var rows []*landWithFertility
+	db := dao.DB.WithContext(ctx).Model(&LandInfo{}).Select("land_info.*, " + filter.Regen.Expr() + " AS current_fertility")
+	db = pagination.Where(db.Where("owner_address = ?", ownerAddress), filter.filters()...)
+	if err := q.Apply(db).Find(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+	lands := make([]*LandInfo, 0, len(rows))
+	for _, row := range rows {
+		row.Fertility = row.CurrentFertility
+		lands = append(lands, &row.LandInfo)
+	}
+	return pagination.Paginate(q, lands, (*LandInfo).pageKey)
 }
 
 func (dao *Dao) CreateLandInfo(ctx context.Context, tx *gorm.DB, land *LandInfo) error {
@@ -78,11 +264,80 @@ func (dao *Dao) UpdateLevel(ctx context.Context, tx *gorm.DB, tokenID string, le
 	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id = ?", tokenID).Update("level", level).Error
 }
 
-func (dao *Dao) UpdateFertility(ctx context.Context, tx *gorm.DB, tokenID string, fertility int) error {
+// FertilitySettledAt 肥力结算时间
+func (l *LandInfo) FertilitySettledAt() time.Time {
+	if l.FertilityUpdateTime != nil {
+		return *l.FertilityUpdateTime
+	}
+	return l.UpdateTime
+}
+
+// UpdateFertility 写入结算后的肥力值及结算时间
+func (dao *Dao) UpdateFertility(ctx context.Context, tx *gorm.DB, tokenID string, fertility int, settledAt time.Time) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id = ?", tokenID).UpdateColumns(map[string]interface{}{
+		"fertility":             fertility,
+		"fertility_update_time": settledAt,
+		"update_time":           time.Now(),
+	}).Error
+}
+
+// UpdateFertilities 用一条UPDATE批量写入多块土地结算后的肥力及结算时间
+func (dao *Dao) UpdateFertilities(ctx context.Context, tx *gorm.DB, lands []*LandInfo) error {
 	if tx == nil {
 		tx = dao.DB
 	}
-	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id = ?", tokenID).Update("fertility", fertility).Error
+	if len(lands) == 0 {
+		return nil
+	}
+	tokenIDs := make([]string, 0, len(lands))
+	fertility := make([]interface{}, 0, 2*len(lands))
+	settledAt := make([]interface{}, 0, 2*len(lands))
+	for _, land := range lands {
+		tokenIDs = append(tokenIDs, land.LandTokenID)
+		fertility = append(fertility, land.LandTokenID, land.Fertility)
+		settledAt = append(settledAt, land.LandTokenID, land.FertilityUpdateTime)
+	}
+	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id IN ?", tokenIDs).UpdateColumns(map[string]interface{}{
+		"fertility":             caseExpr("land_token_id", fertility...),
+		"fertility_update_time": caseExpr("land_token_id", settledAt...),
+		"update_time":           time.Now(),
+	}).Error
+}
+
+// UpdateLastHarvestTime 批量更新土地最后收获时间
+func (dao *Dao) UpdateLastHarvestTime(ctx context.Context, tx *gorm.DB, tokenIDs []string, harvestTime time.Time) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id IN ?", tokenIDs).UpdateColumns(map[string]interface{}{
+		"last_harvest_time": harvestTime,
+		"update_time":       time.Now(),
+	}).Error
+}
+
+// LockLandInfo 在事务中锁定土地行
+func (dao *Dao) LockLandInfo(ctx context.Context, tx *gorm.DB, tokenID string) (*LandInfo, error) {
+	var land LandInfo
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("land_token_id = ?", tokenID).First(&land).Error
+	return &land, err
+}
+
+// LockLandInfos 在事务中按TokenID顺序锁定多块土地, 按TokenID索引
+func (dao *Dao) LockLandInfos(ctx context.Context, tx *gorm.DB, tokenIDs []string) (map[string]*LandInfo, error) {
+	var lands []*LandInfo
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("land_token_id IN ?", tokenIDs).Order("land_token_id").Find(&lands).Error
+	if err != nil {
+		return nil, err
+	}
+	byTokenID := make(map[string]*LandInfo, len(lands))
+	for _, land := range lands {
+		byTokenID[land.LandTokenID] = land
+	}
+	return byTokenID, nil
 }
 
 // UpdateLandOwner 更新土地所有者
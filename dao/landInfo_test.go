@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestGridSize(t *testing.T) {
+	tests := []struct {
+		area int
+		want int
+	}{
+		{area: 0, want: 0},
+		{area: 1, want: 1},
+		{area: 3, want: 1},
+		{area: 99, want: 9},
+		{area: 100, want: 10},
+		{area: 101, want: 10},
+		{area: 1<<31 - 1, want: 46340},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.area), func(t *testing.T) {
+			land := &LandInfo{Area: tt.area}
+			if got := land.GridSize(); got != tt.want {
+				t.Fatalf("GridSize(%d) = %d, want %d", tt.area, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnlockZones(t *testing.T) {
+	tests := []struct {
+		name     string
+		unlocked string
+		add      string
+		want     []int8
+	}{
+		{name: "追加", unlocked: "0", add: "1", want: []int8{0, 1}},
+		{name: "忽略已解锁及非法值", unlocked: "0,1", add: "1, x ,2", want: []int8{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			land := &LandInfo{UnlockedZones: tt.unlocked}
+			land.UnlockZones(tt.add)
+			if got := land.Zones(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("zones = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
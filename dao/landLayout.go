@@ -5,6 +5,21 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 分区类型
+const (
+	ZoneTypePlanting   int8 = iota // 0: 种植区
+	ZoneTypeBreeding               // 1: 养殖区
+	ZoneTypeDecoration             // 2: 装饰区
+)
+
+// 分区加成类型
+const (
+	BonusTypeNone      int8 = -1 // 无加成
+	BonusTypeFertility int8 = 0  // 肥力恢复
+	BonusTypeYield     int8 = 1  // 产量提升
 )
 
 // LandLayout 土地分区信息表结构体
@@ -39,25 +54,108 @@ func NewLandLayout(landTokenID string, area int, zoneType int8, posX, posY, widt
 		Width:            width,
 		Height:           height,
 		HasAdjacentBonus: false,
-		BonusType:        -1,
+		BonusType:        BonusTypeNone,
 		BonusValue:       0,
 		CreateTime:       now,
 		UpdateTime:       now,
 	}
 }
 
-func (dao *Dao) GetLayoutByTokenID(ctx context.Context, tokenID string) (*LandLayout, error) {
+// GetLandLayoutByID 获取土地上的指定分区
+func (dao *Dao) GetLandLayoutByID(ctx context.Context, tokenID string, zoneID uint64) (*LandLayout, error) {
+	var layout LandLayout
+	err := dao.DB.WithContext(ctx).Where("land_token_id = ? AND id = ?", tokenID, zoneID).First(&layout).Error
+	return &layout, err
+}
+
+// LockLandLayout 在事务中锁定土地上的指定分区, 用于种植前校验剩余面积
+func (dao *Dao) LockLandLayout(ctx context.Context, tx *gorm.DB, tokenID string, zoneID uint64) (*LandLayout, error) {
 	var layout LandLayout
-	err := dao.DB.WithContext(ctx).Where("land_token_id = ?", tokenID).First(&layout).Error
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("land_token_id = ? AND id = ?", tokenID, zoneID).First(&layout).Error
 	return &layout, err
 }
 
+// ZoneOccupancy 分区面积占用情况
+type ZoneOccupancy struct {
+	ZoneID       uint64 `json:"zone_id"`       // 分区ID
+	ZoneType     int8   `json:"zone_type"`     // 分区类型(0-种植区,1-养殖区,2-装饰区)
+	Area         int    `json:"area"`          // 分区面积(㎡)
+	OccupiedArea int    `json:"occupied_area"` // 生长中及枯萎未清理的活动占用的面积(㎡)
+	FreeArea     int    `json:"free_area"`     // 剩余可用面积(㎡)
+}
+
+// GetZoneOccupiedArea 获取一块或多块土地各分区被生长中及枯萎未清理的活动占用的面积, 没有占用的分区不在结果中
+func (dao *Dao) GetZoneOccupiedArea(ctx context.Context, tx *gorm.DB, tokenIDs ...string) (map[uint64]int, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	var rows []struct {
+		ZoneID uint64
+		Area   int
+	}
+	err := tx.WithContext(ctx).Model(&LandActivity{}).
+		Select("zone_id, SUM(area) AS area").
+		Where("land_token_id IN ? AND status IN ?", tokenIDs, []int8{ActivityStatusGrowing, ActivityStatusDead}).
+		Group("zone_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	occupied := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		occupied[row.ZoneID] = row.Area
+	}
+	return occupied, nil
+}
+
+// GetLayoutsByTokenID 获取土地的全部分区, 按分区ID排序
 func (dao *Dao) GetLayoutsByTokenID(ctx context.Context, tokenID string) ([]*LandLayout, error) {
 	var layouts []*LandLayout
-	err := dao.DB.WithContext(ctx).Where("land_token_id = ?", tokenID).Find(&layouts).Error
+	err := dao.DB.WithContext(ctx).Where("land_token_id = ?", tokenID).Order("id").Find(&layouts).Error
+	return layouts, err
+}
+
+// LockLayoutsByTokenID 在事务中锁定土地的全部分区, 用于整体替换布局
+func (dao *Dao) LockLayoutsByTokenID(ctx context.Context, tx *gorm.DB, tokenID string) ([]*LandLayout, error) {
+	var layouts []*LandLayout
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("land_token_id = ?", tokenID).Order("id").Find(&layouts).Error
+	return layouts, err
+}
+
+// LockLayoutsByTokenIDs 在事务中锁定多块土地的全部分区, 按分区ID排序
+func (dao *Dao) LockLayoutsByTokenIDs(ctx context.Context, tx *gorm.DB, tokenIDs []string) ([]*LandLayout, error) {
+	var layouts []*LandLayout
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("land_token_id IN ?", tokenIDs).Order("id").Find(&layouts).Error
 	return layouts, err
 }
 
+// DeleteLandLayouts 删除土地上的指定分区
+func (dao *Dao) DeleteLandLayouts(ctx context.Context, tx *gorm.DB, tokenID string, zoneIDs []uint64) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Where("land_token_id = ? AND id IN ?", tokenID, zoneIDs).Delete(&LandLayout{}).Error
+}
+
+// GetLayoutBonuses 批量获取土地已激活的指定类型布局加成(百分比之和), 没有加成的土地不在结果中
+func (dao *Dao) GetLayoutBonuses(ctx context.Context, tokenIDs []string, bonusType int8) (map[string]float64, error) {
+	var rows []struct {
+		LandTokenID string
+		Bonus       float64
+	}
+	err := dao.DB.WithContext(ctx).Model(&LandLayout{}).
+		Select("land_token_id, SUM(bonus_value) AS bonus").
+		Where("land_token_id IN ? AND has_adjacent_bonus = ? AND bonus_type = ?", tokenIDs, true, bonusType).
+		Group("land_token_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	bonuses := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		bonuses[row.LandTokenID] = row.Bonus
+	}
+	return bonuses, nil
+}
+
 func (dao *Dao) CreateLandLayout(ctx context.Context, tx *gorm.DB, layout *LandLayout) error {
 	if tx == nil {
 		tx = dao.DB
@@ -72,6 +170,7 @@ func (dao *Dao) UpdateLandLayout(ctx context.Context, tx *gorm.DB, layout *LandL
 	return tx.WithContext(ctx).Save(layout).Error
 }
 
+// UpdateBonus 写入分区的相邻加成
 func (dao *Dao) UpdateBonus(ctx context.Context, tx *gorm.DB, tokenID string, zoneID uint64, hasBonus bool, bonusType int8, bonusValue float64) error {
 	if tx == nil {
 		tx = dao.DB
@@ -84,14 +183,18 @@ func (dao *Dao) UpdateBonus(ctx context.Context, tx *gorm.DB, tokenID string, zo
 	}).Error
 }
 
-func (dao *Dao) UpdateLandLayoutBonus(ctx context.Context, tx *gorm.DB, tokenID uint64, zoneID uint64, hasBonus bool, bonusType int8, bonusValue float64) error {
-	if tx == nil {
-		tx = dao.DB
+// GetZoneBonuses 批量获取土地各分区已激活的指定类型加成, 按分区ID索引, 没有加成的分区不在结果中
+func (dao *Dao) GetZoneBonuses(ctx context.Context, tokenIDs []string, bonusType int8) (map[uint64]float64, error) {
+	var layouts []*LandLayout
+	err := dao.DB.WithContext(ctx).Select("id, bonus_value").
+		Where("land_token_id IN ? AND has_adjacent_bonus = ? AND bonus_type = ?", tokenIDs, true, bonusType).
+		Find(&layouts).Error
+	if err != nil {
+		return nil, err
 	}
-	return tx.WithContext(ctx).Model(&LandLayout{}).Where("land_token_id = ? AND id = ?", tokenID, zoneID).UpdateColumns(map[string]interface{}{
-		"has_adjacent_bonus": hasBonus,
-		"bonus_type":         bonusType,
-		"bonus_value":        bonusValue,
-		"update_time":        time.Now(),
-	}).Error
+	bonuses := make(map[uint64]float64, len(layouts))
+	for _, layout := range layouts {
+		bonuses[layout.ID] = layout.BonusValue
+	}
+	return bonuses, nil
 }
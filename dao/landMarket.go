@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"MetaFarmBackend/component/pagination"
+
 	"gorm.io/gorm"
 )
 
@@ -16,17 +18,17 @@ const (
 
 // LandMarket 土地交易市场表结构体
 type LandMarket struct {
-	ID              uint64     `gorm:"primaryKey;column:id"`                         // 主键ID
-	LandTokenID     string     `gorm:"column:land_token_id;uniqueIndex"`             // 土地NFT TokenID
-	SellerAddress   string     `gorm:"column:seller_address;type:varchar(42);index"` // 卖家钱包地址
-	BuyerAddress    string     `gorm:"column:buyer_address;type:varchar(42);index"`  // 买家钱包地址
-	Area            int        `gorm:"column:area"`                                  // 土地面积(㎡)
-	Price           float64    `gorm:"column:price;type:decimal(18,6)"`              // 售价
-	Status          int8       `gorm:"column:status;default:0;index"`                // 状态(0-待出售,1-已售出,2-已取消)
-	ListingTime     time.Time  `gorm:"column:listing_time"`                          // 挂牌时间
-	TransactionTime *time.Time `gorm:"column:transaction_time"`                      // 交易完成时间
-	CreateTime      time.Time  `gorm:"column:create_time"`                           // 创建时间
-	UpdateTime      time.Time  `gorm:"column:update_time"`                           // 更新时间
+	ID              uint64     `gorm:"primaryKey;column:id"`                                              // 主键ID
+	LandTokenID     string     `gorm:"column:land_token_id;uniqueIndex"`                                  // 土地NFT TokenID
+	SellerAddress   string     `gorm:"column:seller_address;type:varchar(42);index"`                      // 卖家钱包地址
+	BuyerAddress    string     `gorm:"column:buyer_address;type:varchar(42);index"`                       // 买家钱包地址
+	Area            int        `gorm:"column:area"`                                                       // 土地面积(㎡)
+	Price           float64    `gorm:"column:price;type:decimal(18,6);index:idx_status_price,priority:2"` // 售价
+	Status          int8       `gorm:"column:status;default:0;index;index:idx_status_price,priority:1"`   // 状态(0-待出售,1-已售出,2-已取消)
+	ListingTime     time.Time  `gorm:"column:listing_time"`                                               // 挂牌时间
+	TransactionTime *time.Time `gorm:"column:transaction_time"`                                           // 交易完成时间
+	CreateTime      time.Time  `gorm:"column:create_time"`                                                // 创建时间
+	UpdateTime      time.Time  `gorm:"column:update_time"`                                                // 更新时间
 }
 
 func (LandMarket) TableName() string {
@@ -47,10 +49,53 @@ func NewLandMarket(landTokenID string, sellerAddress string, area int, price flo
 	}
 }
 
-func (dao *Dao) GetActiveLandListings(ctx context.Context) ([]*LandMarket, error) {
+// LandMarketPageSpec 土地挂牌列表可排序字段, 默认按价格升序
+var LandMarketPageSpec = pagination.Spec{
+	Fields: map[string]string{
+		"id":          "id",
+		"price":       "price",
+		"area":        "area",
+		"listingTime": "listing_time",
+	},
+	DefaultField: "price",
+}
+
+// LandMarketFilter 土地挂牌筛选条件
+type LandMarketFilter struct {
+	MinPrice *float64 // 最低售价
+	MaxPrice *float64 // 最高售价
+	MinArea  *int     // 最小面积
+	MaxArea  *int     // 最大面积
+}
+
+func (f LandMarketFilter) filters() []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Range("price", f.MinPrice, f.MaxPrice),
+		pagination.Range("area", f.MinArea, f.MaxArea),
+	}
+}
+
+// pageKey 返回挂牌在排序字段上的值, 用于生成游标
+func (m *LandMarket) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "price":
+		return m.Price, m.ID
+	case "area":
+		return m.Area, m.ID
+	case "listingTime":
+		return m.ListingTime, m.ID
+	}
+	return m.ID, m.ID
+}
+
+// GetActiveLandListings 分页获取待出售的土地挂牌
+func (dao *Dao) GetActiveLandListings(ctx context.Context, filter LandMarketFilter, q *pagination.Query) ([]*LandMarket, *pagination.Result, error) {
 	var listings []*LandMarket
-	err := dao.DB.WithContext(ctx).Where("status = 0").Order("price ASC").Find(&listings).Error
-	return listings, err
+	db := pagination.Where(dao.DB.WithContext(ctx).Where("status = ?", MarketStatusPending), filter.filters()...)
+	if err := q.Apply(db).Find(&listings).Error; err != nil {
+		return nil, nil, err
+	}
+	return pagination.Paginate(q, listings, (*LandMarket).pageKey)
 }
 
 func (dao *Dao) UpdateMarketStatusByID(ctx context.Context, tx *gorm.DB, id uint64, status int8, buyerAddress string) error {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"MetaFarmBackend/component/pagination"
+
 	"gorm.io/gorm"
 )
 
@@ -17,19 +19,19 @@ const (
 
 // LandRental 土地租赁表结构体
 type LandRental struct {
-	ID               uint64    `gorm:"primaryKey;column:id"`                           // 主键ID
-	LandTokenID      string    `gorm:"column:land_token_id;index"`                     // 土地NFT TokenID
-	OwnerAddress     string    `gorm:"column:owner_address;type:varchar(42);index"`    // 所有者钱包地址
-	RenterAddress    string    `gorm:"column:renter_address;type:varchar(42);index"`   // 租客钱包地址
-	RentalDuration   int       `gorm:"column:rental_duration"`                         // 租期(天，7/14/30)
-	RentPerSqmPerDay float64   `gorm:"column:rent_per_sqm_per_day;type:decimal(10,6)"` // 每平方米日租金
-	TotalRent        float64   `gorm:"column:total_rent;type:decimal(18,6)"`           // 总租金
-	SystemFee        float64   `gorm:"column:system_fee;type:decimal(18,6)"`           // 系统手续费(5%)
-	Status           int8      `gorm:"column:status;default:0;index"`                  // 状态(0-待确认,1-租赁中,2-已结束,3-已取消)
-	RentalStartTime  time.Time `gorm:"column:rental_start_time"`                       // 租赁开始时间
-	RentalEndTime    time.Time `gorm:"column:rental_end_time"`                         // 租赁结束时间
-	CreateTime       time.Time `gorm:"column:create_time"`                             // 创建时间
-	UpdateTime       time.Time `gorm:"column:update_time"`                             // 更新时间
+	ID               uint64    `gorm:"primaryKey;column:id"`                                            // 主键ID
+	LandTokenID      string    `gorm:"column:land_token_id;index:idx_token_status,priority:1"`          // 土地NFT TokenID
+	OwnerAddress     string    `gorm:"column:owner_address;type:varchar(42);index"`                     // 所有者钱包地址
+	RenterAddress    string    `gorm:"column:renter_address;type:varchar(42);index"`                    // 租客钱包地址
+	RentalDuration   int       `gorm:"column:rental_duration"`                                          // 租期(天，7/14/30)
+	RentPerSqmPerDay float64   `gorm:"column:rent_per_sqm_per_day;type:decimal(10,6)"`                  // 每平方米日租金
+	TotalRent        float64   `gorm:"column:total_rent;type:decimal(18,6)"`                            // 总租金
+	SystemFee        float64   `gorm:"column:system_fee;type:decimal(18,6)"`                            // 系统手续费(5%)
+	Status           int8      `gorm:"column:status;default:0;index;index:idx_token_status,priority:2"` // 状态(0-待确认,1-租赁中,2-已结束,3-已取消)
+	RentalStartTime  time.Time `gorm:"column:rental_start_time"`                                        // 租赁开始时间
+	RentalEndTime    time.Time `gorm:"column:rental_end_time"`                                          // 租赁结束时间
+	CreateTime       time.Time `gorm:"column:create_time"`                                              // 创建时间
+	UpdateTime       time.Time `gorm:"column:update_time"`                                              // 更新时间
 }
 
 func (LandRental) TableName() string {
@@ -86,9 +88,58 @@ func (dao *Dao) UpdateLandRentalStatus(ctx context.Context, tx *gorm.DB, rentalI
 	}).Error
 }
 
-// 查询用户作为租客的活跃租赁订单
-func (dao *Dao) GetLandRentalByRenter(ctx context.Context, renterAddress string) ([]*LandRental, error) {
+// LandRentalPageSpec 租赁订单列表可排序字段, 默认按到期时间升序
+var LandRentalPageSpec = pagination.Spec{
+	Fields: map[string]string{
+		"id":              "id",
+		"rentalEndTime":   "rental_end_time",
+		"rentalStartTime": "rental_start_time",
+		"totalRent":       "total_rent",
+	},
+	DefaultField: "rentalEndTime",
+}
+
+// LandRentalFilter 租赁订单筛选条件
+type LandRentalFilter struct {
+	LandTokenID    *string // 土地NFT TokenID
+	RentalDuration *int    // 租期(天)
+}
+
+func (f LandRentalFilter) filters() []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Eq("land_token_id", f.LandTokenID),
+		pagination.Eq("rental_duration", f.RentalDuration),
+	}
+}
+
+// pageKey 返回租赁订单在排序字段上的值, 用于生成游标
+func (r *LandRental) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "rentalEndTime":
+		return r.RentalEndTime, r.ID
+	case "rentalStartTime":
+		return r.RentalStartTime, r.ID
+	case "totalRent":
+		return r.TotalRent, r.ID
+	}
+	return r.ID, r.ID
+}
+
+// 分页查询用户作为租客的活跃租赁订单
+func (dao *Dao) GetLandRentalByRenter(ctx context.Context, renterAddress string, filter LandRentalFilter, q *pagination.Query) ([]*LandRental, *pagination.Result, error) {
+	var rentals []*LandRental
+	db := pagination.Where(dao.DB.WithContext(ctx).Where("renter_address = ? AND status = ?", renterAddress, RentalStatusActive), filter.filters()...)
+	if err := q.Apply(db).Find(&rentals).Error; err != nil {
+		return nil, nil, err
+	}
+	return pagination.Paginate(q, rentals, (*LandRental).pageKey)
+}
+
+// GetRentalsEndingBetween 查询在[from, to]内到期的租赁中订单, 用于推送即将到期事件
+func (dao *Dao) GetRentalsEndingBetween(ctx context.Context, from, to time.Time, limit int) ([]*LandRental, error) {
 	var rentals []*LandRental
-	err := dao.DB.WithContext(ctx).Where("renter_address = ? AND status = 1", renterAddress).Order("rental_end_time ASC").Find(&rentals).Error
+	err := dao.DB.WithContext(ctx).
+		Where("status = ? AND rental_end_time >= ? AND rental_end_time <= ?", RentalStatusActive, from, to).
+		Order("rental_end_time ASC").Limit(limit).Find(&rentals).Error
 	return rentals, err
 }
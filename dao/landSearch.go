@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/component/pagination"
+
+	"gorm.io/gorm"
+)
+
+// activeRentalExists 土地存在生效中租赁的子查询条件, 参数依次为租赁状态和当前时间
+const activeRentalExists = "EXISTS (SELECT 1 FROM land_rental WHERE land_rental.land_token_id = land_info.land_token_id AND land_rental.status = ? AND land_rental.rental_end_time > ?)"
+
+// LandSearchPageSpec 土地搜索可排序字段, 按价格排序时只返回待出售的土地; 按肥力排序使用当前肥力
+func LandSearchPageSpec(regen FertilityRegen) pagination.Spec {
+	return pagination.Spec{
+		Fields: map[string]string{
+			"id":         "land_info.id",
+			"level":      "land_info.level",
+			"rarity":     "land_info.rarity",
+			"fertility":  regen.Expr(),
+			"area":       "land_info.area",
+			"createTime": "land_info.create_time",
+			"price":      "land_market.price",
+		},
+		DefaultField: "id",
+		IDColumn:     "land_info.id",
+	}
+}
+
+// LandSearchFilter 土地搜索筛选条件, 肥力按当前肥力筛选
+type LandSearchFilter struct {
+	Regen         FertilityRegen // 肥力恢复参数, 用于计算当前肥力
+	LandType      *int8          // 地形类型
+	Rarity        *int8          // 稀有度
+	MinLevel      *int8          // 最低等级
+	MaxLevel      *int8          // 最高等级
+	MinFertility  *int           // 最低肥力
+	MaxFertility  *int           // 最高肥力
+	MinArea       *int           // 最小面积
+	MaxArea       *int           // 最大面积
+	SpecialEffect *string        // 特殊效果
+	ForSale       *bool          // 是否待出售
+	ForRent       *bool          // 是否租赁中
+	MinPrice      *float64       // 最低售价, 只匹配待出售的土地
+	MaxPrice      *float64       // 最高售价, 只匹配待出售的土地
+}
+
+func (f LandSearchFilter) filters(now time.Time) []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Eq("land_info.land_type", f.LandType),
+		pagination.Eq("land_info.rarity", f.Rarity),
+		pagination.Range("land_info.level", f.MinLevel, f.MaxLevel),
+		pagination.Range(f.Regen.Expr(), f.MinFertility, f.MaxFertility),
+		pagination.Range("land_info.area", f.MinArea, f.MaxArea),
+		pagination.Eq("land_info.special_effect", f.SpecialEffect),
+		pagination.Range("land_market.price", f.MinPrice, f.MaxPrice),
+		func(db *gorm.DB) *gorm.DB {
+			if f.ForSale == nil {
+				return db
+			}
+			if *f.ForSale {
+				return db.Where("land_market.id IS NOT NULL")
+			}
+			return db.Where("land_market.id IS NULL")
+		},
+		func(db *gorm.DB) *gorm.DB {
+			if f.ForRent == nil {
+				return db
+			}
+			if *f.ForRent {
+				return db.Where(activeRentalExists, RentalStatusActive, now)
+			}
+			return db.Where("NOT "+activeRentalExists, RentalStatusActive, now)
+		},
+	}
+}
+
+// LandSearchResult 土地搜索结果, 附带待出售挂牌及租赁状态
+type LandSearchResult struct {
+	LandInfo
+	ListingID    *uint64  `gorm:"column:listing_id"`    // 待出售挂牌ID, 未挂牌时为空
+	ListingPrice *float64 `gorm:"column:listing_price"` // 挂牌售价, 未挂牌时为空
+	Rented       bool     `gorm:"column:rented"`        // 是否租赁中
+
+	CurrentFertility int `gorm:"column:current_fertility"` // 查询时的当前肥力, 已写回LandInfo.Fertility
+}
+
+// pageKey 返回搜索结果在排序字段上的值, 用于生成游标
+func (r *LandSearchResult) pageKey(field string) (interface{}, uint64) {
+	if field == "price" && r.ListingPrice != nil {
+		return *r.ListingPrice, r.ID
+	}
+	return r.LandInfo.pageKey(field)
+}
+
+// FacetCount 分面统计项
+type FacetCount struct {
+	Value int8  `gorm:"column:value"` // 取值
+	Count int64 `gorm:"column:count"` // 符合条件的土地数量
+}
+
+// LandFacets 土地搜索的分面统计, 每一项统计时忽略该项自身的筛选条件, 其余条件照常生效
+type LandFacets struct {
+	Rarity   []FacetCount // 按稀有度统计
+	LandType []FacetCount // 按地形类型统计
+}
+
+// landSearchQuery 关联待出售挂牌的土地查询, 挂牌表中每块土地最多一条记录
+func (dao *Dao) landSearchQuery(ctx context.Context, filter LandSearchFilter, now time.Time) *gorm.DB {
+	db := dao.DB.WithContext(ctx).Model(&LandInfo{}).
+		Joins("LEFT JOIN land_market ON land_market.land_token_id = land_info.land_token_id AND land_market.status = ?", MarketStatusPending)
+	return pagination.Where(db, filter.filters(now)...)
+}
+
+// SearchLands 按筛选条件分页搜索全部土地, 返回的肥力为查询时的当前肥力
+func (dao *Dao) SearchLands(ctx context.Context, filter LandSearchFilter, q *pagination.Query) ([]*LandSearchResult, *pagination.Result, error) {
+	now := time.Now()
+	db := dao.landSearchQuery(ctx, filter, now).
+		Select("land_info.*, land_market.id AS listing_id, land_market.price AS listing_price, "+activeRentalExists+" AS rented, "+filter.Regen.Expr()+" AS current_fertility", RentalStatusActive, now)
+	if q.Field == "price" {
+		db = db.Where("land_market.id IS NOT NULL")
+	}
+	var results []*LandSearchResult
+	if err := q.Apply(db).Find(&results).Error; err != nil {
+		return nil, nil, err
+	}
+	for _, r := range results {
+		r.Fertility = r.CurrentFertility
+	}
+	return pagination.Paginate(q, results, (*LandSearchResult).pageKey)
+}
+
+// GetLandSearchFacets 统计符合筛选条件的土地按稀有度、地形类型的分布
+func (dao *Dao) GetLandSearchFacets(ctx context.Context, filter LandSearchFilter) (*LandFacets, error) {
+	now := time.Now()
+	facets := &LandFacets{}
+
+	byRarity := filter
+	byRarity.Rarity = nil
+	if err := dao.landSearchQuery(ctx, byRarity, now).
+		Select("land_info.rarity AS value, COUNT(*) AS count").
+		Group("land_info.rarity").Order("land_info.rarity").
+		Scan(&facets.Rarity).Error; err != nil {
+		return nil, err
+	}
+
+	byType := filter
+	byType.LandType = nil
+	if err := dao.landSearchQuery(ctx, byType, now).
+		Select("land_info.land_type AS value, COUNT(*) AS count").
+		Group("land_info.land_type").Order("land_info.land_type").
+		Scan(&facets.LandType).Error; err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
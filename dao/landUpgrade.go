@@ -6,43 +6,62 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 土地升级状态枚举, 历史记录均为已完成
+const (
+	UpgradeStatusBuilding  int8 = 1 // 施工中
+	UpgradeStatusFinished  int8 = 2 // 已完成
+	UpgradeStatusCancelled int8 = 3 // 已取消
 )
 
 // LandUpgrade 土地升级记录表结构体
 type LandUpgrade struct {
-	ID           uint64          `gorm:"primaryKey;column:id"`                        // 主键ID
-	LandTokenID  string          `gorm:"column:land_token_id;index"`                  // 土地NFT TokenID
-	OwnerAddress string          `gorm:"column:owner_address;type:varchar(42);index"` // 所有者钱包地址
-	OldLevel     int8            `gorm:"column:old_level"`                            // 升级前等级
-	NewLevel     int8            `gorm:"column:new_level;index"`                      // 升级后等级
-	CostTokens   uint64          `gorm:"column:cost_tokens;"`                         // 消耗代币数量
-	CostItems    json.RawMessage `gorm:"column:cost_items;type:json"`                 // 消耗道具列表(JSON格式)
-	UpgradeTime  time.Time       `gorm:"column:upgrade_time"`                         // 升级时间
-	CreateTime   time.Time       `gorm:"column:create_time"`                          // 创建时间
-	UpdateTime   time.Time       `gorm:"column:update_time"`                          // 更新时间
+	ID           uint64          `gorm:"primaryKey;column:id"`                                                   // 主键ID
+	LandTokenID  string          `gorm:"column:land_token_id;index"`                                             // 土地NFT TokenID
+	OwnerAddress string          `gorm:"column:owner_address;type:varchar(42);index"`                            // 所有者钱包地址
+	OldLevel     int8            `gorm:"column:old_level"`                                                       // 升级前等级
+	NewLevel     int8            `gorm:"column:new_level;index"`                                                 // 升级后等级
+	RuleID       uint64          `gorm:"column:rule_id"`                                                         // 升级规则ID
+	CostTokens   uint64          `gorm:"column:cost_tokens;"`                                                    // 消耗代币数量
+	CostItems    json.RawMessage `gorm:"column:cost_items;type:json" swaggertype:"object"`                       // 消耗道具列表(JSON格式)
+	RefundTokens uint64          `gorm:"column:refund_tokens;default:0"`                                         // 取消时退还的代币数量
+	Status       int8            `gorm:"column:status;default:2;index:idx_status_complete"`                      // 状态(1-施工中,2-已完成,3-已取消)
+	UpgradeTime  time.Time       `gorm:"column:upgrade_time"`                                                    // 开始升级时间
+	CompleteTime *time.Time      `gorm:"column:complete_time;index:idx_status_complete" extensions:"x-nullable"` // 预计完工时间
+	FinishTime   *time.Time      `gorm:"column:finish_time" extensions:"x-nullable"`                             // 实际完成或取消时间
+	CreateTime   time.Time       `gorm:"column:create_time"`                                                     // 创建时间
+	UpdateTime   time.Time       `gorm:"column:update_time"`                                                     // 更新时间
 }
 
 func (LandUpgrade) TableName() string {
 	return "land_upgrade"
 }
 
-func NewLandUpgrade(landTokenID string, ownerAddress string, oldLevel, newLevel int8, costTokens uint64, costItems map[string]int) *LandUpgrade {
+// NewLandUpgrade 创建施工中的升级记录, 施工时长为0时也先以施工中状态写入, 由调用方立即完成
+func NewLandUpgrade(landTokenID string, ownerAddress string, oldLevel, newLevel int8, costTokens uint64, costItems map[string]int, ruleID uint64, buildTime time.Duration) *LandUpgrade {
 	now := time.Now()
 	itemsJSON, _ := json.Marshal(costItems)
+	completeTime := now.Add(buildTime)
 	return &LandUpgrade{
 		LandTokenID:  landTokenID,
 		OwnerAddress: ownerAddress,
 		OldLevel:     oldLevel,
 		NewLevel:     newLevel,
+		RuleID:       ruleID,
 		CostTokens:   costTokens,
 		CostItems:    itemsJSON,
+		Status:       UpgradeStatusBuilding,
 		UpgradeTime:  now,
+		CompleteTime: &completeTime,
 		CreateTime:   now,
 		UpdateTime:   now,
 	}
 }
 
-func (dao *Dao) GetLandUpgradeHistory(ctx context.Context, landTokenID uint64) ([]*LandUpgrade, error) {
+// GetLandUpgradeHistory 获取土地的全部升级记录, 按升级时间倒序
+func (dao *Dao) GetLandUpgradeHistory(ctx context.Context, landTokenID string) ([]*LandUpgrade, error) {
 	var upgrades []*LandUpgrade
 	err := dao.DB.WithContext(ctx).Where("land_token_id = ?", landTokenID).Order("upgrade_time DESC").Find(&upgrades).Error
 	return upgrades, err
@@ -54,3 +73,37 @@ func (dao *Dao) CreateLandUpgrade(ctx context.Context, tx *gorm.DB, upgrade *Lan
 	}
 	return tx.WithContext(ctx).Create(upgrade).Error
 }
+
+// UpdateLandUpgrade 更新升级记录
+func (dao *Dao) UpdateLandUpgrade(ctx context.Context, tx *gorm.DB, upgrade *LandUpgrade) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	upgrade.UpdateTime = time.Now()
+	return tx.WithContext(ctx).Save(upgrade).Error
+}
+
+// LockLandUpgrade 在事务中锁定升级记录
+func (dao *Dao) LockLandUpgrade(ctx context.Context, tx *gorm.DB, id uint64) (*LandUpgrade, error) {
+	var upgrade LandUpgrade
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&upgrade).Error
+	return &upgrade, err
+}
+
+// GetBuildingUpgradesByOwner 获取用户施工中的升级, 按完工时间升序
+func (dao *Dao) GetBuildingUpgradesByOwner(ctx context.Context, ownerAddress string) ([]*LandUpgrade, error) {
+	var upgrades []*LandUpgrade
+	err := dao.DB.WithContext(ctx).
+		Where("owner_address = ? AND status = ?", ownerAddress, UpgradeStatusBuilding).
+		Order("complete_time").Find(&upgrades).Error
+	return upgrades, err
+}
+
+// GetDueLandUpgrades 获取已到完工时间但仍处于施工中的升级
+func (dao *Dao) GetDueLandUpgrades(ctx context.Context, now time.Time, limit int) ([]*LandUpgrade, error) {
+	var upgrades []*LandUpgrade
+	err := dao.DB.WithContext(ctx).
+		Where("status = ? AND complete_time <= ?", UpgradeStatusBuilding, now).
+		Order("complete_time").Limit(limit).Find(&upgrades).Error
+	return upgrades, err
+}
@@ -0,0 +1,228 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AnyLandAttr 升级规则中表示匹配任意稀有度/地形
+const AnyLandAttr int8 = -1
+
+// LandUpgradeRule 土地升级规则表结构体, 每行定义升级到某一等级的消耗、效果与前置条件
+// 稀有度、地形为-1表示通用规则, 查询时优先使用更具体的规则
+type LandUpgradeRule struct {
+	ID              uint64          `gorm:"primaryKey;column:id"`                                   // 主键ID
+	Rarity          int8            `gorm:"column:rarity;default:-1;uniqueIndex:idx_rule"`          // 稀有度(-1-通用,0-普通,1-稀有,2-史诗,3-传说)
+	LandType        int8            `gorm:"column:land_type;default:-1;uniqueIndex:idx_rule"`       // 地形类型(-1-通用,0-平原,1-湿地,2-山地)
+	Level           int8            `gorm:"column:level;uniqueIndex:idx_rule"`                      // 升级后的等级
+	CostTokens      uint64          `gorm:"column:cost_tokens"`                                     // 消耗MFG代币数量
+	CostItems       json.RawMessage `gorm:"column:cost_items;type:json" swaggertype:"object"`       // 消耗道具(道具TokenID->数量)
+	AreaIncrease    int             `gorm:"column:area_increase;default:0"`                         // 面积增加(㎡)
+	FertilityCap    int             `gorm:"column:fertility_cap;default:100"`                       // 肥力上限
+	YieldMultiplier float64         `gorm:"column:yield_multiplier;type:decimal(5,2);default:1.00"` // 产量倍率
+	UnlockZoneTypes string          `gorm:"column:unlock_zone_types;type:varchar(32)"`              // 解锁的分区类型(逗号分隔)
+	BuildMinutes    int             `gorm:"column:build_minutes;default:0"`                         // 施工时长(分钟), 0表示立即完成
+	MinFertility    int             `gorm:"column:min_fertility;default:0"`                         // 前置条件: 最低肥力
+	CooldownHours   int             `gorm:"column:cooldown_hours;default:0"`                        // 前置条件: 距上次升级的最短间隔(小时)
+	CreateTime      time.Time       `gorm:"column:create_time"`                                     // 创建时间
+	UpdateTime      time.Time       `gorm:"column:update_time"`                                     // 更新时间
+}
+
+func (LandUpgradeRule) TableName() string {
+	return "land_upgrade_rule"
+}
+
+// Items 解析消耗道具列表
+func (r *LandUpgradeRule) Items() (map[string]int, error) {
+	items := map[string]int{}
+	if len(r.CostItems) == 0 {
+		return items, nil
+	}
+	err := json.Unmarshal(r.CostItems, &items)
+	return items, err
+}
+
+// GetLandUpgradeRule 获取土地升级到指定等级的规则, 按稀有度、地形从具体到通用匹配
+func (dao *Dao) GetLandUpgradeRule(ctx context.Context, rarity, landType, level int8) (*LandUpgradeRule, error) {
+	var rule LandUpgradeRule
+	err := dao.DB.WithContext(ctx).
+		Where("level = ? AND rarity IN (?, ?) AND land_type IN (?, ?)", level, rarity, AnyLandAttr, landType, AnyLandAttr).
+		Order("rarity DESC, land_type DESC").
+		First(&rule).Error
+	return &rule, err
+}
+
+// SeedLandUpgradeRules 规则表为空时写入默认的通用升级规则
+func (dao *Dao) SeedLandUpgradeRules(ctx context.Context) error {
+	var count int64
+	if err := dao.DB.WithContext(ctx).Model(&LandUpgradeRule{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return dao.DB.WithContext(ctx).Create(defaultLandUpgradeRules()).Error
+}
+
+// defaultLandUpgradeRules 默认规则: 2-10级, 每级消耗100*等级MFG及1001、1002道具各1个
+// 每级面积+10㎡、肥力上限+5、产量倍率+0.1, 3级解锁养殖区, 5级解锁装饰区, 施工时长为(等级-1)小时
+func defaultLandUpgradeRules() []*LandUpgradeRule {
+	now := time.Now()
+	items, _ := json.Marshal(map[string]int{"1001": 1, "1002": 1})
+	unlocks := map[int8]string{3: "1", 5: "2"}
+
+	var rules []*LandUpgradeRule
+	for level := int8(2); level <= 10; level++ {
+		rules = append(rules, &LandUpgradeRule{
+			Rarity:          AnyLandAttr,
+			LandType:        AnyLandAttr,
+			Level:           level,
+			CostTokens:      uint64(100 * int64(level)),
+			CostItems:       items,
+			AreaIncrease:    10,
+			FertilityCap:    100 + 5*int(level-1),
+			YieldMultiplier: 1 + 0.1*float64(level-1),
+			UnlockZoneTypes: unlocks[level],
+			BuildMinutes:    60 * int(level-1),
+			CreateTime:      now,
+			UpdateTime:      now,
+		})
+	}
+	return rules
+}
+
+// levelBackfillBatch 回填等级效果时每批处理的土地数量
+const levelBackfillBatch = 500
+
+// BackfillLandLevelEffects 回填升级规则表上线前已升级的土地: 这些土地的肥力上限、产量倍率及已解锁分区仍为默认值,
+// 按规则表重放2级到当前等级的效果(面积已在当时升级时增加, 不再重复); 只处理仍为默认值的土地, 可重复执行
+func (dao *Dao) BackfillLandLevelEffects(ctx context.Context) error {
+	var rules []*LandUpgradeRule
+	if err := dao.DB.WithContext(ctx).Find(&rules).Error; err != nil {
+		return err
+	}
+
+	var afterID uint64
+	for {
+		var lands []*LandInfo
+		if err := dao.DB.WithContext(ctx).
+			Where("id > ? AND level > 1", afterID).
+			Where(levelDefaultsCondition, "0", 100, 1).
+			Order("id").Limit(levelBackfillBatch).
+			Find(&lands).Error; err != nil {
+			return err
+		}
+		for _, land := range lands {
+			for level := int8(2); level <= land.Level; level++ {
+				rule := matchLandUpgradeRule(rules, land.Rarity, land.LandType, level)
+				if rule == nil {
+					continue
+				}
+				if rule.FertilityCap > 0 {
+					land.FertilityCap = rule.FertilityCap
+				}
+				if rule.YieldMultiplier > 0 {
+					land.YieldMultiplier = rule.YieldMultiplier
+				}
+				land.UnlockZones(rule.UnlockZoneTypes)
+			}
+			// 以默认值作为条件, 期间已通过升级更新的土地不会被覆盖
+			if err := dao.DB.WithContext(ctx).Model(&LandInfo{}).
+				Where("id = ?", land.ID).
+				Where(levelDefaultsCondition, "0", 100, 1).
+				UpdateColumns(map[string]interface{}{
+					"fertility_cap":    land.FertilityCap,
+					"yield_multiplier": land.YieldMultiplier,
+					"unlocked_zones":   land.UnlockedZones,
+					"update_time":      time.Now(),
+				}).Error; err != nil {
+				return err
+			}
+		}
+		if len(lands) < levelBackfillBatch {
+			return nil
+		}
+		afterID = lands[len(lands)-1].ID
+	}
+}
+
+// levelDefaultsCondition 土地的等级效果仍为新建时的默认值
+const levelDefaultsCondition = "unlocked_zones = ? AND fertility_cap = ? AND yield_multiplier = ?"
+
+// matchLandUpgradeRule 与GetLandUpgradeRule相同的匹配顺序: 稀有度、地形从具体到通用
+func matchLandUpgradeRule(rules []*LandUpgradeRule, rarity, landType, level int8) *LandUpgradeRule {
+	var best *LandUpgradeRule
+	for _, rule := range rules {
+		if rule.Level != level || (rule.Rarity != rarity && rule.Rarity != AnyLandAttr) || (rule.LandType != landType && rule.LandType != AnyLandAttr) {
+			continue
+		}
+		if best == nil || rule.Rarity > best.Rarity || (rule.Rarity == best.Rarity && rule.LandType > best.LandType) {
+			best = rule
+		}
+	}
+	return best
+}
+
+// GetLandUpgradeRuleByID 根据ID获取升级规则
+func (dao *Dao) GetLandUpgradeRuleByID(ctx context.Context, id uint64) (*LandUpgradeRule, error) {
+	var rule LandUpgradeRule
+	err := dao.DB.WithContext(ctx).Where("id = ?", id).First(&rule).Error
+	return &rule, err
+}
+
+// GetLastLandUpgrade 获取土地最近一次完成的升级记录
+func (dao *Dao) GetLastLandUpgrade(ctx context.Context, landTokenID string) (*LandUpgrade, error) {
+	var upgrade LandUpgrade
+	err := dao.DB.WithContext(ctx).Where("land_token_id = ? AND status = ?", landTokenID, UpgradeStatusFinished).Order("upgrade_time DESC").First(&upgrade).Error
+	return &upgrade, err
+}
+
+// ApplyLandUpgrade 施工完成, 按升级规则更新土地等级及属性、写入结算后的肥力并结束施工, 以升级前等级作为条件防止并发重复升级
+func (dao *Dao) ApplyLandUpgrade(ctx context.Context, tx *gorm.DB, land *LandInfo, fromLevel int8) (bool, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	land.UpdateTime = time.Now()
+	result := tx.WithContext(ctx).Model(&LandInfo{}).
+		Where("land_token_id = ? AND level = ?", land.LandTokenID, fromLevel).
+		UpdateColumns(map[string]interface{}{
+			"level":                 land.Level,
+			"area":                  land.Area,
+			"fertility":             land.Fertility,
+			"fertility_update_time": land.FertilityUpdateTime,
+			"fertility_cap":         land.FertilityCap,
+			"yield_multiplier":      land.YieldMultiplier,
+			"unlocked_zones":        land.UnlockedZones,
+			"upgrade_complete_time": nil,
+			"update_time":           land.UpdateTime,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// StartLandConstruction 土地开始升级施工, 已在施工或等级已变化时不更新并返回false
+func (dao *Dao) StartLandConstruction(ctx context.Context, tx *gorm.DB, tokenID string, level int8, completeTime time.Time) (bool, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	result := tx.WithContext(ctx).Model(&LandInfo{}).
+		Where("land_token_id = ? AND level = ? AND upgrade_complete_time IS NULL", tokenID, level).
+		UpdateColumns(map[string]interface{}{
+			"upgrade_complete_time": completeTime,
+			"update_time":           time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateLandConstruction 更新土地施工完成时间, completeTime为nil表示结束施工
+func (dao *Dao) UpdateLandConstruction(ctx context.Context, tx *gorm.DB, tokenID string, completeTime *time.Time) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&LandInfo{}).Where("land_token_id = ?", tokenID).UpdateColumns(map[string]interface{}{
+		"upgrade_complete_time": completeTime,
+		"update_time":           time.Now(),
+	}).Error
+}
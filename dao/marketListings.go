@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"MetaFarmBackend/component/pagination"
 )
 
 // MarketListings 市场挂单表结构体
@@ -59,25 +61,54 @@ func (dao *Dao) GetMarketListingByID(ctx context.Context, listingID int64) (*Mar
 	return &listing, err
 }
 
-func (dao *Dao) GetActiveListings(ctx context.Context, page, pageSize int) ([]*MarketListings, int64, error) {
-	var listings []*MarketListings
-	var total int64
-	db := dao.DB.WithContext(ctx)
+// MarketListingsPageSpec 市场挂单列表可排序字段, 默认按创建时间倒序
+var MarketListingsPageSpec = pagination.Spec{
+	Fields: map[string]string{
+		"id":         "id",
+		"createTime": "create_time",
+		"startTime":  "start_time",
+		"price":      "price",
+	},
+	DefaultField: "createTime",
+	DefaultDesc:  true,
+}
+
+// MarketListingsFilter 市场挂单筛选条件
+type MarketListingsFilter struct {
+	NFTType       *int8   // NFT类型
+	SaleType      *int8   // 销售类型
+	SellerAddress *string // 卖家钱包地址
+}
 
-	// 获取总数
-	err := db.Model(&MarketListings{}).Where("status = 1 AND start_time <= NOW() AND (end_time IS NULL OR end_time >= NOW())").Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+func (f MarketListingsFilter) filters() []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Eq("nft_type", f.NFTType),
+		pagination.Eq("sale_type", f.SaleType),
+		pagination.Eq("seller_address", f.SellerAddress),
 	}
+}
 
-	// 分页查询
-	offset := (page - 1) * pageSize
-	err = db.Where("status = 1 AND start_time <= NOW() AND (end_time IS NULL OR end_time >= NOW())").Order("create_time DESC").Offset(offset).Limit(pageSize).Find(&listings).Error
-	if err != nil {
-		return nil, 0, err
+// pageKey 返回挂单在排序字段上的值, 用于生成游标
+func (m *MarketListings) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "createTime":
+		return m.CreateTime, uint64(m.ID)
+	case "startTime":
+		return m.StartTime, uint64(m.ID)
+	case "price":
+		return m.Price.Float64, uint64(m.ID)
 	}
+	return m.ID, uint64(m.ID)
+}
 
-	return listings, total, nil
+// GetActiveListings 分页获取当前有效的挂单
+func (dao *Dao) GetActiveListings(ctx context.Context, filter MarketListingsFilter, q *pagination.Query) ([]*MarketListings, *pagination.Result, error) {
+	var listings []*MarketListings
+	db := dao.DB.WithContext(ctx).Where("status = 1 AND start_time <= NOW() AND (end_time IS NULL OR end_time >= NOW())")
+	if err := q.Apply(pagination.Where(db, filter.filters()...)).Find(&listings).Error; err != nil {
+		return nil, nil, err
+	}
+	return pagination.Paginate(q, listings, (*MarketListings).pageKey)
 }
 
 // CreateMarketListing 创建挂单记录
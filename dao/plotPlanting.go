@@ -3,27 +3,48 @@ package dao
 import (
 	"context"
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 地块照料状态的取值范围
+const (
+	PlotLevelMin = 0   // 水分/肥料/虫害等级下限
+	PlotLevelMax = 100 // 水分/肥料/虫害等级上限, 虫害达到上限时无法收获
 )
 
-// PlotPlanting 地块种植表结构体
+// PlotPlanting 地块种植表结构体, 每次种植作物对应一个地块, 记录浇水、施肥、除虫等照料状态
 type PlotPlanting struct {
-	ID                int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`                                   // 主键ID
-	PlotID            int64     `gorm:"column:plot_id;uniqueIndex:idx_plot_id" json:"plot_id"`                          // 地块ID
-	LandTokenID       int64     `gorm:"column:land_token_id;index:idx_land_token_id" json:"land_token_id"`              // 土地NFT TokenID
-	CropID            int       `gorm:"column:crop_id" json:"crop_id"`                                                  // 作物ID
-	CropName          string    `gorm:"column:crop_name;size:50" json:"crop_name"`                                      // 作物名称
-	PlantedAt         time.Time `gorm:"column:planted_at" json:"planted_at"`                                            // 种植时间
-	WaterLevel        int       `gorm:"column:water_level;default:0" json:"water_level"`                                // 水分等级
-	FertilizerLevel   int       `gorm:"column:fertilizer_level;default:0" json:"fertilizer_level"`                      // 肥料等级
-	PestLevel         int       `gorm:"column:pest_level;default:0" json:"pest_level"`                                  // 虫害等级
-	IsHarvestable     int8      `gorm:"column:is_harvestable;default:0;index:idx_is_harvestable" json:"is_harvestable"` // 是否可收获(0:否, 1:是)
-	IsPlanted         int8      `gorm:"column:is_planted;default:0;index:idx_is_planted" json:"is_planted"`             // 是否已种植(0:否, 1:是)
-	ExpectedYield     int       `gorm:"column:expected_yield;default:0" json:"expected_yield"`                          // 预计产量
-	LastWaterTime     time.Time `gorm:"column:last_water_time" json:"last_water_time"`                                  // 最后浇水时间
-	LastFertilizeTime time.Time `gorm:"column:last_fertilize_time" json:"last_fertilize_time"`                          // 最后施肥时间
-	LastPesticideTime time.Time `gorm:"column:last_pesticide_time" json:"last_pesticide_time"`                          // 最后除虫时间
-	CreateTime        time.Time `gorm:"column:create_time;not null" json:"create_time"`                                 // 创建时间
-	UpdateTime        time.Time `gorm:"column:update_time;not null;autoUpdateTime" json:"update_time"`                  // 更新时间
+	ID                int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`                                   // 主键ID
+	PlotID            int64      `gorm:"column:plot_id;uniqueIndex:idx_plot_id" json:"plot_id"`                          // 地块ID, 与种植活动ID一致
+	LandTokenID       string     `gorm:"column:land_token_id;index:idx_land_token_id" json:"land_token_id"`              // 土地NFT TokenID
+	ActivityID        uint64     `gorm:"column:activity_id;index" json:"activity_id"`                                    // 种植活动ID
+	ZoneID            uint64     `gorm:"column:zone_id;index" json:"zone_id"`                                            // 所在分区ID
+	CropID            int        `gorm:"column:crop_id" json:"crop_id"`                                                  // 作物ID
+	CropName          string     `gorm:"column:crop_name;size:50" json:"crop_name"`                                      // 作物名称
+	PlantedAt         time.Time  `gorm:"column:planted_at" json:"planted_at"`                                            // 种植时间
+	WaterLevel        int        `gorm:"column:water_level;default:0" json:"water_level"`                                // 水分等级
+	FertilizerLevel   int        `gorm:"column:fertilizer_level;default:0" json:"fertilizer_level"`                      // 肥料等级
+	PestLevel         int        `gorm:"column:pest_level;default:0" json:"pest_level"`                                  // 虫害等级
+	IsHarvestable     int8       `gorm:"column:is_harvestable;default:0;index:idx_is_harvestable" json:"is_harvestable"` // 是否可收获(0:否, 1:是)
+	IsPlanted         int8       `gorm:"column:is_planted;default:0;index:idx_is_planted" json:"is_planted"`             // 是否已种植(0:否, 1:是)
+	ExpectedYield     int        `gorm:"column:expected_yield;default:0" json:"expected_yield"`                          // 预计产量
+	LastWaterTime     *time.Time `gorm:"column:last_water_time" json:"last_water_time"`                                  // 最后浇水时间
+	LastFertilizeTime *time.Time `gorm:"column:last_fertilize_time" json:"last_fertilize_time"`                          // 最后施肥时间
+	LastPesticideTime *time.Time `gorm:"column:last_pesticide_time" json:"last_pesticide_time"`                          // 最后除虫时间
+	CareSettledAt     time.Time  `gorm:"column:care_settled_at" json:"care_settled_at"`                                  // 水分结算时间
+	PestSettledAt     *time.Time `gorm:"column:pest_settled_at" json:"pest_settled_at" extensions:"x-nullable"`          // 虫害结算时间, 为空时以水分结算时间为准
+	CreateTime        time.Time  `gorm:"column:create_time;not null" json:"create_time"`                                 // 创建时间
+	UpdateTime        time.Time  `gorm:"column:update_time;not null;autoUpdateTime" json:"update_time"`                  // 更新时间
+}
+
+// PestSettledAtOrDefault 虫害结算时间, 新增该字段前创建的地块以水分结算时间为准
+func (p *PlotPlanting) PestSettledAtOrDefault() time.Time {
+	if p.PestSettledAt != nil {
+		return *p.PestSettledAt
+	}
+	return p.CareSettledAt
 }
 
 // TableName 设置表名
@@ -31,14 +52,24 @@ func (p *PlotPlanting) TableName() string {
 	return "plot_planting"
 }
 
-// NewPlotPlanting 创建新的地块种植实例
-func NewPlotPlanting(plotID, landTokenID int64) *PlotPlanting {
+// NewPlotPlanting 为种植活动创建地块, 种植时已浇透水
+func NewPlotPlanting(activity *LandActivity, zoneID uint64) *PlotPlanting {
 	now := time.Now()
 	return &PlotPlanting{
-		PlotID:      plotID,
-		LandTokenID: landTokenID,
-		CreateTime:  now,
-		UpdateTime:  now,
+		PlotID:        int64(activity.ID),
+		LandTokenID:   activity.LandTokenID,
+		ActivityID:    activity.ID,
+		ZoneID:        zoneID,
+		CropID:        int(activity.CropAnimalID),
+		CropName:      activity.CropAnimalName,
+		PlantedAt:     activity.Start_time,
+		WaterLevel:    PlotLevelMax,
+		IsPlanted:     1,
+		LastWaterTime: &now,
+		CareSettledAt: now,
+		PestSettledAt: &now,
+		CreateTime:    now,
+		UpdateTime:    now,
 	}
 }
 
@@ -52,22 +83,78 @@ func (dao *Dao) GetPlotPlantingByPlotID(ctx context.Context, plotID int64) (*Plo
 	return &plotPlanting, nil
 }
 
-// GetPlotPlantingsByLandTokenID 根据土地NFT ID获取种植信息列表
-func (dao *Dao) GetPlotPlantingsByLandTokenID(ctx context.Context, landTokenID int64) ([]*PlotPlanting, error) {
+// GetPlotPlantingByActivityID 根据种植活动ID获取地块
+func (dao *Dao) GetPlotPlantingByActivityID(ctx context.Context, activityID uint64) (*PlotPlanting, error) {
+	var plotPlanting PlotPlanting
+	err := dao.DB.WithContext(ctx).Where("activity_id = ?", activityID).First(&plotPlanting).Error
+	if err != nil {
+		return nil, err
+	}
+	return &plotPlanting, nil
+}
+
+// GetPlotPlantingsByActivityIDs 批量获取种植活动对应的地块, 按活动ID索引
+func (dao *Dao) GetPlotPlantingsByActivityIDs(ctx context.Context, activityIDs []uint64) (map[uint64]*PlotPlanting, error) {
+	var plotPlantings []*PlotPlanting
+	if err := dao.DB.WithContext(ctx).Where("activity_id IN ?", activityIDs).Find(&plotPlantings).Error; err != nil {
+		return nil, err
+	}
+	byActivity := make(map[uint64]*PlotPlanting, len(plotPlantings))
+	for _, plot := range plotPlantings {
+		byActivity[plot.ActivityID] = plot
+	}
+	return byActivity, nil
+}
+
+// GetPlotPlantingsByLandTokenID 获取土地上正在种植的地块
+func (dao *Dao) GetPlotPlantingsByLandTokenID(ctx context.Context, landTokenID string) ([]*PlotPlanting, error) {
 	var plotPlantings []*PlotPlanting
-	err := dao.DB.WithContext(ctx).Where("land_token_id = ?", landTokenID).Find(&plotPlantings).Error
+	err := dao.DB.WithContext(ctx).Where("land_token_id = ? AND is_planted = 1", landTokenID).Order("id").Find(&plotPlantings).Error
 	if err != nil {
 		return nil, err
 	}
 	return plotPlantings, nil
 }
 
+// LockPlotPlanting 在事务中锁定种植活动对应的地块
+func (dao *Dao) LockPlotPlanting(ctx context.Context, tx *gorm.DB, activityID uint64) (*PlotPlanting, error) {
+	var plotPlanting PlotPlanting
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("activity_id = ?", activityID).First(&plotPlanting).Error
+	return &plotPlanting, err
+}
+
 // CreatePlotPlanting 创建地块种植记录
-func (dao *Dao) CreatePlotPlanting(ctx context.Context, p *PlotPlanting) error {
-	return dao.DB.WithContext(ctx).Create(p).Error
+func (dao *Dao) CreatePlotPlanting(ctx context.Context, tx *gorm.DB, p *PlotPlanting) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Create(p).Error
+}
+
+// CreatePlotPlantings 批量创建地块种植记录
+func (dao *Dao) CreatePlotPlantings(ctx context.Context, tx *gorm.DB, plots []*PlotPlanting) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Create(plots).Error
 }
 
 // UpdatePlotPlanting 更新地块种植记录
-func (dao *Dao) UpdatePlotPlanting(ctx context.Context, p *PlotPlanting) error {
-	return dao.DB.WithContext(ctx).Save(p).Error
+func (dao *Dao) UpdatePlotPlanting(ctx context.Context, tx *gorm.DB, p *PlotPlanting) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Save(p).Error
+}
+
+// FinishPlotPlanting 活动收获或枯萎后结束地块种植, 地块不再接受照料
+func (dao *Dao) FinishPlotPlanting(ctx context.Context, tx *gorm.DB, activityIDs ...uint64) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&PlotPlanting{}).Where("activity_id IN ?", activityIDs).UpdateColumns(map[string]interface{}{
+		"is_planted":     0,
+		"is_harvestable": 0,
+		"update_time":    time.Now(),
+	}).Error
 }
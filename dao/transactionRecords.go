@@ -4,6 +4,27 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"MetaFarmBackend/component/pagination"
+
+	"gorm.io/gorm"
+)
+
+// 交易类型
+const (
+	TransactionTypeBuy      int8 = 1 // 购买
+	TransactionTypeSell     int8 = 2 // 出售
+	TransactionTypeTransfer int8 = 3 // 转账
+	TransactionTypeUpgrade  int8 = 4 // 土地升级消耗
+	TransactionTypeRefund   int8 = 5 // 取消升级退款
+	TransactionTypeSpeedUp  int8 = 6 // 升级加速消耗
+)
+
+// 交易状态
+const (
+	TransactionStatusPending int8 = 0 // 处理中
+	TransactionStatusSuccess int8 = 1 // 成功
+	TransactionStatusFailed  int8 = 2 // 失败
 )
 
 // TransactionRecords 交易记录表结构体
@@ -11,7 +32,7 @@ type TransactionRecords struct {
 	ID                 int64           `gorm:"column:id;primaryKey;autoIncrement" json:"id"`                                        // 主键ID
 	TxHash             string          `gorm:"column:tx_hash;size:66;uniqueIndex:idx_tx_hash" json:"tx_hash"`                       // 交易哈希
 	UserAddress        string          `gorm:"column:user_address;size:42;not null;index:idx_user_address" json:"user_address"`     // 用户钱包地址
-	TransactionType    int8            `gorm:"column:transaction_type;not null;index:idx_transaction_type" json:"transaction_type"` // 交易类型(1:购买, 2:出售, 3:转账等)
+	TransactionType    int8            `gorm:"column:transaction_type;not null;index:idx_transaction_type" json:"transaction_type"` // 交易类型(1:购买, 2:出售, 3:转账, 4:土地升级消耗, 5:取消升级退款, 6:升级加速消耗)
 	NFTContractAddress string          `gorm:"column:nft_contract_address;size:42" json:"nft_contract_address"`                     // NFT合约地址
 	TokenID            int64           `gorm:"column:token_id" json:"token_id"`                                                     // NFT TokenID
 	Amount             sql.NullFloat64 `gorm:"column:amount;type:decimal(36,18);default:0.0" json:"amount"`                         // 交易金额
@@ -48,30 +69,57 @@ func (dao *Dao) GetTransactionByTxHash(ctx context.Context, txHash string) (*Tra
 	return &transaction, nil
 }
 
-// GetTransactionRecordsByUser 根据用户地址获取交易记录列表
-func (dao *Dao) GetTransactionRecordsByUser(ctx context.Context, userAddress string, page, pageSize int) ([]*TransactionRecords, int64, error) {
-	var transactions []*TransactionRecords
-	var total int64
+// TransactionRecordsPageSpec 交易记录列表可排序字段, 默认按创建时间倒序
+var TransactionRecordsPageSpec = pagination.Spec{
+	Fields: map[string]string{
+		"id":         "id",
+		"createTime": "create_time",
+		"amount":     "amount",
+	},
+	DefaultField: "createTime",
+	DefaultDesc:  true,
+}
 
-	// 获取总数
-	err := dao.DB.WithContext(ctx).Model(&TransactionRecords{}).Where("user_address = ?", userAddress).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+// TransactionRecordsFilter 交易记录筛选条件
+type TransactionRecordsFilter struct {
+	TransactionType *int8 // 交易类型
+	Status          *int8 // 状态
+}
+
+func (f TransactionRecordsFilter) filters() []pagination.Filter {
+	return []pagination.Filter{
+		pagination.Eq("transaction_type", f.TransactionType),
+		pagination.Eq("status", f.Status),
 	}
+}
 
-	// 分页查询
-	offset := (page - 1) * pageSize
-	err = dao.DB.WithContext(ctx).Where("user_address = ?", userAddress).Order("create_time DESC").Offset(offset).Limit(pageSize).Find(&transactions).Error
-	if err != nil {
-		return nil, 0, err
+// pageKey 返回交易记录在排序字段上的值, 用于生成游标
+func (t *TransactionRecords) pageKey(field string) (interface{}, uint64) {
+	switch field {
+	case "createTime":
+		return t.CreateTime, uint64(t.ID)
+	case "amount":
+		return t.Amount.Float64, uint64(t.ID)
 	}
+	return t.ID, uint64(t.ID)
+}
 
-	return transactions, total, nil
+// GetTransactionRecordsByUser 根据用户地址分页获取交易记录列表
+func (dao *Dao) GetTransactionRecordsByUser(ctx context.Context, userAddress string, filter TransactionRecordsFilter, q *pagination.Query) ([]*TransactionRecords, *pagination.Result, error) {
+	var transactions []*TransactionRecords
+	db := pagination.Where(dao.DB.WithContext(ctx).Where("user_address = ?", userAddress), filter.filters()...)
+	if err := q.Apply(db).Find(&transactions).Error; err != nil {
+		return nil, nil, err
+	}
+	return pagination.Paginate(q, transactions, (*TransactionRecords).pageKey)
 }
 
 // CreateTransactionRecord 创建交易记录
-func (dao *Dao) CreateTransactionRecord(ctx context.Context, t *TransactionRecords) error {
-	return dao.DB.WithContext(ctx).Create(t).Error
+func (dao *Dao) CreateTransactionRecord(ctx context.Context, tx *gorm.DB, t *TransactionRecords) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Create(t).Error
 }
 
 // UpdateTransactionStatus 更新交易状态
@@ -3,6 +3,9 @@ package dao
 import (
 	"context"
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // UserAccount 用户账户信息表结构体
@@ -18,6 +21,7 @@ type UserAccount struct {
 	TotalNFTCount    int        `gorm:"column:total_nft_count;default:0"`                   // NFT总数
 	MFGBalance       float64    `gorm:"column:mfg_balance;type:decimal(36,18);default:0.0"` // MFG代币余额
 	LastClaimTime    *time.Time `gorm:"column:last_claim_time"`                             // 最后领取时间
+	Experience       int64      `gorm:"column:experience;default:0"`                        // 经验值
 	IsBanned         bool       `gorm:"column:is_banned;default:false"`                     // 是否封禁
 }
 
@@ -45,3 +49,31 @@ func (dao *Dao) CreateUserAccount(ctx context.Context, user *UserAccount) error
 func (dao *Dao) UpdateUserAccount(ctx context.Context, user *UserAccount) error {
 	return dao.DB.WithContext(ctx).Save(user).Error
 }
+
+// LockUserAccount 在事务中锁定用户账户行, 用于扣减余额前的校验
+func (dao *Dao) LockUserAccount(ctx context.Context, tx *gorm.DB, address string) (*UserAccount, error) {
+	var user UserAccount
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_address = ?", address).First(&user).Error
+	return &user, err
+}
+
+// AddMFGBalance 增减MFG余额, amount为负数时扣减, 余额不足时不更新并返回false
+func (dao *Dao) AddMFGBalance(ctx context.Context, tx *gorm.DB, address string, amount float64) (bool, error) {
+	if tx == nil {
+		tx = dao.DB
+	}
+	result := tx.WithContext(ctx).Model(&UserAccount{}).
+		Where("user_address = ? AND mfg_balance + ? >= 0", address, amount).
+		Update("mfg_balance", gorm.Expr("mfg_balance + ?", amount))
+	return result.RowsAffected > 0, result.Error
+}
+
+// AddExperience 增加用户经验值
+func (dao *Dao) AddExperience(ctx context.Context, tx *gorm.DB, address string, experience int64) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&UserAccount{}).
+		Where("user_address = ?", address).
+		Update("experience", gorm.Expr("experience + ?", experience)).Error
+}
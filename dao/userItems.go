@@ -5,6 +5,17 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 道具类型
+const (
+	ItemTypeFertilizer int8 = 1 // 肥料, Power为每次使用恢复的肥力
+	ItemTypePesticide  int8 = 2 // 杀虫剂, Power为每次使用降低的地块虫害
+	ItemTypeSpeedUp    int8 = 3 // 加速道具, Power为每次使用缩短的施工时间(分钟)
+	ItemTypeWater      int8 = 4 // 水壶, Power为每次浇水增加的地块水分
+	ItemTypeFeed       int8 = 5 // 饲料, Power为每次喂养恢复的动物健康值
 )
 
 // UserItems 用户道具表结构体
@@ -12,7 +23,7 @@ type UserItems struct {
 	ID            int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`                                   // 主键ID
 	UserAddress   string    `gorm:"column:user_address;size:42;not null" json:"user_address"`                       // 用户钱包地址
 	ItemTokenID   int64     `gorm:"column:item_token_id;not null" json:"item_token_id"`                             // 道具TokenID
-	ItemType      int8      `gorm:"column:item_type;not null;index:idx_item_type" json:"item_type"`                 // 道具类型(1:肥料, 2:杀虫剂等)
+	ItemType      int8      `gorm:"column:item_type;not null;index:idx_item_type" json:"item_type"`                 // 道具类型(1:肥料, 2:杀虫剂, 3:加速道具, 4:水壶, 5:饲料)
 	ItemName      string    `gorm:"column:item_name;size:50;not null" json:"item_name"`                             // 道具名称
 	Rarity        int8      `gorm:"column:rarity;not null;default:1;index:idx_rarity" json:"rarity"`                // 稀有度(1:普通, 2:稀有, 3:史诗)
 	Power         int       `gorm:"column:power;default:0" json:"power"`                                            // 道具效果值
@@ -82,3 +93,24 @@ func (dao *Dao) DecreaseRemainingUses(ctx context.Context, userItems *UserItems,
 	userItems.RemainingUses -= amount
 	return dao.UpdateUserItems(ctx, userItems)
 }
+
+// LockUserItemsByTokens 在事务中锁定用户指定TokenID的可用道具, 按主键排序加锁避免死锁
+func (dao *Dao) LockUserItemsByTokens(ctx context.Context, tx *gorm.DB, userAddress string, itemTokenIDs []int64) ([]*UserItems, error) {
+	var userItems []*UserItems
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_address = ? AND item_token_id IN ? AND is_active = 1 AND remaining_uses > 0", userAddress, itemTokenIDs).
+		Order("id").Find(&userItems).Error
+	return userItems, err
+}
+
+// ConsumeUserItemUses 在事务中扣减道具剩余使用次数
+func (dao *Dao) ConsumeUserItemUses(ctx context.Context, tx *gorm.DB, item *UserItems, uses int) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	if item.RemainingUses < uses {
+		return errors.New("insufficient remaining uses")
+	}
+	item.RemainingUses -= uses
+	return tx.WithContext(ctx).Model(item).Update("remaining_uses", item.RemainingUses).Error
+}
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserProduce 用户收获物库存表结构体, 每个用户每种作物/动物一行
+type UserProduce struct {
+	ID             uint64    `gorm:"primaryKey;column:id" json:"id"`                                                       // 主键ID
+	UserAddress    string    `gorm:"column:user_address;type:varchar(42);uniqueIndex:uk_user_produce" json:"user_address"` // 用户钱包地址
+	CropAnimalID   uint64    `gorm:"column:crop_animal_id;uniqueIndex:uk_user_produce" json:"crop_animal_id"`              // 作物/动物ID
+	CropAnimalName string    `gorm:"column:crop_animal_name;type:varchar(50)" json:"crop_animal_name"`                     // 作物/动物名称
+	Quantity       int64     `gorm:"column:quantity;default:0" json:"quantity"`                                            // 库存数量
+	CreateTime     time.Time `gorm:"column:create_time" json:"create_time"`                                                // 创建时间
+	UpdateTime     time.Time `gorm:"column:update_time" json:"update_time"`                                                // 更新时间
+}
+
+func (UserProduce) TableName() string {
+	return "user_produce"
+}
+
+// AddUserProduce 增加用户收获物库存, 首次收获时创建库存行
+func (dao *Dao) AddUserProduce(ctx context.Context, tx *gorm.DB, address string, cropAnimalID uint64, name string, quantity int64) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	now := time.Now()
+	produce := &UserProduce{
+		UserAddress:    address,
+		CropAnimalID:   cropAnimalID,
+		CropAnimalName: name,
+		Quantity:       quantity,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_address"}, {Name: "crop_animal_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"quantity":         gorm.Expr("quantity + ?", quantity),
+			"crop_animal_name": name,
+			"update_time":      now,
+		}),
+	}).Create(produce).Error
+}
+
+// GetUserProduce 获取用户的收获物库存
+func (dao *Dao) GetUserProduce(ctx context.Context, address string) ([]*UserProduce, error) {
+	var items []*UserProduce
+	err := dao.DB.WithContext(ctx).Where("user_address = ? AND quantity > 0", address).Order("crop_animal_id").Find(&items).Error
+	return items, err
+}
+
+// LockUserProduce 在事务中锁定用户某种收获物的库存行
+func (dao *Dao) LockUserProduce(ctx context.Context, tx *gorm.DB, address string, cropAnimalID uint64) (*UserProduce, error) {
+	var produce UserProduce
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_address = ? AND crop_animal_id = ?", address, cropAnimalID).First(&produce).Error
+	return &produce, err
+}
+
+// DeductUserProduce 扣减已锁定的收获物库存
+func (dao *Dao) DeductUserProduce(ctx context.Context, tx *gorm.DB, produceID uint64, quantity int64) error {
+	if tx == nil {
+		tx = dao.DB
+	}
+	return tx.WithContext(ctx).Model(&UserProduce{}).Where("id = ?", produceID).UpdateColumns(map[string]interface{}{
+		"quantity":    gorm.Expr("quantity - ?", quantity),
+		"update_time": time.Now(),
+	}).Error
+}
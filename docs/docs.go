@@ -0,0 +1,5401 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "contact": {},
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/api/v1/catalog/admin/create": {
+            "post": {
+                "description": "新增作物/动物目录条目, 名称不能重复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "创建作物/动物(管理)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理API Key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "作物/动物",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CropAnimalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.CropAnimal"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/catalog/admin/delete": {
+            "post": {
+                "description": "删除作物/动物目录条目, 已有的种植记录保留名称; 临时下架请使用更新接口将enabled置为false",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "删除作物/动物(管理)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理API Key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "删除请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DeleteCropAnimalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/catalog/admin/list": {
+            "get": {
+                "description": "获取包括未上架条目在内的全部作物/动物",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "获取全部作物/动物(管理)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理API Key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1
+                        ],
+                        "type": "integer",
+                        "description": "类型(0-作物,1-动物), 不传表示全部",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.CropAnimal"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/catalog/admin/update": {
+            "post": {
+                "description": "更新作物/动物目录条目, 只影响之后的种植, 已开始的活动不变",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "更新作物/动物(管理)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理API Key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "作物/动物",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCropAnimalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.CropAnimal"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/catalog/list": {
+            "get": {
+                "description": "获取上架的作物/动物及其种植规则: 生长时长、每平方米肥力消耗、适宜地形与分区、可种植季节及解锁等级",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "获取作物/动物目录",
+                "parameters": [
+                    {
+                        "enum": [
+                            0,
+                            1
+                        ],
+                        "type": "integer",
+                        "description": "类型(0-作物,1-动物), 不传表示全部",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.CropAnimal"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/stream": {
+            "get": {
+                "description": "以text/event-stream推送当前用户的事件, 事件id即事件ID, event为事件类型; 浏览器重连时会自动携带Last-Event-ID补发断线期间的事件",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "订阅实时事件(SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "会话令牌, 也可使用session_token Cookie",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "最后收到的事件ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "最后收到的事件ID, 优先使用Last-Event-ID请求头",
+                        "name": "lastEventId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/events.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/ws": {
+            "get": {
+                "description": "升级为WebSocket后推送当前用户的作物成熟/枯萎、土地售出、租赁及余额变动事件, 每条文本消息为一个JSON事件; 传入lastEventId可补发断线期间的事件",
+                "tags": [
+                    "events"
+                ],
+                "summary": "订阅实时事件(WebSocket)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "会话令牌, 也可使用session_token Cookie",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "最后收到的事件ID",
+                        "name": "lastEventId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "$ref": "#/definitions/events.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/activity/clear": {
+            "post": {
+                "description": "土地所有者清理土地上超过收获宽限期而枯萎的作物, 释放占用的面积, 返回清理的数量",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "清理枯萎作物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "清理枯萎作物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ClearDeadCropsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "integer"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/activity/harvest": {
+            "post": {
+                "description": "收获成熟的作物, 产量由目录基础产量、面积、土地等级、种植时肥力、稀有度、特殊效果及布局加成决定, 收获物计入库存并获得经验值",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "收获作物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "收获作物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.HarvestCropRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.HarvestCropResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/activity/plant": {
+            "post": {
+                "description": "在分区中种植作物或养殖动物, 作物只能种植在种植区, 动物只能养殖在养殖区, 种植面积不超过分区剩余面积. 作物/动物须在目录中上架, 并满足解锁等级、适宜地形、分区类型及季节, 生长时长与每平方米肥力消耗由目录决定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "种植作物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "种植作物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PlantCropRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.PlantCropResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/animal/breed": {
+            "post": {
+                "description": "已成年且健康值达到要求的动物繁殖幼崽, 消耗亲代健康值及幼崽占用面积对应的土地肥力, 受冷却时间限制. 幼崽作为新的养殖活动放入同一分区, 成年后才能产出和繁殖; 分区剩余面积不足或不满足条件时返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "繁殖动物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "繁殖动物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AnimalActionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.BreedAnimalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/animal/collect": {
+            "post": {
+                "description": "动物成年后按目录中的周期产出鸡蛋、牛奶等产物, 产量按收获规则计算并按健康值修正, 产物计入库存并获得经验值, 收集后开始下一周期. 产物未就绪或动物已饿死返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "收集动物产物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "收集产物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AnimalActionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CollectProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/animal/feed": {
+            "post": {
+                "description": "使用饲料道具或目录允许的作物收获物喂养动物, 二者选一. 饲料道具每次消耗一次并按效果值恢复健康值, 作物按数量恢复. 健康值随时间下降, 降到0时动物饿死; 饲料不符、健康值已满或动物已饿死返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "喂养动物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "喂养动物请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.FeedAnimalRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.BreedingAnimal"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/batch/harvest": {
+            "post": {
+                "description": "收获当前用户所有土地上已成熟的作物, 每次最多处理100项, hasMore为true时可再次调用; replant为true时在原分区补种同一作物. 每项独立成功或失败, 失败原因见各项的error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "一键收获",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "一键收获请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BatchHarvestRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.BatchFarmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/batch/replant": {
+            "post": {
+                "description": "在已收获活动的原分区重新种植同一作物, 面积与原活动相同, 一次最多100项. 每项独立成功或失败, 不满足种植条件、肥力或分区剩余面积不足的项失败原因见各项的error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "批量补种",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "批量补种请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BatchReplantRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.BatchFarmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/fertilize": {
+            "post": {
+                "description": "消耗肥料道具(item_type=1), 每次使用恢复道具Power点肥力, 不超过肥力上限. 肥力平时按时间自动恢复, 恢复速度受稀有度、特殊效果及布局加成影响",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "使用肥料恢复土地肥力",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "施肥请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.FertilizeLandRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.LandInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/layout/preview": {
+            "post": {
+                "description": "按更新布局的规则校验分区, 返回各分区按相邻规则计算出的加成, 不保存. 新分区的id为0; 布局不合法时返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "预览土地布局",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "预览布局请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateLandLayoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LandLayoutResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/layout/update": {
+            "post": {
+                "description": "整体替换土地的分区布局: 带id的分区更新, 不带id的新建, 未列出的已有分区删除. 土地视为边长⌊√面积⌋的正方形网格, 分区须在网格内、互不重叠且面积等于宽×高; 有生长中或枯萎未清理作物的分区不能修改或删除, 不满足时返回400. 保存后按相邻规则重新计算各分区加成",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "更新土地布局",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "更新布局请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateLandLayoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LandLayoutResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/list": {
+            "get": {
+                "description": "分页获取当前登录用户的土地信息, sort可选id、level、rarity、fertility、area、createTime. 按肥力排序及返回值均为当前肥力",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取用户所有土地",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一页返回的next_cursor, 首页为空",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2
+                        ],
+                        "type": "integer",
+                        "description": "地形类型(0-平原,1-湿地,2-山地)",
+                        "name": "landType",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "每页条数, 默认20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最高等级",
+                        "name": "maxLevel",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最低等级",
+                        "name": "minLevel",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2,
+                            3
+                        ],
+                        "type": "integer",
+                        "description": "稀有度(0-普通,1-稀有,2-史诗,3-传说)",
+                        "name": "rarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序字段, 取值见各接口说明",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.LandInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/market/buy": {
+            "post": {
+                "description": "从市场购买土地, 链服务不可用(降级模式)时返回503",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "购买土地",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "购买土地请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BuyLandRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/market/list": {
+            "get": {
+                "description": "分页获取市场上待出售的土地, 默认按价格升序, sort可选id、price、area、listingTime",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地挂牌列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一页返回的next_cursor, 首页为空",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "每页条数, 默认20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最大面积",
+                        "name": "maxArea",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "最高售价",
+                        "name": "maxPrice",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最小面积",
+                        "name": "minArea",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "最低售价",
+                        "name": "minPrice",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序字段, 取值见各接口说明",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.LandMarket"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/plot/fertilize": {
+            "post": {
+                "description": "给地块施肥, 消耗一次肥料道具提高肥料等级, 肥料等级越高预计产量越高. 受冷却时间限制, 道具不符或冷却中返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "地块施肥",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "照料地块请求, itemTokenId为肥料道具",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CarePlotRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.PlotPlanting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/plot/pesticide": {
+            "post": {
+                "description": "给地块除虫, 消耗一次杀虫剂道具降低虫害. 虫害随时间增长, 达到100时无法收获. 受冷却时间限制, 道具不符或冷却中返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "除虫",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "照料地块请求, itemTokenId为杀虫剂道具",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CarePlotRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.PlotPlanting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/plot/water": {
+            "post": {
+                "description": "给地块浇水, 消耗一次水壶道具增加水分. 水分随时间流失, 为0时无法收获. 受冷却时间限制, 道具不符或冷却中返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "浇水",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "照料地块请求, itemTokenId为水壶道具",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CarePlotRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.PlotPlanting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/produce/list": {
+            "get": {
+                "description": "获取当前用户收获的作物/动物库存",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取收获物库存",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.UserProduce"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/rent/cancel": {
+            "post": {
+                "description": "取消土地租赁订单",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "取消土地租赁",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "取消租赁请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CancelRentalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/rent/create": {
+            "post": {
+                "description": "创建土地租赁订单, 链服务不可用(降级模式)时返回503",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "创建土地租赁订单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "创建租赁请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateRentRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RentLandResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/rent/list": {
+            "post": {
+                "description": "分页获取当前用户的租赁订单, sort可选id、rentalEndTime、rentalStartTime、totalRent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取租赁订单列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一页返回的next_cursor, 首页为空",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT唯一标识",
+                        "name": "landTokenId",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "每页条数, 默认20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            7,
+                            14,
+                            30
+                        ],
+                        "type": "integer",
+                        "description": "租赁时长(天)",
+                        "name": "rentalDuration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序字段, 取值见各接口说明",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.LandRental"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/search": {
+            "get": {
+                "description": "按地形、稀有度、等级、肥力、面积、特殊效果、出售/租赁状态及售价筛选全部土地, sort可选id、level、rarity、fertility、area、createTime、price(按价格排序时只返回待出售的土地). 肥力按当前肥力筛选和排序. 首页(cursor为空)返回按稀有度、地形类型的分面统计, 每一项统计时忽略该项自身的筛选条件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "搜索土地",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一页返回的next_cursor, 首页为空",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否租赁中",
+                        "name": "forRent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否待出售",
+                        "name": "forSale",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2
+                        ],
+                        "type": "integer",
+                        "description": "地形类型(0-平原,1-湿地,2-山地)",
+                        "name": "landType",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "每页条数, 默认20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最大面积",
+                        "name": "maxArea",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "最高肥力, 按当前肥力比较",
+                        "name": "maxFertility",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最高等级",
+                        "name": "maxLevel",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "最高售价, 只匹配待出售的土地",
+                        "name": "maxPrice",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最小面积",
+                        "name": "minArea",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "最低肥力, 按当前肥力比较",
+                        "name": "minFertility",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最低等级",
+                        "name": "minLevel",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "最低售价, 只匹配待出售的土地",
+                        "name": "minPrice",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2,
+                            3
+                        ],
+                        "type": "integer",
+                        "description": "稀有度(0-普通,1-稀有,2-史诗,3-传说)",
+                        "name": "rarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序字段, 取值见各接口说明",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "description": "特殊效果(如\"湿润土地\"、\"黄金土地\")",
+                        "name": "specialEffect",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.SearchLandsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/upgrade": {
+            "post": {
+                "description": "将土地升级到下一等级, 按土地稀有度与地形匹配升级规则, 升级后面积、肥力上限、产量倍率提升并可能解锁新的分区类型. 消耗的MFG与道具在同一事务中扣减, 不足时返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "升级土地",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "升级土地请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpgradeLandRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.LandUpgrade"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/upgrade/cancel": {
+            "post": {
+                "description": "取消施工中的升级, 按配置比例退还MFG, 已消耗的道具不退还",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "取消升级施工",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "取消升级请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CancelUpgradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.LandUpgrade"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/upgrade/queue": {
+            "get": {
+                "description": "获取当前用户施工中的土地升级, 按完工时间升序",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取升级施工队列",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.LandUpgrade"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/upgrade/speedup": {
+            "post": {
+                "description": "指定itemTokenId时使用加速道具(每次缩短道具Power分钟), 否则按剩余分钟支付MFG立即完成",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "加速升级施工",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "加速升级请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SpeedUpUpgradeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "幂等键",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dao.LandUpgrade"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/animals": {
+            "get": {
+                "description": "获取土地上存活的养殖动物及其健康值、饿死时间、下次可收集产物的时间和代数, 健康值按当前时间计算. 土地不存在时返回404",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地上的养殖动物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.BreedingAnimal"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/detail": {
+            "get": {
+                "description": "根据tokenID获取土地属性及当前肥力、分区、生长中的活动及距成熟的剩余时间、生效的租赁、待出售的挂牌和最近一次完成的升级. 结果缓存在redis中, 土地相关的写操作后立即失效. 土地不存在时返回404",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地详细信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LandDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/history": {
+            "get": {
+                "description": "按时间倒序分页获取土地的铸造、升级、挂牌、成交(所有权转移)、租赁及种植养殖记录, sort仅支持time. 土地不存在时返回404",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地历史时间线",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一页返回的next_cursor, 首页为空",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "每页条数, 默认20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序字段, 取值见各接口说明",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.LandHistoryEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/layout": {
+            "get": {
+                "description": "获取土地的全部分区及其相邻加成. 土地不存在时返回404",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地布局",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LandLayoutResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/plots": {
+            "get": {
+                "description": "获取土地上正在种植的地块及其水分、肥料、虫害、预计产量和是否可收获, 按当前时间计算. 土地不存在时返回404",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取土地上的地块",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.PlotPlanting"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/land/{tokenID}/zones": {
+            "get": {
+                "description": "获取土地各分区的面积、被生长中及枯萎未清理的活动占用的面积和剩余可种植面积. 土地不存在时返回404",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "land"
+                ],
+                "summary": "获取分区面积占用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户钱包地址",
+                        "name": "user_address",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "土地NFT TokenID",
+                        "name": "tokenID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dao.ZoneOccupancy"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/login": {
+            "post": {
+                "description": "验证钱包签名并创建会话",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "签名登录",
+                "parameters": [
+                    {
+                        "description": "签名登录请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/login/message": {
+            "post": {
+                "description": "生成待钱包签名的登录消息和随机数",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "获取登录消息",
+                "parameters": [
+                    {
+                        "description": "获取登录消息请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginMessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/logout": {
+            "post": {
+                "description": "吊销当前会话令牌",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "注销",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer 会话令牌",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "进程存活即返回200, 不检查外部依赖",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "存活检查",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "检查MySQL、Redis及L1/L2区块新鲜度, 核心依赖不可用时返回503",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "就绪检查",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/health.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/middleware.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/health.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dao.BreedingAnimal": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "description": "养殖活动ID",
+                    "type": "integer"
+                },
+                "animal_id": {
+                    "description": "动物ID",
+                    "type": "integer"
+                },
+                "animal_name": {
+                    "description": "动物名称",
+                    "type": "string"
+                },
+                "collect_count": {
+                    "description": "已收集产物次数",
+                    "type": "integer"
+                },
+                "create_time": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "generation": {
+                    "description": "代数, 放养的为第1代",
+                    "type": "integer"
+                },
+                "health": {
+                    "description": "健康值, 不喂养时随时间下降",
+                    "type": "integer"
+                },
+                "health_settled_at": {
+                    "description": "健康值结算时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "is_alive": {
+                    "description": "是否存活(0:否, 1:是), 饿死后为0",
+                    "type": "integer"
+                },
+                "land_token_id": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "last_breed_time": {
+                    "description": "最后繁殖时间",
+                    "type": "string"
+                },
+                "last_feed_time": {
+                    "description": "最后喂养时间",
+                    "type": "string"
+                },
+                "next_collect_time": {
+                    "description": "下次可收集产物的时间",
+                    "type": "string"
+                },
+                "owner_address": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "parent_activity_id": {
+                    "description": "繁殖出该群动物的养殖活动ID, 放养的为0",
+                    "type": "integer"
+                },
+                "starve_time": {
+                    "description": "不再喂养时的饿死时间",
+                    "type": "string"
+                },
+                "update_time": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "zone_id": {
+                    "description": "所在分区ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "dao.CropAnimal": {
+            "type": "object",
+            "properties": {
+                "base_yield": {
+                    "description": "每平方米基础产量",
+                    "type": "number"
+                },
+                "create_time": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "cycle_minutes": {
+                    "description": "动物成年后的产物周期(分钟), 为0时与生长时长相同",
+                    "type": "integer"
+                },
+                "enabled": {
+                    "description": "是否上架",
+                    "type": "boolean"
+                },
+                "feed_crop_ids": {
+                    "description": "可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具",
+                    "type": "string"
+                },
+                "fertility_per_sqm": {
+                    "description": "每平方米消耗肥力",
+                    "type": "integer"
+                },
+                "growth_minutes": {
+                    "description": "生长时长(分钟)",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "主键ID, 即作物/动物ID",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "类型, 与活动类型一致(0-作物,1-动物)",
+                    "type": "integer"
+                },
+                "land_types": {
+                    "description": "适宜地形(0-平原,1-湿地,2-山地)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string"
+                },
+                "offspring_area": {
+                    "description": "动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖",
+                    "type": "integer"
+                },
+                "product_name": {
+                    "description": "动物产物名称, 如鸡蛋、牛奶",
+                    "type": "string"
+                },
+                "seasons": {
+                    "description": "可种植季节(0-春,1-夏,2-秋,3-冬)",
+                    "type": "string"
+                },
+                "unlock_level": {
+                    "description": "解锁所需土地等级",
+                    "type": "integer"
+                },
+                "update_time": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "wither_minutes": {
+                    "description": "作物成熟后未收获多久枯萎(分钟), 为0时使用全局配置",
+                    "type": "integer"
+                },
+                "wither_refund": {
+                    "description": "枯萎时退还种植所耗肥力的比例, 为0时全部损失",
+                    "type": "number"
+                },
+                "zone_types": {
+                    "description": "适宜分区类型(0-种植区,1-养殖区,2-装饰区)",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.LandHistoryEntry": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "发起方地址: 升级/挂牌/种植为所有者, 成交为买家, 租赁为租客",
+                    "type": "string"
+                },
+                "amount": {
+                    "description": "金额: 升级消耗、挂牌/成交价格、租金",
+                    "type": "number"
+                },
+                "counterparty": {
+                    "description": "对手方地址: 成交为卖家, 租赁为所有者",
+                    "type": "string"
+                },
+                "event_time": {
+                    "description": "事件时间",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "事件类型(mint/upgrade/listing/sale/rental/activity)",
+                    "type": "string"
+                },
+                "new_level": {
+                    "description": "升级后等级",
+                    "type": "integer"
+                },
+                "old_level": {
+                    "description": "升级前等级",
+                    "type": "integer"
+                },
+                "ref_id": {
+                    "description": "来源记录ID",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "来源记录状态",
+                    "type": "integer"
+                },
+                "subject": {
+                    "description": "作物/动物名称",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.LandInfo": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "土地面积(㎡)",
+                    "type": "integer"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "fertility": {
+                    "description": "土地肥力值(0-肥力上限), 为上次结算时的值",
+                    "type": "integer"
+                },
+                "fertilityCap": {
+                    "description": "肥力上限, 随等级提升",
+                    "type": "integer"
+                },
+                "fertilityUpdateTime": {
+                    "description": "肥力结算时间, 之后的恢复在读取时按时间计算, 为空时以更新时间为准",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "landTokenID": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "landType": {
+                    "description": "地形类型(0-平原,1-湿地,2-山地)",
+                    "type": "integer"
+                },
+                "lastHarvestTime": {
+                    "description": "最后收获时间",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "level": {
+                    "description": "土地等级(1-10级)",
+                    "type": "integer"
+                },
+                "metadataURI": {
+                    "description": "元数据URI",
+                    "type": "string"
+                },
+                "ownerAddress": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "rarity": {
+                    "description": "稀有度(0-普通,1-稀有,2-史诗,3-传说)",
+                    "type": "integer"
+                },
+                "specialEffect": {
+                    "description": "特殊效果(如\"湿润土地\"、\"黄金土地\")",
+                    "type": "string"
+                },
+                "unlockedZones": {
+                    "description": "已解锁的分区类型(逗号分隔, 0-种植区,1-养殖区,2-装饰区)",
+                    "type": "string"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "upgradeCompleteTime": {
+                    "description": "升级施工完成时间, 为空表示未在施工",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "yieldMultiplier": {
+                    "description": "产量倍率, 随等级提升",
+                    "type": "number"
+                }
+            }
+        },
+        "dao.LandMarket": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "土地面积(㎡)",
+                    "type": "integer"
+                },
+                "buyerAddress": {
+                    "description": "买家钱包地址",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "landTokenID": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "listingTime": {
+                    "description": "挂牌时间",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "售价",
+                    "type": "number"
+                },
+                "sellerAddress": {
+                    "description": "卖家钱包地址",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "状态(0-待出售,1-已售出,2-已取消)",
+                    "type": "integer"
+                },
+                "transactionTime": {
+                    "description": "交易完成时间",
+                    "type": "string"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.LandRental": {
+            "type": "object",
+            "properties": {
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "landTokenID": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "ownerAddress": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "rentPerSqmPerDay": {
+                    "description": "每平方米日租金",
+                    "type": "number"
+                },
+                "rentalDuration": {
+                    "description": "租期(天，7/14/30)",
+                    "type": "integer"
+                },
+                "rentalEndTime": {
+                    "description": "租赁结束时间",
+                    "type": "string"
+                },
+                "rentalStartTime": {
+                    "description": "租赁开始时间",
+                    "type": "string"
+                },
+                "renterAddress": {
+                    "description": "租客钱包地址",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "状态(0-待确认,1-租赁中,2-已结束,3-已取消)",
+                    "type": "integer"
+                },
+                "systemFee": {
+                    "description": "系统手续费(5%)",
+                    "type": "number"
+                },
+                "totalRent": {
+                    "description": "总租金",
+                    "type": "number"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.LandUpgrade": {
+            "type": "object",
+            "properties": {
+                "completeTime": {
+                    "description": "预计完工时间",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "costItems": {
+                    "description": "消耗道具列表(JSON格式)",
+                    "type": "object"
+                },
+                "costTokens": {
+                    "description": "消耗代币数量",
+                    "type": "integer"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "finishTime": {
+                    "description": "实际完成或取消时间",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "landTokenID": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "newLevel": {
+                    "description": "升级后等级",
+                    "type": "integer"
+                },
+                "oldLevel": {
+                    "description": "升级前等级",
+                    "type": "integer"
+                },
+                "ownerAddress": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "refundTokens": {
+                    "description": "取消时退还的代币数量",
+                    "type": "integer"
+                },
+                "ruleID": {
+                    "description": "升级规则ID",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态(1-施工中,2-已完成,3-已取消)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "upgradeTime": {
+                    "description": "开始升级时间",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.PlotPlanting": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "description": "种植活动ID",
+                    "type": "integer"
+                },
+                "care_settled_at": {
+                    "description": "水分结算时间",
+                    "type": "string"
+                },
+                "create_time": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "crop_id": {
+                    "description": "作物ID",
+                    "type": "integer"
+                },
+                "crop_name": {
+                    "description": "作物名称",
+                    "type": "string"
+                },
+                "expected_yield": {
+                    "description": "预计产量",
+                    "type": "integer"
+                },
+                "fertilizer_level": {
+                    "description": "肥料等级",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "is_harvestable": {
+                    "description": "是否可收获(0:否, 1:是)",
+                    "type": "integer"
+                },
+                "is_planted": {
+                    "description": "是否已种植(0:否, 1:是)",
+                    "type": "integer"
+                },
+                "land_token_id": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "last_fertilize_time": {
+                    "description": "最后施肥时间",
+                    "type": "string"
+                },
+                "last_pesticide_time": {
+                    "description": "最后除虫时间",
+                    "type": "string"
+                },
+                "last_water_time": {
+                    "description": "最后浇水时间",
+                    "type": "string"
+                },
+                "pest_level": {
+                    "description": "虫害等级",
+                    "type": "integer"
+                },
+                "pest_settled_at": {
+                    "description": "虫害结算时间, 为空时以水分结算时间为准",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "planted_at": {
+                    "description": "种植时间",
+                    "type": "string"
+                },
+                "plot_id": {
+                    "description": "地块ID, 与种植活动ID一致",
+                    "type": "integer"
+                },
+                "update_time": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "water_level": {
+                    "description": "水分等级",
+                    "type": "integer"
+                },
+                "zone_id": {
+                    "description": "所在分区ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "dao.UserProduce": {
+            "type": "object",
+            "properties": {
+                "create_time": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "crop_animal_id": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                },
+                "crop_animal_name": {
+                    "description": "作物/动物名称",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "库存数量",
+                    "type": "integer"
+                },
+                "update_time": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "user_address": {
+                    "description": "用户钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "dao.ZoneOccupancy": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "分区面积(㎡)",
+                    "type": "integer"
+                },
+                "free_area": {
+                    "description": "剩余可用面积(㎡)",
+                    "type": "integer"
+                },
+                "occupied_area": {
+                    "description": "生长中及枯萎未清理的活动占用的面积(㎡)",
+                    "type": "integer"
+                },
+                "zone_id": {
+                    "description": "分区ID",
+                    "type": "integer"
+                },
+                "zone_type": {
+                    "description": "分区类型(0-种植区,1-养殖区,2-装饰区)",
+                    "type": "integer"
+                }
+            }
+        },
+        "events.Event": {
+            "type": "object",
+            "properties": {
+                "createTime": {
+                    "description": "事件时间(unix毫秒)",
+                    "type": "integer"
+                },
+                "data": {
+                    "description": "事件内容",
+                    "type": "object"
+                },
+                "id": {
+                    "description": "用户内递增的事件ID, 作为Last-Event-ID",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "事件类型",
+                    "type": "string"
+                },
+                "userAddress": {
+                    "description": "接收用户钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "health.DependencyStatus": {
+            "type": "object",
+            "properties": {
+                "blockAgeSec": {
+                    "description": "最新区块距今秒数(仅链依赖)",
+                    "type": "integer"
+                },
+                "blockNumber": {
+                    "description": "最新区块高度(仅链依赖)",
+                    "type": "integer"
+                },
+                "critical": {
+                    "description": "是否为核心依赖(核心依赖不可用时服务不可用)",
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "错误信息",
+                    "type": "string"
+                },
+                "latencyMs": {
+                    "description": "检查耗时(毫秒)",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态(up/down)",
+                    "type": "string"
+                }
+            }
+        },
+        "health.Report": {
+            "type": "object",
+            "properties": {
+                "chainEnabled": {
+                    "description": "链相关功能是否可用",
+                    "type": "boolean"
+                },
+                "checkedAt": {
+                    "description": "检查时间",
+                    "type": "string"
+                },
+                "dependencies": {
+                    "description": "各依赖检查结果",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.DependencyStatus"
+                    }
+                },
+                "status": {
+                    "description": "整体状态(ok/degraded/down)",
+                    "type": "string"
+                }
+            }
+        },
+        "middleware.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "has_more": {
+                    "description": "是否还有更多数据, 仅分页接口返回",
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "下一页游标, 仅分页接口返回",
+                    "type": "string"
+                }
+            }
+        },
+        "request.AnimalActionRequest": {
+            "type": "object",
+            "required": [
+                "activityId",
+                "userAddress"
+            ],
+            "properties": {
+                "activityId": {
+                    "description": "养殖活动ID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.BatchHarvestRequest": {
+            "type": "object",
+            "required": [
+                "userAddress"
+            ],
+            "properties": {
+                "replant": {
+                    "description": "收获后是否在原分区重新种植同一作物",
+                    "type": "boolean"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.BatchReplantRequest": {
+            "type": "object",
+            "required": [
+                "activityIds",
+                "userAddress"
+            ],
+            "properties": {
+                "activityIds": {
+                    "description": "已收获的种植活动ID",
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.BuyLandRequest": {
+            "type": "object",
+            "required": [
+                "buyerAddress",
+                "listingId",
+                "marketId"
+            ],
+            "properties": {
+                "buyerAddress": {
+                    "description": "买家钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                },
+                "listingId": {
+                    "description": "挂牌ID",
+                    "type": "integer"
+                },
+                "marketId": {
+                    "description": "市场ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "request.CancelRentalRequest": {
+            "type": "object",
+            "required": [
+                "rentalId",
+                "userAddress"
+            ],
+            "properties": {
+                "rentalId": {
+                    "description": "租赁订单ID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.CancelUpgradeRequest": {
+            "type": "object",
+            "required": [
+                "upgradeId",
+                "userAddress"
+            ],
+            "properties": {
+                "upgradeId": {
+                    "description": "升级记录ID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.CarePlotRequest": {
+            "type": "object",
+            "required": [
+                "activityId",
+                "itemTokenId",
+                "userAddress"
+            ],
+            "properties": {
+                "activityId": {
+                    "description": "种植活动ID",
+                    "type": "integer"
+                },
+                "itemTokenId": {
+                    "description": "水壶、肥料或杀虫剂道具TokenID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.ClearDeadCropsRequest": {
+            "type": "object",
+            "required": [
+                "landTokenId",
+                "userAddress"
+            ],
+            "properties": {
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.CreateRentRequest": {
+            "type": "object",
+            "required": [
+                "landTokenId",
+                "rentPerSqm",
+                "rentalDuration",
+                "renterAddress",
+                "userAddress"
+            ],
+            "properties": {
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "rentPerSqm": {
+                    "description": "每平方米租金",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "rentalDuration": {
+                    "description": "租赁时长(天)",
+                    "type": "integer",
+                    "enum": [
+                        7,
+                        14,
+                        30
+                    ]
+                },
+                "renterAddress": {
+                    "description": "租户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                },
+                "userAddress": {
+                    "description": "操作人钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.CropAnimalRequest": {
+            "type": "object",
+            "required": [
+                "growthMinutes",
+                "name"
+            ],
+            "properties": {
+                "baseYield": {
+                    "description": "每平方米基础产量",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "cycleMinutes": {
+                    "description": "动物成年后的产物周期(分钟), 为0时与生长时长相同",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "enabled": {
+                    "description": "是否上架, 创建时默认true, 更新时不传表示不变",
+                    "type": "boolean"
+                },
+                "feedCropIds": {
+                    "description": "可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具",
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "fertilityPerSqm": {
+                    "description": "每平方米消耗肥力",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "growthMinutes": {
+                    "description": "生长时长(分钟)",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "kind": {
+                    "description": "类型(0-作物,1-动物)",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1
+                    ]
+                },
+                "landTypes": {
+                    "description": "适宜地形(0-平原,1-湿地,2-山地), 为空表示不限",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "offspringArea": {
+                    "description": "动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "productName": {
+                    "description": "动物产物名称, 为空时使用动物名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "seasons": {
+                    "description": "可种植季节(0-春,1-夏,2-秋,3-冬), 为空表示全年",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "unlockLevel": {
+                    "description": "解锁所需土地等级, 默认1",
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "witherMinutes": {
+                    "description": "成熟后未收获多久枯萎(分钟), 为0时使用全局配置",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "witherRefund": {
+                    "description": "枯萎时退还种植所耗肥力的比例, 为0时全部损失",
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "zoneTypes": {
+                    "description": "适宜分区类型(0-种植区,1-养殖区,2-装饰区), 为空表示不限",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "request.DeleteCropAnimalRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "request.FeedAnimalRequest": {
+            "type": "object",
+            "required": [
+                "activityId",
+                "userAddress"
+            ],
+            "properties": {
+                "activityId": {
+                    "description": "养殖活动ID",
+                    "type": "integer"
+                },
+                "feedCropId": {
+                    "description": "用作饲料的作物ID, 从收获物库存中扣减",
+                    "type": "integer"
+                },
+                "itemTokenId": {
+                    "description": "饲料道具TokenID, 每次消耗一次",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "作物饲料数量, 默认1",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.FertilizeLandRequest": {
+            "type": "object",
+            "required": [
+                "itemTokenId",
+                "landTokenId",
+                "userAddress"
+            ],
+            "properties": {
+                "itemTokenId": {
+                    "description": "肥料道具TokenID",
+                    "type": "integer"
+                },
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                },
+                "uses": {
+                    "description": "使用次数, 默认1",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.HarvestCropRequest": {
+            "type": "object",
+            "required": [
+                "activityId",
+                "userAddress"
+            ],
+            "properties": {
+                "activityId": {
+                    "description": "活动ID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "request.LayoutZoneReq": {
+            "type": "object",
+            "required": [
+                "area",
+                "height",
+                "width"
+            ],
+            "properties": {
+                "area": {
+                    "description": "区域面积, 须等于宽×高",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "height": {
+                    "description": "区域高度",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "id": {
+                    "description": "区域ID, 修改已有分区时传入, 不传表示新建",
+                    "type": "integer"
+                },
+                "positionX": {
+                    "description": "X坐标位置",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "positionY": {
+                    "description": "Y坐标位置",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "width": {
+                    "description": "区域宽度",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "zoneType": {
+                    "description": "区域类型(0-种植区,1-养殖区,2-装饰区)",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "request.LoginMessageRequest": {
+            "type": "object",
+            "required": [
+                "wallet_address"
+            ],
+            "properties": {
+                "wallet_address": {
+                    "description": "钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "request.LoginRequest": {
+            "type": "object",
+            "required": [
+                "nonce",
+                "signature",
+                "wallet_address"
+            ],
+            "properties": {
+                "nonce": {
+                    "description": "登录消息中的随机数",
+                    "type": "string"
+                },
+                "signature": {
+                    "description": "对登录消息的签名",
+                    "type": "string"
+                },
+                "wallet_address": {
+                    "description": "钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "request.PlantCropRequest": {
+            "type": "object",
+            "required": [
+                "area",
+                "cropAnimalId",
+                "landTokenId",
+                "userAddress",
+                "zoneId"
+            ],
+            "properties": {
+                "area": {
+                    "description": "种植面积",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "cropAnimalId": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                },
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                },
+                "zoneId": {
+                    "description": "区域ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "request.SpeedUpUpgradeRequest": {
+            "type": "object",
+            "required": [
+                "upgradeId",
+                "userAddress"
+            ],
+            "properties": {
+                "itemTokenId": {
+                    "description": "加速道具TokenID",
+                    "type": "integer"
+                },
+                "upgradeId": {
+                    "description": "升级记录ID",
+                    "type": "integer"
+                },
+                "userAddress": {
+                    "description": "用户钱包地址",
+                    "type": "string",
+                    "maxLength": 42
+                },
+                "uses": {
+                    "description": "道具使用次数, 默认1",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.UpdateCropAnimalRequest": {
+            "type": "object",
+            "required": [
+                "growthMinutes",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "baseYield": {
+                    "description": "每平方米基础产量",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "cycleMinutes": {
+                    "description": "动物成年后的产物周期(分钟), 为0时与生长时长相同",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "enabled": {
+                    "description": "是否上架, 创建时默认true, 更新时不传表示不变",
+                    "type": "boolean"
+                },
+                "feedCropIds": {
+                    "description": "可作为饲料喂养动物的作物ID, 为空时只能使用饲料道具",
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "fertilityPerSqm": {
+                    "description": "每平方米消耗肥力",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "growthMinutes": {
+                    "description": "生长时长(分钟)",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "id": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "类型(0-作物,1-动物)",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1
+                    ]
+                },
+                "landTypes": {
+                    "description": "适宜地形(0-平原,1-湿地,2-山地), 为空表示不限",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "offspringArea": {
+                    "description": "动物每次繁殖的幼崽占用面积(㎡), 为0时不可繁殖",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "productName": {
+                    "description": "动物产物名称, 为空时使用动物名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "seasons": {
+                    "description": "可种植季节(0-春,1-夏,2-秋,3-冬), 为空表示全年",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "unlockLevel": {
+                    "description": "解锁所需土地等级, 默认1",
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "witherMinutes": {
+                    "description": "成熟后未收获多久枯萎(分钟), 为0时使用全局配置",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "witherRefund": {
+                    "description": "枯萎时退还种植所耗肥力的比例, 为0时全部损失",
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "zoneTypes": {
+                    "description": "适宜分区类型(0-种植区,1-养殖区,2-装饰区), 为空表示不限",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "request.UpdateLandLayoutRequest": {
+            "type": "object",
+            "required": [
+                "tokenId",
+                "userAddress"
+            ],
+            "properties": {
+                "tokenId": {
+                    "description": "土地NFT ID",
+                    "type": "string"
+                },
+                "userAddress": {
+                    "description": "用户地址",
+                    "type": "string"
+                },
+                "zones": {
+                    "description": "分区列表, 为空表示清空布局",
+                    "type": "array",
+                    "maxItems": 64,
+                    "items": {
+                        "$ref": "#/definitions/request.LayoutZoneReq"
+                    }
+                }
+            }
+        },
+        "request.UpgradeLandRequest": {
+            "type": "object",
+            "required": [
+                "landTokenId",
+                "level",
+                "userAddress"
+            ],
+            "properties": {
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "升级目标等级(须为当前等级+1)",
+                    "type": "integer",
+                    "minimum": 2
+                },
+                "userAddress": {
+                    "description": "用户钱包地址(42位)",
+                    "type": "string",
+                    "maxLength": 42
+                }
+            }
+        },
+        "response.BatchFarmItem": {
+            "type": "object",
+            "properties": {
+                "activityId": {
+                    "description": "原活动ID",
+                    "type": "integer"
+                },
+                "cropName": {
+                    "description": "作物名称",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "失败原因, 为空表示成功",
+                    "type": "string"
+                },
+                "experience": {
+                    "description": "收获获得的经验值",
+                    "type": "integer"
+                },
+                "harvested": {
+                    "description": "是否已收获",
+                    "type": "boolean"
+                },
+                "landTokenId": {
+                    "description": "土地NFT TokenID",
+                    "type": "string"
+                },
+                "newActivityId": {
+                    "description": "补种创建的活动ID",
+                    "type": "integer"
+                },
+                "replanted": {
+                    "description": "是否已补种",
+                    "type": "boolean"
+                },
+                "yield": {
+                    "description": "收获产量",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.BatchFarmResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "description": "失败的项数",
+                    "type": "integer"
+                },
+                "hasMore": {
+                    "description": "是否还有未处理的成熟作物, 为true时可再次调用",
+                    "type": "boolean"
+                },
+                "items": {
+                    "description": "各项结果",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.BatchFarmItem"
+                    }
+                },
+                "succeeded": {
+                    "description": "成功的项数",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.BreedAnimalResponse": {
+            "type": "object",
+            "properties": {
+                "offspring": {
+                    "description": "新出生的幼崽",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dao.BreedingAnimal"
+                        }
+                    ]
+                },
+                "parent": {
+                    "description": "繁殖后的亲代",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dao.BreedingAnimal"
+                        }
+                    ]
+                }
+            }
+        },
+        "response.CollectProductsResponse": {
+            "type": "object",
+            "properties": {
+                "experience": {
+                    "description": "获得的经验值",
+                    "type": "integer"
+                },
+                "health": {
+                    "description": "动物当前健康值",
+                    "type": "integer"
+                },
+                "nextCollectTime": {
+                    "description": "下次可收集产物的时间",
+                    "type": "string"
+                },
+                "productName": {
+                    "description": "产物名称",
+                    "type": "string"
+                },
+                "yield": {
+                    "description": "产量",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "错误信息",
+                    "type": "string"
+                }
+            }
+        },
+        "response.FacetCountRes": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "符合条件的土地数量",
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "取值",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.GrowingActivityRes": {
+            "type": "object",
+            "properties": {
+                "activityId": {
+                    "description": "活动ID",
+                    "type": "integer"
+                },
+                "activityType": {
+                    "description": "活动类型(0-种植,1-养殖)",
+                    "type": "integer"
+                },
+                "area": {
+                    "description": "占用面积(㎡)",
+                    "type": "integer"
+                },
+                "cropAnimalId": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                },
+                "cropAnimalName": {
+                    "description": "作物/动物名称",
+                    "type": "string"
+                },
+                "expectedEndTime": {
+                    "description": "预计成熟时间",
+                    "type": "string"
+                },
+                "remainingSeconds": {
+                    "description": "距成熟的剩余秒数, 已成熟为0",
+                    "type": "integer"
+                },
+                "startTime": {
+                    "description": "开始时间",
+                    "type": "string"
+                },
+                "zoneId": {
+                    "description": "所在分区ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.HarvestCropResponse": {
+            "type": "object",
+            "properties": {
+                "cropName": {
+                    "description": "作物名称",
+                    "type": "string"
+                },
+                "experience": {
+                    "description": "获得经验值(可选)",
+                    "type": "integer"
+                },
+                "success": {
+                    "description": "收获是否成功",
+                    "type": "boolean"
+                },
+                "yield": {
+                    "description": "产量数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LandDetailResponse": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "description": "生长中的种植/养殖活动",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.GrowingActivityRes"
+                    }
+                },
+                "area": {
+                    "description": "土地面积(㎡)",
+                    "type": "integer"
+                },
+                "fertility": {
+                    "description": "当前肥力, 含上次结算后按时间恢复的部分",
+                    "type": "integer"
+                },
+                "fertilityCap": {
+                    "description": "等级决定的肥力上限",
+                    "type": "integer"
+                },
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "landType": {
+                    "description": "地形类型(0-平原,1-湿地,2-山地)",
+                    "type": "integer"
+                },
+                "lastHarvestTime": {
+                    "description": "最后收获时间",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "lastUpgrade": {
+                    "description": "最近一次完成的升级, 没有时为空",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.LandUpgradeRecordRes"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "level": {
+                    "description": "土地等级(1-10)",
+                    "type": "integer"
+                },
+                "listing": {
+                    "description": "待出售的挂牌, 没有时为空",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.LandListingRes"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "metadataUri": {
+                    "description": "元数据URI",
+                    "type": "string"
+                },
+                "ownerAddress": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "rarity": {
+                    "description": "稀有度(0-普通,1-稀有,2-史诗,3-传说)",
+                    "type": "integer"
+                },
+                "rental": {
+                    "description": "生效的租赁, 没有时为空",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.RentLandResponse"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "specialEffect": {
+                    "description": "特殊效果描述",
+                    "type": "string"
+                },
+                "unlockedZones": {
+                    "description": "已解锁的分区类型(逗号分隔)",
+                    "type": "string"
+                },
+                "upgradeCompleteTime": {
+                    "description": "升级施工完成时间, 为空表示未在施工",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "zones": {
+                    "description": "分区",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LayoutZoneRes"
+                    }
+                }
+            }
+        },
+        "response.LandFacetsRes": {
+            "type": "object",
+            "properties": {
+                "landType": {
+                    "description": "按地形类型统计",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.FacetCountRes"
+                    }
+                },
+                "rarity": {
+                    "description": "按稀有度统计",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.FacetCountRes"
+                    }
+                }
+            }
+        },
+        "response.LandLayoutResponse": {
+            "type": "object",
+            "properties": {
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "layouts": {
+                    "description": "布局区域列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LayoutZoneRes"
+                    }
+                }
+            }
+        },
+        "response.LandListingRes": {
+            "type": "object",
+            "properties": {
+                "listingTime": {
+                    "description": "挂牌时间",
+                    "type": "string"
+                },
+                "marketId": {
+                    "description": "挂牌ID",
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "售价",
+                    "type": "number"
+                },
+                "sellerAddress": {
+                    "description": "卖家钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "response.LandSearchItem": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "土地面积(㎡)",
+                    "type": "integer"
+                },
+                "fertility": {
+                    "description": "当前肥力, 含上次结算后按时间恢复的部分",
+                    "type": "integer"
+                },
+                "fertilityCap": {
+                    "description": "等级决定的肥力上限",
+                    "type": "integer"
+                },
+                "forSale": {
+                    "description": "是否待出售",
+                    "type": "boolean"
+                },
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "landType": {
+                    "description": "地形类型(0-平原,1-湿地,2-山地)",
+                    "type": "integer"
+                },
+                "level": {
+                    "description": "土地等级(1-10)",
+                    "type": "integer"
+                },
+                "marketId": {
+                    "description": "待出售挂牌ID, 未挂牌时为空",
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "metadataUri": {
+                    "description": "元数据URI",
+                    "type": "string"
+                },
+                "ownerAddress": {
+                    "description": "所有者钱包地址",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "挂牌售价, 未挂牌时为空",
+                    "type": "number",
+                    "x-nullable": true
+                },
+                "rarity": {
+                    "description": "稀有度(0-普通,1-稀有,2-史诗,3-传说)",
+                    "type": "integer"
+                },
+                "rented": {
+                    "description": "是否租赁中",
+                    "type": "boolean"
+                },
+                "specialEffect": {
+                    "description": "特殊效果描述",
+                    "type": "string"
+                }
+            }
+        },
+        "response.LandUpgradeRecordRes": {
+            "type": "object",
+            "properties": {
+                "costTokens": {
+                    "description": "消耗MFG数量",
+                    "type": "integer"
+                },
+                "finishTime": {
+                    "description": "完成时间",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "newLevel": {
+                    "description": "升级后等级",
+                    "type": "integer"
+                },
+                "oldLevel": {
+                    "description": "升级前等级",
+                    "type": "integer"
+                },
+                "upgradeId": {
+                    "description": "升级记录ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LayoutZoneRes": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "区域面积",
+                    "type": "integer"
+                },
+                "bonusType": {
+                    "description": "奖励类型(-1-无加成,0-肥力恢复,1-产量提升)",
+                    "type": "integer"
+                },
+                "bonusValue": {
+                    "description": "奖励值(百分比)",
+                    "type": "number"
+                },
+                "hasAdjacentBonus": {
+                    "description": "是否有相邻奖励",
+                    "type": "boolean"
+                },
+                "height": {
+                    "description": "区域高度",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "区域ID",
+                    "type": "integer"
+                },
+                "positionX": {
+                    "description": "X坐标位置",
+                    "type": "integer"
+                },
+                "positionY": {
+                    "description": "Y坐标位置",
+                    "type": "integer"
+                },
+                "width": {
+                    "description": "区域宽度",
+                    "type": "integer"
+                },
+                "zoneType": {
+                    "description": "区域类型(0-种植区,1-养殖区,2-装饰区)",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LoginMessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "待签名的登录消息",
+                    "type": "string"
+                },
+                "nonce": {
+                    "description": "随机数",
+                    "type": "string"
+                }
+            }
+        },
+        "response.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "过期时间",
+                    "type": "string"
+                },
+                "session_token": {
+                    "description": "会话令牌",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "用户ID",
+                    "type": "integer"
+                },
+                "wallet_address": {
+                    "description": "钱包地址",
+                    "type": "string"
+                }
+            }
+        },
+        "response.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "结果描述",
+                    "type": "string"
+                }
+            }
+        },
+        "response.PlantCropResponse": {
+            "type": "object",
+            "properties": {
+                "activityId": {
+                    "description": "活动ID",
+                    "type": "integer"
+                },
+                "activityType": {
+                    "description": "活动类型(0-种植,1-养殖)",
+                    "type": "integer"
+                },
+                "area": {
+                    "description": "占用面积(㎡)",
+                    "type": "integer"
+                },
+                "cropAnimalId": {
+                    "description": "作物/动物ID",
+                    "type": "integer"
+                },
+                "cropAnimalName": {
+                    "description": "作物/动物名称",
+                    "type": "string"
+                },
+                "expectedEndTime": {
+                    "description": "预计完成时间",
+                    "type": "string"
+                },
+                "fertilityCost": {
+                    "description": "消耗肥力值",
+                    "type": "integer"
+                },
+                "startTime": {
+                    "description": "开始时间",
+                    "type": "string"
+                }
+            }
+        },
+        "response.RentLandResponse": {
+            "type": "object",
+            "properties": {
+                "landTokenId": {
+                    "description": "土地NFT唯一标识",
+                    "type": "string"
+                },
+                "rentalEndTime": {
+                    "description": "租赁结束时间",
+                    "type": "string"
+                },
+                "rentalId": {
+                    "description": "租赁订单ID",
+                    "type": "integer"
+                },
+                "rentalStartTime": {
+                    "description": "租赁开始时间",
+                    "type": "string"
+                },
+                "totalRent": {
+                    "description": "总租金",
+                    "type": "number"
+                }
+            }
+        },
+        "response.SearchLandsResponse": {
+            "type": "object",
+            "properties": {
+                "facets": {
+                    "description": "按稀有度、地形类型的分面统计, 只在首页返回",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.LandFacetsRes"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "lands": {
+                    "description": "土地列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LandSearchItem"
+                    }
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "",
+	BasePath:         "/",
+	Schemes:          []string{},
+	Title:            "MetaFarm API",
+	Description:      "MetaFarm 游戏后端接口, 供Unity与Web客户端生成SDK使用",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
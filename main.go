@@ -4,23 +4,34 @@ import (
 	"MetaFarmBackend/api/router"
 	"MetaFarmBackend/component/config"
 	"MetaFarmBackend/component/context"
+	"fmt"
+	"os"
 )
 
+//go:generate swag init -g main.go -o docs --outputTypes go,json,yaml
+
+// @title MetaFarm API
+// @version 1.0
+// @description MetaFarm 游戏后端接口, 供Unity与Web客户端生成SDK使用
+// @BasePath /
 func main() {
 	//读取配置文件
 	config, err := config.LoadConfig("component/config/config.toml")
 	if err != nil {
-		// 处理错误
-		panic(err)
+		fmt.Fprintf(os.Stderr, "读取配置失败: %v\n", err)
+		os.Exit(1)
 	}
 
 	appContext, err := context.NewAppContext(config)
 	if err != nil {
-		// 处理错误
-		panic(err)
+		fmt.Fprintf(os.Stderr, "初始化应用失败: %v\n", err)
+		os.Exit(1)
 	}
 	//初始化路由
 	r := router.InitRouter(appContext)
-	//启动服务
-	r.Run(":" + config.API.Port)
+	//启动服务, 收到SIGINT/SIGTERM后优雅停机
+	if err := appContext.Lifecycle.Run(r); err != nil {
+		fmt.Fprintf(os.Stderr, "服务异常退出: %v\n", err)
+		os.Exit(1)
+	}
 }
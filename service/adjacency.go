@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// zoneBonus 分区按相邻规则计算出的加成
+type zoneBonus struct {
+	BonusType  int8
+	BonusValue float64
+}
+
+// zonesAdjacent 两个分区是否相邻: 共享一段长度大于0的边, 仅角点接触不算相邻
+func zonesAdjacent(a, b *dao.LandLayout) bool {
+	// 左右相邻: 竖边重合且纵向投影有交集
+	if a.PositionX+a.Width == b.PositionX || b.PositionX+b.Width == a.PositionX {
+		return a.PositionY < b.PositionY+b.Height && b.PositionY < a.PositionY+a.Height
+	}
+	// 上下相邻: 横边重合且横向投影有交集
+	if a.PositionY+a.Height == b.PositionY || b.PositionY+b.Height == a.PositionY {
+		return a.PositionX < b.PositionX+b.Width && b.PositionX < a.PositionX+a.Width
+	}
+	return false
+}
+
+// computeAdjacencyBonuses 按规则计算每个分区的相邻加成, 返回值与layouts一一对应.
+// 每条规则对一个分区最多生效一次(与几个同类分区相邻都只算一次);
+// 一个分区只保留一种加成类型, 取最先匹配的规则的类型, 同类型的规则加成值累加
+func computeAdjacencyBonuses(rules []config.AdjacencyRule, layouts []*dao.LandLayout) []zoneBonus {
+	bonuses := make([]zoneBonus, len(layouts))
+	for i, zone := range layouts {
+		bonus := zoneBonus{BonusType: dao.BonusTypeNone}
+		for _, rule := range rules {
+			if rule.ZoneType != zone.ZoneType || rule.BonusValue <= 0 {
+				continue
+			}
+			if bonus.BonusType != dao.BonusTypeNone && bonus.BonusType != rule.BonusType {
+				continue
+			}
+			for j, neighbor := range layouts {
+				if i != j && neighbor.ZoneType == rule.NeighborType && zonesAdjacent(zone, neighbor) {
+					bonus.BonusType = rule.BonusType
+					bonus.BonusValue += rule.BonusValue
+					break
+				}
+			}
+		}
+		bonuses[i] = bonus
+	}
+	return bonuses
+}
+
+// applyAdjacencyBonuses 将计算出的加成写回分区, 返回加成发生变化的分区下标
+func applyAdjacencyBonuses(rules []config.AdjacencyRule, layouts []*dao.LandLayout) []int {
+	var changed []int
+	for i, bonus := range computeAdjacencyBonuses(rules, layouts) {
+		layout := layouts[i]
+		hasBonus := bonus.BonusType != dao.BonusTypeNone
+		if layout.HasAdjacentBonus == hasBonus && layout.BonusType == bonus.BonusType && layout.BonusValue == bonus.BonusValue {
+			continue
+		}
+		layout.HasAdjacentBonus = hasBonus
+		layout.BonusType = bonus.BonusType
+		layout.BonusValue = bonus.BonusValue
+		changed = append(changed, i)
+	}
+	return changed
+}
+
+// refreshAdjacencyBonuses 在布局事务中按最新分区重新计算并持久化相邻加成
+func (s *landServiceImpl) refreshAdjacencyBonuses(ctx context.Context, tx *gorm.DB, tokenID string) error {
+	layouts, err := s.dao.LockLayoutsByTokenID(ctx, tx, tokenID)
+	if err != nil {
+		return errors.Wrap(err, "查询土地布局失败")
+	}
+	for _, i := range applyAdjacencyBonuses(s.cfg.AdjacencyRules, layouts) {
+		layout := layouts[i]
+		if err := s.dao.UpdateBonus(ctx, tx, tokenID, layout.ID, layout.HasAdjacentBonus, layout.BonusType, layout.BonusValue); err != nil {
+			return errors.Wrap(err, "更新分区加成失败")
+		}
+	}
+	return nil
+}
+
+// PreviewLandLayout 预览布局调整后的分区及相邻加成, 只校验不保存
+func (s *landServiceImpl) PreviewLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) ([]*dao.LandLayout, error) {
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.TokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.TokenID, landInfo.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限预览土地布局")
+	}
+	if err := validateLayout(landInfo, req.Zones); err != nil {
+		return nil, err
+	}
+
+	layouts := make([]*dao.LandLayout, 0, len(req.Zones))
+	for _, zone := range req.Zones {
+		layout := dao.NewLandLayout(req.TokenID, zone.Area, zone.ZoneType, zone.PositionX, zone.PositionY, zone.Width, zone.Height)
+		layout.ID = zone.ID
+		layouts = append(layouts, layout)
+	}
+	applyAdjacencyBonuses(s.cfg.AdjacencyRules, layouts)
+	return layouts, nil
+}
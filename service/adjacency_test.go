@@ -0,0 +1,103 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/dao"
+)
+
+func layoutAt(zoneType int8, x, y, w, h int) *dao.LandLayout {
+	return &dao.LandLayout{ZoneType: zoneType, PositionX: x, PositionY: y, Width: w, Height: h, Area: w * h}
+}
+
+func TestZonesAdjacent(t *testing.T) {
+	base := layoutAt(0, 2, 2, 3, 2)
+	tests := []struct {
+		name string
+		zone *dao.LandLayout
+		want bool
+	}{
+		{name: "右侧共享竖边", zone: layoutAt(1, 5, 3, 2, 2), want: true},
+		{name: "左侧共享竖边", zone: layoutAt(1, 0, 0, 2, 3), want: true},
+		{name: "下方共享横边", zone: layoutAt(1, 4, 4, 3, 1), want: true},
+		{name: "上方共享横边", zone: layoutAt(1, 0, 0, 3, 2), want: true},
+		{name: "仅角点接触", zone: layoutAt(1, 5, 4, 1, 1)},
+		{name: "竖边对齐但纵向不相交", zone: layoutAt(1, 5, 4, 1, 2)},
+		{name: "相离", zone: layoutAt(1, 6, 2, 1, 1)},
+		{name: "重叠", zone: layoutAt(1, 3, 2, 1, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zonesAdjacent(base, tt.zone); got != tt.want {
+				t.Fatalf("zonesAdjacent = %v, want %v", got, tt.want)
+			}
+			if got := zonesAdjacent(tt.zone, base); got != tt.want {
+				t.Fatalf("zonesAdjacent not symmetric: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeAdjacencyBonuses(t *testing.T) {
+	none := zoneBonus{BonusType: dao.BonusTypeNone}
+	plantNextToBreeding := config.AdjacencyRule{ZoneType: 0, NeighborType: 1, BonusType: dao.BonusTypeYield, BonusValue: 10}
+	plantNextToDecoration := config.AdjacencyRule{ZoneType: 0, NeighborType: 2, BonusType: dao.BonusTypeYield, BonusValue: 5}
+	plantFertility := config.AdjacencyRule{ZoneType: 0, NeighborType: 2, BonusType: dao.BonusTypeFertility, BonusValue: 20}
+	tests := []struct {
+		name    string
+		rules   []config.AdjacencyRule
+		layouts []*dao.LandLayout
+		want    []zoneBonus
+	}{
+		{
+			name:    "没有规则",
+			layouts: []*dao.LandLayout{layoutAt(0, 0, 0, 2, 2), layoutAt(1, 2, 0, 2, 2)},
+			want:    []zoneBonus{none, none},
+		},
+		{
+			name:    "相邻获得加成",
+			rules:   []config.AdjacencyRule{plantNextToBreeding},
+			layouts: []*dao.LandLayout{layoutAt(0, 0, 0, 2, 2), layoutAt(1, 2, 0, 2, 2)},
+			want:    []zoneBonus{{BonusType: dao.BonusTypeYield, BonusValue: 10}, none},
+		},
+		{
+			name:    "不相邻没有加成",
+			rules:   []config.AdjacencyRule{plantNextToBreeding},
+			layouts: []*dao.LandLayout{layoutAt(0, 0, 0, 2, 2), layoutAt(1, 3, 0, 2, 2)},
+			want:    []zoneBonus{none, none},
+		},
+		{
+			name:    "与多个同类分区相邻只算一次",
+			rules:   []config.AdjacencyRule{plantNextToBreeding},
+			layouts: []*dao.LandLayout{layoutAt(0, 2, 2, 2, 2), layoutAt(1, 0, 2, 2, 2), layoutAt(1, 4, 2, 2, 2)},
+			want:    []zoneBonus{{BonusType: dao.BonusTypeYield, BonusValue: 10}, none, none},
+		},
+		{
+			name:    "同类型规则累加",
+			rules:   []config.AdjacencyRule{plantNextToBreeding, plantNextToDecoration},
+			layouts: []*dao.LandLayout{layoutAt(0, 2, 2, 2, 2), layoutAt(1, 0, 2, 2, 2), layoutAt(2, 4, 2, 2, 2)},
+			want:    []zoneBonus{{BonusType: dao.BonusTypeYield, BonusValue: 15}, none, none},
+		},
+		{
+			name:    "只保留最先匹配的加成类型",
+			rules:   []config.AdjacencyRule{plantFertility, plantNextToBreeding},
+			layouts: []*dao.LandLayout{layoutAt(0, 2, 2, 2, 2), layoutAt(1, 0, 2, 2, 2), layoutAt(2, 4, 2, 2, 2)},
+			want:    []zoneBonus{{BonusType: dao.BonusTypeFertility, BonusValue: 20}, none, none},
+		},
+		{
+			name:    "忽略非正加成值",
+			rules:   []config.AdjacencyRule{{ZoneType: 0, NeighborType: 1, BonusType: dao.BonusTypeYield}},
+			layouts: []*dao.LandLayout{layoutAt(0, 0, 0, 2, 2), layoutAt(1, 2, 0, 2, 2)},
+			want:    []zoneBonus{none, none},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeAdjacencyBonuses(tt.rules, tt.layouts); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// AssetMFG MFG代币资产标识
+const AssetMFG = "MFG"
+
+// ErrInsufficientAssets 余额或道具不足
+var ErrInsufficientAssets = errors.New("余额或道具不足")
+
+// InsufficientAssetsError 余额或道具不足, 携带不足的资产及数量, 可用errors.Is匹配ErrInsufficientAssets
+type InsufficientAssetsError struct {
+	Asset     string  // 资产: MFG或道具TokenID
+	Required  float64 // 所需数量
+	Available float64 // 可用数量
+}
+
+func (e *InsufficientAssetsError) Error() string {
+	if e.Asset == AssetMFG {
+		return fmt.Sprintf("MFG余额不足: 需要%v, 可用%v", e.Required, e.Available)
+	}
+	return fmt.Sprintf("道具%s不足: 需要%v, 可用%v", e.Asset, e.Required, e.Available)
+}
+
+func (e *InsufficientAssetsError) Is(target error) bool {
+	return target == ErrInsufficientAssets
+}
+
+// assetCost 一次操作需要消耗的资产
+type assetCost struct {
+	Tokens uint64         // MFG代币数量
+	Items  map[string]int // 道具TokenID->使用次数
+}
+
+// chargeAssets 在事务中锁定账户与道具行并扣减消耗, 先锁账户再锁道具, 返回扣减后的MFG余额
+func (s *landServiceImpl) chargeAssets(ctx context.Context, tx *gorm.DB, userAddress string, cost assetCost) (float64, error) {
+	// 1. 锁定账户并扣减MFG
+	account, err := s.dao.LockUserAccount(ctx, tx, userAddress)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Errorf("锁定用户账户失败: %v, user: %s", err, userAddress)
+		return 0, errors.Wrap(err, "查询用户账户失败")
+	}
+	balance := account.MFGBalance
+	if cost.Tokens > 0 {
+		if errors.Is(err, gorm.ErrRecordNotFound) || balance < float64(cost.Tokens) {
+			return 0, &InsufficientAssetsError{Asset: AssetMFG, Required: float64(cost.Tokens), Available: balance}
+		}
+		ok, err := s.dao.AddMFGBalance(ctx, tx, userAddress, -float64(cost.Tokens))
+		if err != nil {
+			logger.Errorf("扣减MFG余额失败: %v, user: %s", err, userAddress)
+			return 0, errors.Wrap(err, "扣减MFG余额失败")
+		}
+		if !ok {
+			// 扣减以余额充足为条件, 未更新说明账户已不满足条件
+			return 0, &InsufficientAssetsError{Asset: AssetMFG, Required: float64(cost.Tokens), Available: balance}
+		}
+		balance -= float64(cost.Tokens)
+	}
+
+	// 2. 锁定道具并扣减使用次数
+	if len(cost.Items) == 0 {
+		return balance, nil
+	}
+	tokenIDs := make([]int64, 0, len(cost.Items))
+	for key := range cost.Items {
+		tokenID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "无效的道具TokenID: %s", key)
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Slice(tokenIDs, func(i, j int) bool { return tokenIDs[i] < tokenIDs[j] })
+
+	items, err := s.dao.LockUserItemsByTokens(ctx, tx, userAddress, tokenIDs)
+	if err != nil {
+		logger.Errorf("锁定用户道具失败: %v, user: %s", err, userAddress)
+		return 0, errors.Wrap(err, "查询用户道具失败")
+	}
+	owned := make(map[int64][]*dao.UserItems, len(tokenIDs))
+	for _, item := range items {
+		owned[item.ItemTokenID] = append(owned[item.ItemTokenID], item)
+	}
+
+	for _, tokenID := range tokenIDs {
+		key := strconv.FormatInt(tokenID, 10)
+		required := cost.Items[key]
+		available := 0
+		for _, item := range owned[tokenID] {
+			available += item.RemainingUses
+		}
+		if available < required {
+			return 0, &InsufficientAssetsError{Asset: key, Required: float64(required), Available: float64(available)}
+		}
+		// 同一道具有多条记录时按主键顺序依次扣减
+		for _, item := range owned[tokenID] {
+			if required == 0 {
+				break
+			}
+			uses := min(required, item.RemainingUses)
+			if err := s.dao.ConsumeUserItemUses(ctx, tx, item, uses); err != nil {
+				logger.Errorf("扣减道具使用次数失败: %v, itemID: %d", err, item.ID)
+				return 0, errors.Wrap(err, "扣减道具失败")
+			}
+			required -= uses
+		}
+	}
+	return balance, nil
+}
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+)
+
+// 一键收获每次最多处理的活动数量, 剩余的由客户端再次调用处理
+const farmBatch = 100
+
+// BatchItemResult 批量操作中单个活动的结果, Err不为空表示该项失败; 收获成功但补种失败时Harvested为true且Err不为空
+type BatchItemResult struct {
+	ActivityID    uint64 // 原活动ID
+	LandTokenID   string // 土地NFT TokenID
+	CropName      string // 作物名称
+	Harvested     bool   // 是否已收获
+	Yield         int    // 收获产量
+	Experience    int64  // 收获获得的经验值
+	Replanted     bool   // 是否已补种
+	NewActivityID uint64 // 补种创建的活动ID
+	Err           error  // 失败原因
+}
+
+// BatchResult 批量操作结果, 各项按处理顺序排列
+type BatchResult struct {
+	Items   []*BatchItemResult
+	HasMore bool // 是否还有未处理的成熟作物
+}
+
+// HarvestAll 收获用户所有土地上已成熟的作物, 每次最多处理farmBatch项; 产量按单次收获的规则计算,
+// 缺水或虫害严重、并发已被收获的作物单独失败, 其余作物批量发放收获物与经验值, 批量事务失败时逐项提交
+func (s *landServiceImpl) HarvestAll(ctx context.Context, req request.BatchHarvestRequest) (*BatchResult, error) {
+	now := time.Now()
+
+	// 1. 批量加载成熟作物及所在土地、分区加成和地块
+	activities, err := s.dao.GetHarvestableActivities(ctx, req.UserAddress, now, s.witherGrace(), farmBatch+1)
+	if err != nil {
+		logger.Errorf("查询成熟作物失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "一键收获失败")
+	}
+	result := &BatchResult{HasMore: len(activities) > farmBatch}
+	if result.HasMore {
+		activities = activities[:farmBatch]
+	}
+	if len(activities) == 0 {
+		return result, nil
+	}
+
+	tokenIDs := make([]string, 0, len(activities))
+	activityIDs := make([]uint64, 0, len(activities))
+	seen := make(map[string]bool, len(activities))
+	for _, activity := range activities {
+		activityIDs = append(activityIDs, activity.ID)
+		if !seen[activity.LandTokenID] {
+			seen[activity.LandTokenID] = true
+			tokenIDs = append(tokenIDs, activity.LandTokenID)
+		}
+	}
+	sort.Strings(tokenIDs)
+	lands, err := s.dao.GetLandInfosByTokenIDs(ctx, tokenIDs)
+	if err != nil {
+		logger.Errorf("批量获取土地信息失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	bonuses, err := s.dao.GetZoneBonuses(ctx, tokenIDs, dao.BonusTypeYield)
+	if err != nil {
+		logger.Errorf("查询产量加成失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "一键收获失败")
+	}
+	plots, err := s.dao.GetPlotPlantingsByActivityIDs(ctx, activityIDs)
+	if err != nil {
+		logger.Errorf("批量获取地块失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "一键收获失败")
+	}
+
+	// 2. 逐项校验并计算产量, 不满足条件的作物单独失败
+	var pending []*BatchItemResult
+	for _, activity := range activities {
+		item := &BatchItemResult{ActivityID: activity.ID, LandTokenID: activity.LandTokenID, CropName: activity.CropAnimalName}
+		result.Items = append(result.Items, item)
+		land, ok := lands[activity.LandTokenID]
+		if !ok {
+			item.Err = errors.Wrap(ErrHarvestNotAllowed, "土地不存在")
+			continue
+		}
+		if err := s.checkHarvest(activity, now); err != nil {
+			item.Err = err
+			continue
+		}
+		yield, err := s.cropYield(land, activity, bonuses[activity.ZoneID], plots[activity.ID], now)
+		if err != nil {
+			item.Err = err
+			continue
+		}
+		item.Yield = yield
+		item.Experience = int64(float64(yield) * s.cfg.ExperiencePerYield)
+		pending = append(pending, item)
+	}
+	settleEach(pending, func(items []*BatchItemResult) error {
+		return s.settleHarvests(ctx, req.UserAddress, items, activities, now)
+	})
+
+	// 3. 在原分区补种已收获的作物
+	if req.Replant {
+		sources := make(map[uint64]*dao.LandActivity, len(activities))
+		var harvested []*BatchItemResult
+		for _, activity := range activities {
+			sources[activity.ID] = activity
+		}
+		for _, item := range pending {
+			if item.Harvested {
+				harvested = append(harvested, item)
+			}
+		}
+		s.replantItems(ctx, req.UserAddress, harvested, sources)
+	}
+
+	logger.Infof("一键收获完成: user=%s, items=%d, hasMore=%t", req.UserAddress, len(result.Items), result.HasMore)
+	return result, nil
+}
+
+// settleHarvests 在同一事务中标记活动已收获并发放收获物与经验值, 并发已被收获的作物单独失败;
+// 加锁顺序与单次收获一致: 活动 → 账户 → 收获物库存 → 地块 → 土地, 活动、收获物及土地按ID排序
+func (s *landServiceImpl) settleHarvests(ctx context.Context, userAddress string, items []*BatchItemResult, activities []*dao.LandActivity, now time.Time) error {
+	byID := make(map[uint64]*dao.LandActivity, len(activities))
+	for _, activity := range activities {
+		byID[activity.ID] = activity
+	}
+	ordered := append([]*BatchItemResult(nil), items...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].ActivityID < ordered[j].ActivityID })
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 1. 锁定仍在生长中的活动, 用一条UPDATE标记已收获
+	ids := make([]uint64, 0, len(ordered))
+	for _, item := range ordered {
+		ids = append(ids, item.ActivityID)
+	}
+	growing, err := s.dao.LockGrowingActivityIDs(ctx, tx, ids)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定活动失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "一键收获失败")
+	}
+	lockedIDs := make(map[uint64]bool, len(growing))
+	for _, id := range growing {
+		lockedIDs[id] = true
+	}
+	var (
+		experience  int64
+		yields      = make(map[uint64]int, len(growing))
+		produce     = make(map[uint64]int64)
+		names       = make(map[uint64]string)
+		activityIDs []uint64
+		tokenIDs    []string
+		seen        = make(map[string]bool)
+	)
+	for _, item := range ordered {
+		if !lockedIDs[item.ActivityID] {
+			item.Err = errors.Wrap(ErrHarvestNotAllowed, "作物未处于生长状态")
+			continue
+		}
+		activity := byID[item.ActivityID]
+		experience += item.Experience
+		yields[item.ActivityID] = item.Yield
+		produce[activity.CropAnimalID] += int64(item.Yield)
+		names[activity.CropAnimalID] = activity.CropAnimalName
+		activityIDs = append(activityIDs, item.ActivityID)
+		if !seen[item.LandTokenID] {
+			seen[item.LandTokenID] = true
+			tokenIDs = append(tokenIDs, item.LandTokenID)
+		}
+	}
+	if len(activityIDs) == 0 {
+		tx.Rollback()
+		return nil
+	}
+	if err := s.dao.HarvestLandActivities(ctx, tx, yields); err != nil {
+		tx.Rollback()
+		logger.Errorf("批量更新活动状态失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "一键收获失败")
+	}
+
+	// 2. 合并发放经验值及各作物的收获物
+	if experience > 0 {
+		if err := s.dao.AddExperience(ctx, tx, userAddress, experience); err != nil {
+			tx.Rollback()
+			logger.Errorf("增加经验值失败: %v, user: %s", err, userAddress)
+			return errors.Wrap(err, "一键收获失败")
+		}
+	}
+	cropIDs := make([]uint64, 0, len(produce))
+	for cropID := range produce {
+		cropIDs = append(cropIDs, cropID)
+	}
+	sort.Slice(cropIDs, func(i, j int) bool { return cropIDs[i] < cropIDs[j] })
+	for _, cropID := range cropIDs {
+		if err := s.dao.AddUserProduce(ctx, tx, userAddress, cropID, names[cropID], produce[cropID]); err != nil {
+			tx.Rollback()
+			logger.Errorf("发放收获物失败: %v, user: %s", err, userAddress)
+			return errors.Wrap(err, "一键收获失败")
+		}
+	}
+
+	// 3. 结束地块种植, 更新土地最后收获时间
+	if err := s.dao.FinishPlotPlanting(ctx, tx, activityIDs...); err != nil {
+		tx.Rollback()
+		logger.Errorf("批量更新地块失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "一键收获失败")
+	}
+	sort.Strings(tokenIDs)
+	if err := s.dao.UpdateLastHarvestTime(ctx, tx, tokenIDs, now); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新最后收获时间失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "一键收获失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交一键收获事务失败: %v", err)
+		return errors.Wrap(err, "一键收获失败")
+	}
+	s.invalidateLandDetails(ctx, tokenIDs...)
+
+	for _, item := range ordered {
+		if item.Err != nil {
+			continue
+		}
+		item.Harvested = true
+		activity := byID[item.ActivityID]
+		activity.Status = dao.ActivityStatusHarvested
+		activity.Yield = item.Yield
+		activity.ActualEndTime = &now
+	}
+	logger.Infof("批量收获成功: user=%s, harvested=%d, experience=%d", userAddress, len(activityIDs), experience)
+	return nil
+}
+
+// ReplantCrops 在已收获活动的原分区重新种植同一作物, 面积与原活动相同; 批量事务失败时逐项补种
+func (s *landServiceImpl) ReplantCrops(ctx context.Context, req request.BatchReplantRequest) (*BatchResult, error) {
+	sources, err := s.dao.GetLandActivitiesByIDs(ctx, req.ActivityIDs)
+	if err != nil {
+		logger.Errorf("批量获取活动失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "获取活动信息失败")
+	}
+
+	result := &BatchResult{}
+	var valid []*BatchItemResult
+	seen := make(map[uint64]bool, len(req.ActivityIDs))
+	for _, id := range req.ActivityIDs {
+		item := &BatchItemResult{ActivityID: id}
+		result.Items = append(result.Items, item)
+		source, ok := sources[id]
+		switch {
+		case seen[id]:
+			item.Err = errors.Wrap(ErrPlantNotAllowed, "活动重复")
+		case !ok:
+			item.Err = errors.Wrap(ErrPlantNotAllowed, "活动不存在")
+		case source.OwnerAddress != req.UserAddress:
+			item.Err = errors.New("无权限补种此活动")
+		case source.ActivityType != dao.ActivityTypePlanting:
+			item.Err = errors.Wrap(ErrPlantNotAllowed, "动物不能补种")
+		case source.Status != dao.ActivityStatusHarvested:
+			item.Err = errors.Wrap(ErrPlantNotAllowed, "作物尚未收获")
+		default:
+			item.LandTokenID = source.LandTokenID
+			item.CropName = source.CropAnimalName
+			valid = append(valid, item)
+		}
+		seen[id] = true
+	}
+	s.replantItems(ctx, req.UserAddress, valid, sources)
+
+	logger.Infof("批量补种完成: user=%s, items=%d", req.UserAddress, len(result.Items))
+	return result, nil
+}
+
+// settleEach 先在一个事务中提交全部项, 该事务失败时逐项各用一个事务重试, 使各项独立成功或失败;
+// settle返回错误时须已回滚事务且未将任何项标记为成功
+func settleEach(items []*BatchItemResult, settle func([]*BatchItemResult) error) {
+	if len(items) == 0 {
+		return
+	}
+	err := settle(items)
+	if err == nil {
+		return
+	}
+	if len(items) == 1 {
+		items[0].Err = err
+		return
+	}
+	for _, item := range items {
+		item.Err = nil
+		if err := settle([]*BatchItemResult{item}); err != nil {
+			item.Err = err
+		}
+	}
+}
+
+// replantItems 在原分区补种各项, 各项的结果及错误记录在项中; sources为各项对应的原活动
+func (s *landServiceImpl) replantItems(ctx context.Context, userAddress string, items []*BatchItemResult, sources map[uint64]*dao.LandActivity) {
+	if len(items) == 0 {
+		return
+	}
+	var cropIDs []uint64
+	seen := make(map[uint64]bool)
+	for _, item := range items {
+		if cropID := sources[item.ActivityID].CropAnimalID; !seen[cropID] {
+			seen[cropID] = true
+			cropIDs = append(cropIDs, cropID)
+		}
+	}
+	catalogs, err := s.dao.GetCropAnimalsByIDs(ctx, cropIDs)
+	if err != nil {
+		logger.Errorf("批量查询作物/动物目录失败: %v, user: %s", err, userAddress)
+		err = errors.Wrap(err, "查询作物/动物目录失败")
+		for _, item := range items {
+			item.Err = err
+		}
+		return
+	}
+	settleEach(items, func(group []*BatchItemResult) error {
+		return s.replantLands(ctx, userAddress, group, sources, catalogs)
+	})
+}
+
+// replantLands 在一个事务中于各项所在土地上补种, 加锁顺序与种植一致: 土地 → 分区, 土地按TokenID排序;
+// 肥力与分区剩余面积按各项依次扣减, 不满足条件的项单独失败, 返回的错误表示补种事务失败
+func (s *landServiceImpl) replantLands(ctx context.Context, userAddress string, items []*BatchItemResult, sources map[uint64]*dao.LandActivity, catalogs map[uint64]*dao.CropAnimal) error {
+	byLand := make(map[string][]*BatchItemResult)
+	var tokenIDs []string
+	for _, item := range items {
+		if _, ok := byLand[item.LandTokenID]; !ok {
+			tokenIDs = append(tokenIDs, item.LandTokenID)
+		}
+		byLand[item.LandTokenID] = append(byLand[item.LandTokenID], item)
+	}
+	sort.Strings(tokenIDs)
+	bonuses, err := s.dao.GetLayoutBonuses(ctx, tokenIDs, dao.BonusTypeFertility)
+	if err != nil {
+		logger.Errorf("查询肥力恢复加成失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "结算土地肥力失败")
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 1. 锁定全部土地及分区, 查询分区占用面积
+	lands, err := s.dao.LockLandInfos(ctx, tx, tokenIDs)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("批量锁定土地失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "获取土地信息失败")
+	}
+	layouts, err := s.dao.LockLayoutsByTokenIDs(ctx, tx, tokenIDs)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("批量锁定土地分区失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "查询土地分区失败")
+	}
+	zones := make(map[uint64]*dao.LandLayout, len(layouts))
+	for _, layout := range layouts {
+		zones[layout.ID] = layout
+	}
+	occupied, err := s.dao.GetZoneOccupiedArea(ctx, tx, tokenIDs...)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("查询分区占用面积失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "查询分区占用面积失败")
+	}
+
+	// 2. 按土地结算肥力, 逐项校验目录、分区、肥力及剩余面积
+	var (
+		planted    []*BatchItemResult
+		activities []*dao.LandActivity
+		changed    []*dao.LandInfo
+	)
+	now := time.Now()
+	for _, tokenID := range tokenIDs {
+		land, ok := lands[tokenID]
+		var landErr error
+		switch {
+		case !ok:
+			landErr = errors.Wrap(ErrPlantNotAllowed, "土地不存在")
+		case land.OwnerAddress != userAddress:
+			logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", tokenID, land.OwnerAddress, userAddress)
+			landErr = errors.New("无权限种植作物")
+		}
+		if landErr != nil {
+			for _, item := range byLand[tokenID] {
+				item.Err = landErr
+			}
+			continue
+		}
+		fertility, settledAt := s.settledFertility(land, bonuses[tokenID], now)
+		land.Fertility = fertility
+		land.FertilityUpdateTime = &settledAt
+
+		count := len(activities)
+		for _, item := range byLand[tokenID] {
+			source := sources[item.ActivityID]
+			catalog, ok := catalogs[source.CropAnimalID]
+			if !ok {
+				item.Err = errors.Wrapf(ErrPlantNotAllowed, "作物/动物不存在: %d", source.CropAnimalID)
+				continue
+			}
+			zone, ok := zones[source.ZoneID]
+			if !ok || zone.LandTokenID != tokenID {
+				item.Err = errors.Wrapf(ErrPlantNotAllowed, "分区不存在: %d", source.ZoneID)
+				continue
+			}
+			if err := checkPlantingRules(land, catalog, zone, source.Area); err != nil {
+				item.Err = err
+				continue
+			}
+			requiredFertility := source.Area * catalog.FertilityPerSqm
+			if land.Fertility < requiredFertility {
+				item.Err = errors.Wrapf(ErrPlantNotAllowed, "土地肥力不足: 需要%d, 当前%d", requiredFertility, land.Fertility)
+				continue
+			}
+			if free := zone.Area - occupied[zone.ID]; source.Area > free {
+				item.Err = errors.Wrapf(ErrPlantNotAllowed, "分区剩余面积不足: 剩余%d㎡", max(free, 0))
+				continue
+			}
+			activities = append(activities, s.newPlanting(land, catalog, zone.ID, userAddress, source.Area))
+			planted = append(planted, item)
+			land.Fertility -= requiredFertility
+			occupied[zone.ID] += source.Area
+		}
+		if len(activities) > count {
+			changed = append(changed, land)
+		}
+	}
+	if len(activities) == 0 {
+		tx.Rollback()
+		return nil
+	}
+
+	// 3. 批量创建活动及地块, 扣减各土地肥力
+	if err := s.dao.CreateLandActivities(ctx, tx, activities); err != nil {
+		tx.Rollback()
+		logger.Errorf("批量创建种植活动失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "补种作物失败")
+	}
+	plots := make([]*dao.PlotPlanting, 0, len(activities))
+	for _, activity := range activities {
+		plots = append(plots, dao.NewPlotPlanting(activity, activity.ZoneID))
+	}
+	if err := s.dao.CreatePlotPlantings(ctx, tx, plots); err != nil {
+		tx.Rollback()
+		logger.Errorf("批量创建地块失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "补种作物失败")
+	}
+	if err := s.dao.UpdateFertilities(ctx, tx, changed); err != nil {
+		tx.Rollback()
+		logger.Errorf("批量更新土地肥力失败: %v, user: %s", err, userAddress)
+		return errors.Wrap(err, "补种作物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交补种事务失败: %v", err)
+		return errors.Wrap(err, "补种作物失败")
+	}
+	changedIDs := make([]string, 0, len(changed))
+	for _, land := range changed {
+		changedIDs = append(changedIDs, land.LandTokenID)
+	}
+	s.invalidateLandDetails(ctx, changedIDs...)
+
+	for i, item := range planted {
+		item.Replanted = true
+		item.NewActivityID = activities[i].ID
+	}
+	logger.Infof("补种成功: user=%s, lands=%d, count=%d", userAddress, len(changed), len(activities))
+	return nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSettleEach(t *testing.T) {
+	txErr := errors.New("deadlock")
+	tests := []struct {
+		name      string
+		failing   map[uint64]bool // 包含这些活动的事务失败
+		ids       []uint64
+		wantCalls int
+		wantErr   []bool
+	}{
+		{name: "批量成功", ids: []uint64{1, 2, 3}, wantCalls: 1, wantErr: []bool{false, false, false}},
+		{name: "单项失败只影响该项", failing: map[uint64]bool{2: true}, ids: []uint64{1, 2, 3}, wantCalls: 4, wantErr: []bool{false, true, false}},
+		{name: "只有一项时不重试", failing: map[uint64]bool{1: true}, ids: []uint64{1}, wantCalls: 1, wantErr: []bool{true}},
+		{name: "没有项", wantCalls: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var items []*BatchItemResult
+			for _, id := range tt.ids {
+				items = append(items, &BatchItemResult{ActivityID: id})
+			}
+			calls := 0
+			settleEach(items, func(group []*BatchItemResult) error {
+				calls++
+				for _, item := range group {
+					if tt.failing[item.ActivityID] {
+						return txErr
+					}
+				}
+				for _, item := range group {
+					item.Harvested = true
+				}
+				return nil
+			})
+			if calls != tt.wantCalls {
+				t.Fatalf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			for i, item := range items {
+				if gotErr := item.Err != nil; gotErr != tt.wantErr[i] || item.Harvested == gotErr {
+					t.Fatalf("item %d: err=%v harvested=%v", item.ActivityID, item.Err, item.Harvested)
+				}
+			}
+		})
+	}
+}
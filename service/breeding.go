@@ -0,0 +1,490 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrBreedingNotAllowed 无法进行养殖操作, 如动物未成年、产物未就绪、健康值不足或饲料不符
+var ErrBreedingNotAllowed = errors.New("无法进行养殖操作")
+
+// 单次扫描处理的饿死动物数量, 未处理完的在下次扫描继续
+const starveBatch = 200
+
+// CollectResult 收集动物产物的结果
+type CollectResult struct {
+	Animal      *dao.BreedingAnimal // 收集后的动物
+	ProductName string              // 产物名称
+	Yield       int                 // 产量
+	Experience  int64               // 获得的经验值
+}
+
+// BreedResult 繁殖动物的结果
+type BreedResult struct {
+	Parent    *dao.BreedingAnimal // 繁殖后的亲代
+	Offspring *dao.BreedingAnimal // 新出生的幼崽
+}
+
+// settleAnimal 按上次结算后经过的时间扣减健康值, 只修改内存中的数据
+func (s *landServiceImpl) settleAnimal(animal *dao.BreedingAnimal, now time.Time) {
+	animal.Health, animal.HealthSettledAt = settleLinear(animal.Health, animal.HealthSettledAt, now, -s.cfg.AnimalHealthDecayPerHour, dao.AnimalHealthMin, dao.AnimalHealthMax)
+}
+
+// starveAfter 按当前健康值不再喂养时距离饿死的时长
+func (s *landServiceImpl) starveAfter(health int) time.Duration {
+	if s.cfg.AnimalHealthDecayPerHour <= 0 {
+		return 100 * 365 * 24 * time.Hour
+	}
+	return time.Duration(float64(health) / s.cfg.AnimalHealthDecayPerHour * float64(time.Hour))
+}
+
+// healthFactor 健康值对产量的修正: 健康值为0时减半
+func healthFactor(animal *dao.BreedingAnimal) float64 {
+	return 0.5 + 0.5*float64(animal.Health)/dao.AnimalHealthMax
+}
+
+// getBreedingActivity 获取养殖活动并校验所有者及状态
+func (s *landServiceImpl) getBreedingActivity(ctx context.Context, activityID uint64, userAddress string) (*dao.LandActivity, error) {
+	activity, err := s.dao.GetLandActivityByID(ctx, activityID)
+	if err != nil {
+		logger.Errorf("获取养殖活动失败: %v, activityID: %d", err, activityID)
+		return nil, errors.Wrap(err, "获取活动信息失败")
+	}
+	if activity.OwnerAddress != userAddress {
+		logger.Errorf("用户非活动所有者: activityID=%d, ownerAddress=%s, user=%s", activityID, activity.OwnerAddress, userAddress)
+		return nil, errors.New("无权限操作此动物")
+	}
+	if activity.ActivityType != dao.ActivityTypeBreeding {
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "该活动不是养殖活动")
+	}
+	if activity.Status != dao.ActivityStatusGrowing {
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "动物已饿死")
+	}
+	return activity, nil
+}
+
+// lockLiveAnimal 在事务中锁定动物并结算健康值, 动物已饿死时返回错误
+func (s *landServiceImpl) lockLiveAnimal(ctx context.Context, tx *gorm.DB, activityID uint64, now time.Time) (*dao.BreedingAnimal, error) {
+	animal, err := s.dao.LockBreedingAnimal(ctx, tx, activityID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "该活动没有养殖记录")
+	}
+	if err != nil {
+		logger.Errorf("锁定养殖动物失败: %v, activityID: %d", err, activityID)
+		return nil, errors.Wrap(err, "获取养殖动物失败")
+	}
+	s.settleAnimal(animal, now)
+	if animal.IsAlive == 0 || animal.Health <= dao.AnimalHealthMin {
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "动物已饿死")
+	}
+	return animal, nil
+}
+
+// FeedAnimal 喂养动物恢复健康值: 饲料道具每次消耗一次并按效果值恢复, 作物收获物按数量恢复
+func (s *landServiceImpl) FeedAnimal(ctx context.Context, req request.FeedAnimalRequest) (*dao.BreedingAnimal, error) {
+	// 1. 验证活动并计算本次恢复的健康值
+	activity, err := s.getBreedingActivity(ctx, req.ActivityID, req.UserAddress)
+	if err != nil {
+		return nil, err
+	}
+	quantity := max(req.Quantity, 1)
+	var gain int
+	if req.FeedCropID != 0 {
+		catalog, err := s.dao.GetCropAnimalByID(ctx, activity.CropAnimalID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Errorf("查询作物/动物目录失败: %v, id: %d", err, activity.CropAnimalID)
+			return nil, errors.Wrap(err, "查询作物/动物目录失败")
+		}
+		if err != nil || !catalog.AcceptsFeed(req.FeedCropID) {
+			return nil, errors.Wrapf(ErrBreedingNotAllowed, "%s不吃该作物", activity.CropAnimalName)
+		}
+		gain = quantity * s.cfg.FeedHealthPerProduce
+	} else {
+		item, err := s.dao.GetUserItemByUserAndToken(ctx, req.UserAddress, req.ItemTokenID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &InsufficientAssetsError{Asset: strconv.FormatInt(req.ItemTokenID, 10), Required: 1}
+		}
+		if err != nil {
+			logger.Errorf("查询饲料道具失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "查询饲料道具失败")
+		}
+		if item.ItemType != dao.ItemTypeFeed || item.Power <= 0 {
+			return nil, errors.Wrap(ErrBreedingNotAllowed, "道具不是饲料")
+		}
+		gain = item.Power
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. 锁定动物, 养殖操作都先锁动物再锁账户、库存及土地
+	now := time.Now()
+	animal, err := s.lockLiveAnimal(ctx, tx, activity.ID, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if animal.Health >= dao.AnimalHealthMax {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "动物健康值已满")
+	}
+
+	// 3. 扣减饲料道具或作物库存
+	if req.FeedCropID != 0 {
+		produce, err := s.dao.LockUserProduce(ctx, tx, req.UserAddress, req.FeedCropID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			tx.Rollback()
+			logger.Errorf("锁定收获物库存失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "喂养动物失败")
+		}
+		if err != nil || produce.Quantity < int64(quantity) {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrInsufficientAssets, "作物饲料不足: 需要%d, 可用%d", quantity, produce.Quantity)
+		}
+		if err := s.dao.DeductUserProduce(ctx, tx, produce.ID, int64(quantity)); err != nil {
+			tx.Rollback()
+			logger.Errorf("扣减收获物库存失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "喂养动物失败")
+		}
+	} else {
+		itemKey := strconv.FormatInt(req.ItemTokenID, 10)
+		if _, err := s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Items: map[string]int{itemKey: 1}}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// 4. 恢复健康值并重新计算饿死时间
+	animal.Health = min(dao.AnimalHealthMax, animal.Health+gain)
+	animal.LastFeedTime = &now
+	animal.StarveTime = animal.HealthSettledAt.Add(s.starveAfter(animal.Health))
+	if err := s.dao.UpdateBreedingAnimal(ctx, tx, animal); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新养殖动物失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "喂养动物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交喂养事务失败: %v", err)
+		return nil, errors.Wrap(err, "喂养动物失败")
+	}
+
+	logger.Infof("喂养动物成功: activityID=%d, health=%d", activity.ID, animal.Health)
+	return animal, nil
+}
+
+// CollectAnimalProducts 收集已成年动物本周期的产物, 产量按收获规则计算并按健康值修正, 收集后开始下一周期
+func (s *landServiceImpl) CollectAnimalProducts(ctx context.Context, req request.AnimalActionRequest) (*CollectResult, error) {
+	// 1. 验证活动并计算未按健康值修正的产量
+	activity, err := s.getBreedingActivity(ctx, req.ActivityID, req.UserAddress)
+	if err != nil {
+		return nil, err
+	}
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, activity.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	bonuses, err := s.dao.GetZoneBonuses(ctx, []string{activity.LandTokenID}, dao.BonusTypeYield)
+	if err != nil {
+		logger.Errorf("查询产量加成失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "收集产物失败")
+	}
+	baseYield := s.harvestYield(landInfo, activity, bonuses[activity.ZoneID])
+
+	// 目录条目已删除时按动物名称和生长时长计
+	productName, cycle := activity.CropAnimalName, activity.ExpectedEndTime.Sub(activity.Start_time)
+	catalog, err := s.dao.GetCropAnimalByID(ctx, activity.CropAnimalID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Errorf("查询作物/动物目录失败: %v, id: %d", err, activity.CropAnimalID)
+		return nil, errors.Wrap(err, "查询作物/动物目录失败")
+	}
+	if err == nil {
+		productName, cycle = catalog.ProductLabel(), catalog.ProductCycle()
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. 锁定动物, 检查产物是否就绪
+	now := time.Now()
+	animal, err := s.lockLiveAnimal(ctx, tx, activity.ID, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if remaining := animal.NextCollectTime.Sub(now); remaining > 0 {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "产物尚未就绪, 剩余%d分钟", int(remaining.Minutes())+1)
+	}
+	yield := max(1, int(float64(baseYield)*healthFactor(animal)))
+	experience := int64(float64(yield) * s.cfg.ExperiencePerYield)
+
+	// 3. 发放经验值及产物
+	if experience > 0 {
+		if err := s.dao.AddExperience(ctx, tx, req.UserAddress, experience); err != nil {
+			tx.Rollback()
+			logger.Errorf("增加经验值失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "收集产物失败")
+		}
+	}
+	if err := s.dao.AddUserProduce(ctx, tx, req.UserAddress, activity.CropAnimalID, productName, int64(yield)); err != nil {
+		tx.Rollback()
+		logger.Errorf("发放产物失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "收集产物失败")
+	}
+
+	// 4. 开始下一周期, 更新土地最后收获时间
+	animal.NextCollectTime = now.Add(cycle)
+	animal.CollectCount++
+	if err := s.dao.UpdateBreedingAnimal(ctx, tx, animal); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新养殖动物失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "收集产物失败")
+	}
+	if err := s.dao.UpdateLastHarvestTime(ctx, tx, []string{activity.LandTokenID}, now); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新最后收获时间失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "收集产物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交收集产物事务失败: %v", err)
+		return nil, errors.Wrap(err, "收集产物失败")
+	}
+	s.invalidateLandDetails(ctx, activity.LandTokenID)
+
+	logger.Infof("收集产物成功: activityID=%d, product=%s, yield=%d, experience=%d", activity.ID, productName, yield, experience)
+	return &CollectResult{Animal: animal, ProductName: productName, Yield: yield, Experience: experience}, nil
+}
+
+// BreedAnimal 繁殖已成年且健康的动物: 消耗亲代健康值及土地肥力, 幼崽作为新的养殖活动放入同一分区, 成年后才能产出和繁殖
+func (s *landServiceImpl) BreedAnimal(ctx context.Context, req request.AnimalActionRequest) (*BreedResult, error) {
+	// 1. 验证活动及目录中的繁殖规则
+	activity, err := s.getBreedingActivity(ctx, req.ActivityID, req.UserAddress)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := s.dao.GetCropAnimalByID(ctx, activity.CropAnimalID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "%s已下架", activity.CropAnimalName)
+	}
+	if err != nil {
+		logger.Errorf("查询作物/动物目录失败: %v, id: %d", err, activity.CropAnimalID)
+		return nil, errors.Wrap(err, "查询作物/动物目录失败")
+	}
+	if catalog.OffspringArea <= 0 {
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "%s不可繁殖", catalog.Name)
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. 锁定亲代, 检查是否成年、健康值及冷却时间
+	now := time.Now()
+	parent, err := s.lockLiveAnimal(ctx, tx, activity.ID, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if now.Before(activity.ExpectedEndTime) {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrBreedingNotAllowed, "动物尚未成年")
+	}
+	if parent.Health < s.cfg.BreedMinHealth {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "健康值不足: 需要%d, 当前%d", s.cfg.BreedMinHealth, parent.Health)
+	}
+	if parent.LastBreedTime != nil {
+		cooldown := time.Duration(s.cfg.BreedCooldownMinutes) * time.Minute
+		if remaining := parent.LastBreedTime.Add(cooldown).Sub(now); remaining > 0 {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrBreedingNotAllowed, "繁殖冷却中, 剩余%d分钟", int(remaining.Minutes())+1)
+		}
+	}
+
+	// 3. 锁定土地及分区, 检查肥力与分区剩余面积
+	landInfo, err := s.dao.LockLandInfo(ctx, tx, activity.LandTokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定土地失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		tx.Rollback()
+		return nil, errors.New("无权限在该土地繁殖动物")
+	}
+	if err := s.settleFertility(ctx, landInfo, now); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	requiredFertility := catalog.OffspringArea * catalog.FertilityPerSqm
+	if landInfo.Fertility < requiredFertility {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "土地肥力不足: 需要%d, 当前%d", requiredFertility, landInfo.Fertility)
+	}
+	zone, err := s.dao.LockLandLayout(ctx, tx, activity.LandTokenID, activity.ZoneID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定土地分区失败: %v, tokenID: %s, zoneID: %d", err, activity.LandTokenID, activity.ZoneID)
+		return nil, errors.Wrap(err, "查询土地分区失败")
+	}
+	occupied, err := s.dao.GetZoneOccupiedArea(ctx, tx, activity.LandTokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("查询分区占用面积失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "查询分区占用面积失败")
+	}
+	if free := zone.Area - occupied[zone.ID]; catalog.OffspringArea > free {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrBreedingNotAllowed, "分区剩余面积不足: 幼崽需要%d㎡, 剩余%d㎡", catalog.OffspringArea, max(free, 0))
+	}
+
+	// 4. 创建幼崽的养殖活动及动物记录
+	child := s.newPlanting(landInfo, catalog, activity.ZoneID, req.UserAddress, catalog.OffspringArea)
+	if err := s.dao.CreateLandActivity(ctx, tx, child); err != nil {
+		tx.Rollback()
+		logger.Errorf("创建养殖活动失败: %v", err)
+		return nil, errors.Wrap(err, "繁殖动物失败")
+	}
+	offspring := dao.NewBreedingAnimal(child, s.starveAfter(dao.AnimalHealthMax))
+	offspring.ParentActivityID = activity.ID
+	offspring.Generation = parent.Generation + 1
+	if err := s.dao.CreateBreedingAnimal(ctx, tx, offspring); err != nil {
+		tx.Rollback()
+		logger.Errorf("创建养殖动物失败: %v", err)
+		return nil, errors.Wrap(err, "繁殖动物失败")
+	}
+
+	// 5. 扣减亲代健康值及土地肥力
+	parent.Health = max(dao.AnimalHealthMin+1, parent.Health-s.cfg.BreedHealthCost)
+	parent.LastBreedTime = &now
+	parent.StarveTime = parent.HealthSettledAt.Add(s.starveAfter(parent.Health))
+	if err := s.dao.UpdateBreedingAnimal(ctx, tx, parent); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新养殖动物失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "繁殖动物失败")
+	}
+	if err := s.dao.UpdateFertility(ctx, tx, activity.LandTokenID, landInfo.Fertility-requiredFertility, *landInfo.FertilityUpdateTime); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新土地肥力失败: %v", err)
+		return nil, errors.Wrap(err, "繁殖动物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交繁殖事务失败: %v", err)
+		return nil, errors.Wrap(err, "繁殖动物失败")
+	}
+	s.invalidateLandDetails(ctx, activity.LandTokenID)
+
+	logger.Infof("动物繁殖成功: parentActivityID=%d, offspringActivityID=%d, generation=%d", activity.ID, child.ID, offspring.Generation)
+	return &BreedResult{Parent: parent, Offspring: offspring}, nil
+}
+
+// StarveHungryAnimals 将健康值耗尽的动物标记为饿死, 活动同作物枯萎一样等待清理, 单条失败不影响其他动物
+func (s *landServiceImpl) StarveHungryAnimals(ctx context.Context) error {
+	animals, err := s.dao.GetStarvedAnimals(ctx, time.Now(), starveBatch)
+	if err != nil {
+		return errors.Wrap(err, "查询饿死动物失败")
+	}
+	for _, animal := range animals {
+		activity, starved, err := s.starveAnimal(ctx, animal.ActivityID)
+		if err != nil {
+			logger.Errorf("标记动物饿死失败: %v, activityID: %d", err, animal.ActivityID)
+			continue
+		}
+		if !starved {
+			continue
+		}
+		s.notify(ctx, activity.OwnerAddress, events.TypeAnimalStarved, events.CropData{
+			ActivityID:      activity.ID,
+			LandTokenID:     activity.LandTokenID,
+			CropAnimalID:    activity.CropAnimalID,
+			ExpectedEndTime: activity.ExpectedEndTime,
+		})
+	}
+	return nil
+}
+
+// starveAnimal 在独立事务中标记一群动物饿死, 扫描后已被喂养的返回false
+func (s *landServiceImpl) starveAnimal(ctx context.Context, activityID uint64) (*dao.LandActivity, bool, error) {
+	activity, err := s.dao.GetLandActivityByID(ctx, activityID)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "获取养殖活动失败")
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+	animal, err := s.dao.LockBreedingAnimal(ctx, tx, activityID)
+	if err != nil {
+		tx.Rollback()
+		return nil, false, errors.Wrap(err, "锁定养殖动物失败")
+	}
+	if animal.IsAlive == 0 || animal.StarveTime.After(now) {
+		tx.Rollback()
+		return nil, false, nil
+	}
+	if _, err := s.dao.WitherLandActivity(ctx, tx, activityID); err != nil {
+		tx.Rollback()
+		return nil, false, errors.Wrap(err, "更新活动状态失败")
+	}
+	animal.Health = dao.AnimalHealthMin
+	animal.HealthSettledAt = now
+	animal.IsAlive = 0
+	if err := s.dao.UpdateBreedingAnimal(ctx, tx, animal); err != nil {
+		tx.Rollback()
+		return nil, false, errors.Wrap(err, "更新养殖动物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, false, errors.Wrap(err, "提交饿死事务失败")
+	}
+	s.invalidateLandDetails(ctx, activity.LandTokenID)
+	logger.Infof("动物饿死: activityID=%d, tokenID=%s", activityID, activity.LandTokenID)
+	return activity, true, nil
+}
+
+// GetLandAnimals 获取土地上存活的养殖动物, 健康值按当前时间计算
+func (s *landServiceImpl) GetLandAnimals(ctx context.Context, tokenID string) ([]*dao.BreedingAnimal, error) {
+	if _, err := s.dao.GetLandInfoByTokenID(ctx, tokenID); err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	animals, err := s.dao.GetBreedingAnimalsByLandTokenID(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取养殖动物失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取养殖动物失败")
+	}
+	now := time.Now()
+	for _, animal := range animals {
+		s.settleAnimal(animal, now)
+	}
+	return animals, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// CatalogService 作物/动物目录服务接口
+type CatalogService interface {
+	// 获取作物/动物目录, includeDisabled为true时包含未上架的条目
+	ListCatalog(ctx context.Context, kind *int8, includeDisabled bool) ([]*dao.CropAnimal, error)
+	// 创建作物/动物
+	CreateCropAnimal(ctx context.Context, req request.CropAnimalRequest) (*dao.CropAnimal, error)
+	// 更新作物/动物
+	UpdateCropAnimal(ctx context.Context, req request.UpdateCropAnimalRequest) (*dao.CropAnimal, error)
+	// 删除作物/动物
+	DeleteCropAnimal(ctx context.Context, id uint64) error
+}
+
+type catalogServiceImpl struct {
+	dao *dao.Dao
+}
+
+// 构造函数
+func NewCatalogService(dao *dao.Dao) CatalogService {
+	return &catalogServiceImpl{dao: dao}
+}
+
+// ListCatalog 获取作物/动物目录
+func (s *catalogServiceImpl) ListCatalog(ctx context.Context, kind *int8, includeDisabled bool) ([]*dao.CropAnimal, error) {
+	items, err := s.dao.ListCropAnimals(ctx, kind, !includeDisabled)
+	if err != nil {
+		logger.Errorf("获取作物/动物目录失败: %v", err)
+		return nil, errors.Wrap(err, "获取作物/动物目录失败")
+	}
+	return items, nil
+}
+
+// CreateCropAnimal 创建作物/动物, 未指定时默认上架且1级解锁
+func (s *catalogServiceImpl) CreateCropAnimal(ctx context.Context, req request.CropAnimalRequest) (*dao.CropAnimal, error) {
+	item := &dao.CropAnimal{Enabled: true}
+	applyCropAnimalRequest(item, req)
+	if err := s.dao.CreateCropAnimal(ctx, item); err != nil {
+		logger.Errorf("创建作物/动物失败: %v, name: %s", err, req.Name)
+		return nil, errors.Wrap(err, "创建作物/动物失败")
+	}
+	logger.Infof("作物/动物已创建: id=%d, name=%s", item.ID, item.Name)
+	return item, nil
+}
+
+// UpdateCropAnimal 更新作物/动物, 已开始的种植活动不受影响
+func (s *catalogServiceImpl) UpdateCropAnimal(ctx context.Context, req request.UpdateCropAnimalRequest) (*dao.CropAnimal, error) {
+	item, err := s.dao.GetCropAnimalByID(ctx, req.ID)
+	if err != nil {
+		logger.Errorf("获取作物/动物失败: %v, id: %d", err, req.ID)
+		return nil, errors.Wrap(err, "获取作物/动物失败")
+	}
+	applyCropAnimalRequest(item, req.CropAnimalRequest)
+	if err := s.dao.UpdateCropAnimal(ctx, item); err != nil {
+		logger.Errorf("更新作物/动物失败: %v, id: %d", err, req.ID)
+		return nil, errors.Wrap(err, "更新作物/动物失败")
+	}
+	logger.Infof("作物/动物已更新: id=%d, name=%s", item.ID, item.Name)
+	return item, nil
+}
+
+// DeleteCropAnimal 删除作物/动物
+func (s *catalogServiceImpl) DeleteCropAnimal(ctx context.Context, id uint64) error {
+	deleted, err := s.dao.DeleteCropAnimal(ctx, id)
+	if err != nil {
+		logger.Errorf("删除作物/动物失败: %v, id: %d", err, id)
+		return errors.Wrap(err, "删除作物/动物失败")
+	}
+	if !deleted {
+		return errors.Wrap(gorm.ErrRecordNotFound, "作物/动物不存在")
+	}
+	logger.Infof("作物/动物已删除: id=%d", id)
+	return nil
+}
+
+// applyCropAnimalRequest 将请求中的目录字段写入条目, 未指定是否上架时保持原值
+func applyCropAnimalRequest(item *dao.CropAnimal, req request.CropAnimalRequest) {
+	item.Name = req.Name
+	item.Kind = req.Kind
+	item.GrowthMinutes = req.GrowthMinutes
+	item.FertilityPerSqm = req.FertilityPerSqm
+	item.BaseYield = req.BaseYield
+	item.LandTypes = dao.FormatInt8List(req.LandTypes)
+	item.ZoneTypes = dao.FormatInt8List(req.ZoneTypes)
+	item.Seasons = dao.FormatInt8List(req.Seasons)
+	item.UnlockLevel = max(req.UnlockLevel, 1)
+	item.WitherMinutes = req.WitherMinutes
+	item.WitherRefund = req.WitherRefund
+	item.ProductName = req.ProductName
+	item.CycleMinutes = req.CycleMinutes
+	item.FeedCropIDs = dao.FormatIDList(req.FeedCropIDs)
+	item.OffspringArea = req.OffspringArea
+	if req.Enabled != nil {
+		item.Enabled = *req.Enabled
+	}
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 单次扫描处理的最大记录数, 未处理完的在下次扫描继续
+const farmEventScanLimit = 500
+
+// FarmEventService 扫描按时间触发的农场事件并推送给用户
+type FarmEventService interface {
+	// 推送已成熟作物事件
+	NotifyCropsReady(ctx context.Context) error
+	// 推送即将到期租约事件
+	NotifyRentalsEnding(ctx context.Context) error
+}
+
+type farmEventServiceImpl struct {
+	dao            *dao.Dao
+	events         *events.Bus
+	rentalEndingIn time.Duration
+}
+
+// 构造函数
+func NewFarmEventService(dao *dao.Dao, events *events.Bus, rentalEndingIn time.Duration) FarmEventService {
+	return &farmEventServiceImpl{
+		dao:            dao,
+		events:         events,
+		rentalEndingIn: rentalEndingIn,
+	}
+}
+
+// NotifyCropsReady 推送最近一天内成熟、尚未收获的作物, 同一活动只推送一次
+func (s *farmEventServiceImpl) NotifyCropsReady(ctx context.Context) error {
+	now := time.Now()
+	activities, err := s.dao.GetMaturedActivities(ctx, now.Add(-24*time.Hour), now, farmEventScanLimit)
+	if err != nil {
+		logger.Errorf("查询已成熟作物失败: %v", err)
+		return errors.Wrap(err, "查询已成熟作物失败")
+	}
+
+	for _, activity := range activities {
+		data := events.CropData{
+			ActivityID:      activity.ID,
+			LandTokenID:     activity.LandTokenID,
+			CropAnimalID:    activity.CropAnimalID,
+			ExpectedEndTime: activity.ExpectedEndTime,
+		}
+		key := fmt.Sprintf("%s:%d", events.TypeCropReady, activity.ID)
+		if err := s.events.PublishOnce(ctx, key, activity.OwnerAddress, events.TypeCropReady, data); err != nil {
+			logger.Errorf("推送作物成熟事件失败: %v, activityID: %d", err, activity.ID)
+		}
+	}
+	return nil
+}
+
+// NotifyRentalsEnding 推送即将到期的租约给所有者和租客, 同一订单只推送一次
+func (s *farmEventServiceImpl) NotifyRentalsEnding(ctx context.Context) error {
+	now := time.Now()
+	rentals, err := s.dao.GetRentalsEndingBetween(ctx, now, now.Add(s.rentalEndingIn), farmEventScanLimit)
+	if err != nil {
+		logger.Errorf("查询即将到期租约失败: %v", err)
+		return errors.Wrap(err, "查询即将到期租约失败")
+	}
+
+	for _, rental := range rentals {
+		data := events.RentalData{
+			RentalID:      rental.ID,
+			LandTokenID:   rental.LandTokenID,
+			OwnerAddress:  rental.OwnerAddress,
+			RenterAddress: rental.RenterAddress,
+			RentalEndTime: rental.RentalEndTime,
+		}
+		for _, user := range []string{rental.OwnerAddress, rental.RenterAddress} {
+			key := fmt.Sprintf("%s:%d:%s", events.TypeRentalEnding, rental.ID, user)
+			if err := s.events.PublishOnce(ctx, key, user, events.TypeRentalEnding, data); err != nil {
+				logger.Errorf("推送租约到期事件失败: %v, rentalID: %d", err, rental.ID)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrFertilizeNotAllowed 无法施肥, 如道具不是肥料或土地肥力已满
+var ErrFertilizeNotAllowed = errors.New("无法施肥")
+
+// fertilityCap 土地肥力上限: 等级决定的上限加上稀有度加成
+func (s *landServiceImpl) fertilityCap(land *dao.LandInfo) int {
+	return land.FertilityCap + int(land.Rarity)*s.cfg.RarityFertilityCap
+}
+
+// fertilityRegenRate 土地每小时恢复的肥力, layoutBonus为布局中已激活的肥力恢复加成(百分比)
+func (s *landServiceImpl) fertilityRegenRate(land *dao.LandInfo, layoutBonus float64) float64 {
+	rate := s.cfg.FertilityRegenPerHour * (1 + float64(land.Rarity)*s.cfg.RarityRegenBonus) * (1 + layoutBonus/100)
+	if multiplier, ok := s.cfg.SpecialEffectRegen[land.SpecialEffect]; ok {
+		rate *= multiplier
+	}
+	return rate
+}
+
+// fertilityRegen 肥力恢复参数, 供列表查询按当前肥力筛选和排序
+func (s *landServiceImpl) fertilityRegen() dao.FertilityRegen {
+	return dao.FertilityRegen{
+		PerHour:       s.cfg.FertilityRegenPerHour,
+		RarityBonus:   s.cfg.RarityRegenBonus,
+		RarityCap:     s.cfg.RarityFertilityCap,
+		SpecialEffect: s.cfg.SpecialEffectRegen,
+	}
+}
+
+// settleLinear 结算按每小时rate线性变化的整数值, rate为负表示流失; 返回结算后的值及新的结算时间
+// 结算时间只推进到产生整数变化量所用的时间, 不足1点的部分留到下次结算, 避免频繁写入时变化量被截断为0;
+// 达到变化方向上的边界(lo/hi)后不再变化, 结算时间推进到now
+func settleLinear(value int, settledAt, now time.Time, rate float64, lo, hi int) (int, time.Time) {
+	if rate == 0 || (rate > 0 && value >= hi) || (rate < 0 && value <= lo) {
+		return value, now
+	}
+	hours := now.Sub(settledAt).Hours()
+	if hours <= 0 {
+		return value, settledAt
+	}
+	delta := int(hours * rate)
+	if rate > 0 && value+delta >= hi {
+		return hi, now
+	}
+	if rate < 0 && value+delta <= lo {
+		return lo, now
+	}
+	return value + delta, settledAt.Add(time.Duration(float64(delta) / rate * float64(time.Hour)))
+}
+
+// settledFertility 按上次结算后经过的时间计算土地当前肥力及对应的结算时间, 不超过肥力上限
+func (s *landServiceImpl) settledFertility(land *dao.LandInfo, layoutBonus float64, now time.Time) (int, time.Time) {
+	return settleLinear(land.Fertility, land.FertilitySettledAt(), now, s.fertilityRegenRate(land, layoutBonus), 0, s.fertilityCap(land))
+}
+
+// currentFertility 按上次结算后经过的时间计算土地当前肥力, 不超过肥力上限
+func (s *landServiceImpl) currentFertility(land *dao.LandInfo, layoutBonus float64, now time.Time) int {
+	fertility, _ := s.settledFertility(land, layoutBonus, now)
+	return fertility
+}
+
+// refreshFertility 读取土地时计算当前肥力, 只修改返回的数据不写库; 查询加成失败时保留已结算的肥力
+func (s *landServiceImpl) refreshFertility(ctx context.Context, lands ...*dao.LandInfo) {
+	if len(lands) == 0 {
+		return
+	}
+	tokenIDs := make([]string, 0, len(lands))
+	for _, land := range lands {
+		tokenIDs = append(tokenIDs, land.LandTokenID)
+	}
+	bonuses, err := s.dao.GetLayoutBonuses(ctx, tokenIDs, dao.BonusTypeFertility)
+	if err != nil {
+		logger.Errorf("查询肥力恢复加成失败: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, land := range lands {
+		land.Fertility = s.currentFertility(land, bonuses[land.LandTokenID], now)
+	}
+}
+
+// settleFertility 在修改肥力前结算恢复量, 结算后的肥力与时间由调用方写入
+func (s *landServiceImpl) settleFertility(ctx context.Context, land *dao.LandInfo, now time.Time) error {
+	bonuses, err := s.dao.GetLayoutBonuses(ctx, []string{land.LandTokenID}, dao.BonusTypeFertility)
+	if err != nil {
+		logger.Errorf("查询肥力恢复加成失败: %v, tokenID: %s", err, land.LandTokenID)
+		return errors.Wrap(err, "结算土地肥力失败")
+	}
+	fertility, settledAt := s.settledFertility(land, bonuses[land.LandTokenID], now)
+	land.Fertility = fertility
+	land.FertilityUpdateTime = &settledAt
+	return nil
+}
+
+// FertilizeLand 使用肥料道具恢复土地肥力, 每次使用恢复道具Power点, 不超过肥力上限
+func (s *landServiceImpl) FertilizeLand(ctx context.Context, req request.FertilizeLandRequest) (*dao.LandInfo, error) {
+	uses := max(req.Uses, 1)
+
+	// 1. 验证土地所有权及肥料道具
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.LandTokenID, landInfo.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限为此土地施肥")
+	}
+	power, err := s.fertilizerPower(ctx, req.UserAddress, req.ItemTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. 扣减肥料使用次数
+	itemKey := strconv.FormatInt(req.ItemTokenID, 10)
+	if _, err := s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Items: map[string]int{itemKey: uses}}); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 3. 锁定土地, 结算恢复量后增加肥力
+	landInfo, err = s.dao.LockLandInfo(ctx, tx, req.LandTokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定土地失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if err := s.settleFertility(ctx, landInfo, time.Now()); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	fertilityCap := s.fertilityCap(landInfo)
+	if landInfo.Fertility >= fertilityCap {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrFertilizeNotAllowed, "土地肥力已满")
+	}
+	landInfo.Fertility = min(fertilityCap, landInfo.Fertility+power*uses)
+	if err := s.dao.UpdateFertility(ctx, tx, req.LandTokenID, landInfo.Fertility, *landInfo.FertilityUpdateTime); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新土地肥力失败: %v", err)
+		return nil, errors.Wrap(err, "施肥失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交施肥事务失败: %v", err)
+		return nil, errors.Wrap(err, "施肥失败")
+	}
+	s.invalidateLandDetails(ctx, req.LandTokenID)
+
+	logger.Infof("施肥成功: tokenID=%s, item=%d, uses=%d, fertility=%d", req.LandTokenID, req.ItemTokenID, uses, landInfo.Fertility)
+	return landInfo, nil
+}
+
+// fertilizerPower 校验道具为肥料并返回每次使用恢复的肥力
+func (s *landServiceImpl) fertilizerPower(ctx context.Context, userAddress string, itemTokenID int64) (int, error) {
+	item, err := s.dao.GetUserItemByUserAndToken(ctx, userAddress, itemTokenID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, &InsufficientAssetsError{Asset: strconv.FormatInt(itemTokenID, 10), Required: 1}
+	}
+	if err != nil {
+		logger.Errorf("查询肥料道具失败: %v, user: %s", err, userAddress)
+		return 0, errors.Wrap(err, "查询肥料道具失败")
+	}
+	if item.ItemType != dao.ItemTypeFertilizer || item.Power <= 0 {
+		return 0, errors.Wrap(ErrFertilizeNotAllowed, "该道具不是肥料")
+	}
+	return item.Power, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSettleLinear(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		value       int
+		elapsed     time.Duration
+		rate        float64
+		lo, hi      int
+		wantValue   int
+		wantSettled time.Duration // 新结算时间相对start的偏移
+	}{
+		{name: "不足1点保留到下次", value: 50, elapsed: 20 * time.Minute, rate: 2, hi: 100, wantValue: 50, wantSettled: 0},
+		{name: "只推进整数点对应的时间", value: 50, elapsed: 45 * time.Minute, rate: 2, hi: 100, wantValue: 51, wantSettled: 30 * time.Minute},
+		{name: "恢复到上限后推进到now", value: 99, elapsed: 3 * time.Hour, rate: 2, hi: 100, wantValue: 100, wantSettled: 3 * time.Hour},
+		{name: "已超过上限不变", value: 120, elapsed: time.Hour, rate: 2, hi: 100, wantValue: 120, wantSettled: time.Hour},
+		{name: "流失", value: 80, elapsed: 100 * time.Minute, rate: -4, hi: 100, wantValue: 74, wantSettled: 90 * time.Minute},
+		{name: "流失到下限", value: 3, elapsed: time.Hour, rate: -4, hi: 100, wantValue: 0, wantSettled: time.Hour},
+		{name: "下限不影响恢复", value: 0, elapsed: 10 * time.Minute, rate: 2, hi: 100, wantValue: 0, wantSettled: 0},
+		{name: "速率为0", value: 10, elapsed: time.Hour, rate: 0, hi: 100, wantValue: 10, wantSettled: time.Hour},
+		{name: "时间未前进", value: 10, elapsed: -time.Minute, rate: 2, hi: 100, wantValue: 10, wantSettled: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, settledAt := settleLinear(tt.value, start, start.Add(tt.elapsed), tt.rate, tt.lo, tt.hi)
+			if value != tt.wantValue || !settledAt.Equal(start.Add(tt.wantSettled)) {
+				t.Fatalf("got (%d, %s), want (%d, %s)", value, settledAt.Sub(start), tt.wantValue, tt.wantSettled)
+			}
+		})
+	}
+}
+
+// 频繁写入时分次结算与一次结算的结果一致
+func TestSettleLinearFrequentWrites(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	value, settledAt := 10, start
+	for now := start; now.Before(start.Add(10 * time.Hour)); now = now.Add(7 * time.Minute) {
+		value, settledAt = settleLinear(value, settledAt, now, 2, 0, 100)
+	}
+	end := start.Add(10 * time.Hour)
+	value, _ = settleLinear(value, settledAt, end, 2, 0, 100)
+	if want, _ := settleLinear(10, start, end, 2, 0, 100); value != want {
+		t.Fatalf("frequent settlement = %d, single settlement = %d", value, want)
+	}
+}
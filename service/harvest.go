@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrHarvestNotAllowed 无法收获, 如作物尚未成熟或已被收获
+var ErrHarvestNotAllowed = errors.New("无法收获")
+
+// HarvestResult 收获结果
+type HarvestResult struct {
+	Activity   *dao.LandActivity // 已收获的活动
+	Yield      int               // 产量
+	Experience int64             // 获得的经验值
+}
+
+// harvestYield 计算收获产量: 基础产量×面积, 按土地等级倍率、种植时肥力、稀有度、特殊效果及所在分区的相邻产量加成修正, 至少为1
+func (s *landServiceImpl) harvestYield(land *dao.LandInfo, activity *dao.LandActivity, zoneBonus float64) int {
+	// 种植时肥力满额时产量不打折, 肥力为0时减半
+	fertilityRatio := min(1, float64(activity.PlantFertility)/float64(max(s.fertilityCap(land), 1)))
+	yield := activity.BaseYield * float64(activity.Area) *
+		s.effectiveYieldMultiplier(land) *
+		(0.5 + 0.5*fertilityRatio) *
+		(1 + float64(land.Rarity)*s.cfg.RarityYieldBonus) *
+		(1 + zoneBonus/100)
+	if multiplier, ok := s.cfg.SpecialEffectYield[land.SpecialEffect]; ok {
+		yield *= multiplier
+	}
+	return max(1, int(yield))
+}
+
+// checkHarvest 校验活动处于生长中、已成熟且未枯萎; 养殖的动物按周期收集产物, 只有早期按作物规则养殖、带枯萎时间的动物可以收获
+func (s *landServiceImpl) checkHarvest(activity *dao.LandActivity, now time.Time) error {
+	if activity.Status != dao.ActivityStatusGrowing {
+		return errors.Wrap(ErrHarvestNotAllowed, "作物未处于生长状态")
+	}
+	if activity.ActivityType == dao.ActivityTypeBreeding && activity.WitherTime == nil {
+		return errors.Wrap(ErrHarvestNotAllowed, "动物不能收获, 请按周期收集产物")
+	}
+	if now.Before(activity.ExpectedEndTime) {
+		return errors.Wrap(ErrHarvestNotAllowed, "作物尚未成熟")
+	}
+	if !now.Before(activity.WitherDeadline(s.witherGrace())) {
+		return errors.Wrap(ErrHarvestNotAllowed, "作物已枯萎")
+	}
+	return nil
+}
+
+// cropYield 计算收获产量, 种植的作物按地块照料状态修正, 缺水或虫害严重时无法收获; plot为nil表示没有地块
+func (s *landServiceImpl) cropYield(land *dao.LandInfo, activity *dao.LandActivity, zoneBonus float64, plot *dao.PlotPlanting, now time.Time) (int, error) {
+	yield := s.harvestYield(land, activity, zoneBonus)
+	if plot == nil {
+		return yield, nil
+	}
+	s.refreshPlot(plot, activity, yield, now)
+	if plot.IsHarvestable == 0 {
+		return 0, errors.Wrap(ErrHarvestNotAllowed, "地块缺水或虫害严重")
+	}
+	return plot.ExpectedYield, nil
+}
+
+// HarvestCrop 收获成熟的作物, 种植的作物按地块照料状态修正产量; 收获物计入用户库存并增加经验值, 同时更新土地最后收获时间
+func (s *landServiceImpl) HarvestCrop(ctx context.Context, req request.HarvestCropRequest) (*HarvestResult, error) {
+	// 1. 获取活动记录, 验证权限、状态及是否成熟
+	activity, err := s.dao.GetLandActivityByID(ctx, req.ActivityID)
+	if err != nil {
+		logger.Errorf("获取种植活动失败: %v, activityID: %d", err, req.ActivityID)
+		return nil, errors.Wrap(err, "获取活动信息失败")
+	}
+	if activity.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非活动所有者: activityID=%d, ownerAddress=%s, user=%s", req.ActivityID, activity.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限收获此作物")
+	}
+	now := time.Now()
+	if err := s.checkHarvest(activity, now); err != nil {
+		return nil, err
+	}
+
+	// 2. 计算产量及经验值
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, activity.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	bonuses, err := s.dao.GetZoneBonuses(ctx, []string{activity.LandTokenID}, dao.BonusTypeYield)
+	if err != nil {
+		logger.Errorf("查询产量加成失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+	plot, err := s.dao.GetPlotPlantingByActivityID(ctx, activity.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Errorf("获取地块失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+	yield, err := s.cropYield(landInfo, activity, bonuses[activity.ZoneID], plot, now)
+	if err != nil {
+		return nil, err
+	}
+	experience := int64(float64(yield) * s.cfg.ExperiencePerYield)
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 3. 标记活动已收获, 并发收获时只有一次成功
+	harvested, err := s.dao.HarvestLandActivity(ctx, tx, activity.ID, yield)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("更新活动状态失败: %v", err)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+	if !harvested {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrHarvestNotAllowed, "作物未处于生长状态")
+	}
+
+	// 4. 发放经验值及收获物, 账户在土地之前更新, 与升级的加锁顺序一致
+	if experience > 0 {
+		if err := s.dao.AddExperience(ctx, tx, req.UserAddress, experience); err != nil {
+			tx.Rollback()
+			logger.Errorf("增加经验值失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "收获作物失败")
+		}
+	}
+	if err := s.dao.AddUserProduce(ctx, tx, req.UserAddress, activity.CropAnimalID, activity.CropAnimalName, int64(yield)); err != nil {
+		tx.Rollback()
+		logger.Errorf("发放收获物失败: %v, user: %s", err, req.UserAddress)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+
+	// 5. 结束地块种植, 更新土地最后收获时间
+	if err := s.dao.FinishPlotPlanting(ctx, tx, activity.ID); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新地块失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+	if err := s.dao.UpdateLastHarvestTime(ctx, tx, []string{activity.LandTokenID}, now); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新最后收获时间失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交收获事务失败: %v", err)
+		return nil, errors.Wrap(err, "收获作物失败")
+	}
+	s.invalidateLandDetails(ctx, activity.LandTokenID)
+
+	activity.Status = dao.ActivityStatusHarvested
+	activity.Yield = yield
+	activity.ActualEndTime = &now
+	logger.Infof("作物收获成功: activityID=%d, cropID=%d, yield=%d, experience=%d", req.ActivityID, activity.CropAnimalID, yield, experience)
+	return &HarvestResult{Activity: activity, Yield: yield, Experience: experience}, nil
+}
+
+// GetUserProduce 获取用户的收获物库存
+func (s *landServiceImpl) GetUserProduce(ctx context.Context, userAddress string) ([]*dao.UserProduce, error) {
+	items, err := s.dao.GetUserProduce(ctx, userAddress)
+	if err != nil {
+		logger.Errorf("获取收获物库存失败: %v, user: %s", err, userAddress)
+		return nil, errors.Wrap(err, "获取收获物库存失败")
+	}
+	return items, nil
+}
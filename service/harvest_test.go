@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/dao"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pkg/errors"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var testLandConfig = config.LandConfig{
+	ConstructionYieldRate: 0.5,
+	RarityFertilityCap:    20,
+	RarityYieldBonus:      0.1,
+	SpecialEffectYield:    map[string]float64{"黄金土地": 2},
+	ExperiencePerYield:    1,
+	WitherGraceMinutes:    60,
+}
+
+func TestHarvestYield(t *testing.T) {
+	s := &landServiceImpl{cfg: testLandConfig}
+	upgrading := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		land      dao.LandInfo
+		activity  dao.LandActivity
+		zoneBonus float64
+		want      int
+	}{
+		{name: "基础产量", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 100}, want: 20},
+		{name: "肥力为0减半", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 2, Area: 10}, want: 10},
+		{name: "肥力超过上限不加成", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 150}, want: 20},
+		{name: "等级倍率", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1.5}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 100}, want: 30},
+		{name: "稀有度提高肥力上限及产量", land: dao.LandInfo{Rarity: 1, FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 60}, want: 16},
+		{name: "施工期间", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1, UpgradeCompleteTime: &upgrading}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 100}, want: 10},
+		{name: "特殊效果", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1, SpecialEffect: "黄金土地"}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 100}, want: 40},
+		{name: "相邻产量加成", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 2, Area: 10, PlantFertility: 100}, zoneBonus: 25, want: 25},
+		{name: "至少为1", land: dao.LandInfo{FertilityCap: 100, YieldMultiplier: 1}, activity: dao.LandActivity{BaseYield: 0.01, Area: 1}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.harvestYield(&tt.land, &tt.activity, tt.zoneBonus); got != tt.want {
+				t.Fatalf("harvestYield = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// newMockService 创建使用sqlmock数据库的服务, 不缓存土地详情
+func newMockService(t *testing.T) (*landServiceImpl, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return &landServiceImpl{dao: dao.NewDao(context.Background(), db, nil), cfg: testLandConfig}, mock
+}
+
+// expectHarvest 按HarvestCrop的执行顺序登记查询: 已成熟的作物, 稀有度1、等级倍率1.5的土地, 无相邻加成及地块
+func expectHarvest(mock sqlmock.Sqlmock, now time.Time) {
+	mock.ExpectQuery("FROM `land_activity`").WillReturnRows(sqlmock.NewRows(
+		[]string{"id", "land_token_id", "zone_id", "owner_address", "activity_type", "crop_animal_id", "crop_animal_name", "area", "plant_fertility", "base_yield", "status", "expected_end_time"}).
+		AddRow(1, "land-1", 7, "0xuser", dao.ActivityTypePlanting, 3, "小麦", 10, 120, 2, dao.ActivityStatusGrowing, now.Add(-time.Minute)))
+	mock.ExpectQuery("FROM `land_info`").WillReturnRows(sqlmock.NewRows(
+		[]string{"id", "land_token_id", "rarity", "fertility_cap", "yield_multiplier"}).
+		AddRow(1, "land-1", 1, 100, 1.5))
+	mock.ExpectQuery("FROM `land_layout`").WillReturnRows(sqlmock.NewRows([]string{"id", "bonus_value"}))
+	mock.ExpectQuery("FROM `plot_planting`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `land_activity`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `user_account`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `user_produce`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `plot_planting`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE `land_info`").WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestHarvestCrop(t *testing.T) {
+	s, mock := newMockService(t)
+	expectHarvest(mock, time.Now())
+	mock.ExpectCommit()
+
+	result, err := s.HarvestCrop(context.Background(), request.HarvestCropRequest{ActivityID: 1, UserAddress: "0xuser"})
+	if err != nil {
+		t.Fatalf("HarvestCrop: %v", err)
+	}
+	if result == nil {
+		t.Fatal("HarvestCrop returned nil result")
+	}
+	// 2×10×1.5×1.1, 肥力达到上限(100+20)不打折
+	if result.Yield != 33 || result.Experience != 33 || result.Activity.Status != dao.ActivityStatusHarvested {
+		t.Fatalf("got yield=%d experience=%d status=%d", result.Yield, result.Experience, result.Activity.Status)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHarvestCropCommitFailed(t *testing.T) {
+	s, mock := newMockService(t)
+	expectHarvest(mock, time.Now())
+	commitErr := errors.New("connection lost")
+	mock.ExpectCommit().WillReturnError(commitErr)
+
+	result, err := s.HarvestCrop(context.Background(), request.HarvestCropRequest{ActivityID: 1, UserAddress: "0xuser"})
+	if !errors.Is(err, commitErr) || result != nil {
+		t.Fatalf("got result=%v err=%v, want commit error", result, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetLandDetail 获取土地详情, 快照优先读取缓存; 当前肥力按分区的肥力恢复加成计算, 已到期的租赁不再返回
+func (s *landServiceImpl) GetLandDetail(ctx context.Context, tokenID string) (*dao.LandDetail, error) {
+	detail, err := s.landDetailSnapshot(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取土地详情失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地详情失败")
+	}
+
+	now := time.Now()
+	var fertilityBonus float64
+	for _, layout := range detail.Layouts {
+		if layout.HasAdjacentBonus && layout.BonusType == dao.BonusTypeFertility {
+			fertilityBonus += layout.BonusValue
+		}
+	}
+	detail.Land.Fertility = s.currentFertility(detail.Land, fertilityBonus, now)
+	if detail.Rental != nil && !now.Before(detail.Rental.RentalEndTime) {
+		detail.Rental = nil
+	}
+	return detail, nil
+}
+
+// landDetailSnapshot 读取土地详情快照, 未缓存时从数据库加载并写入缓存; 缓存读写失败不影响返回
+func (s *landServiceImpl) landDetailSnapshot(ctx context.Context, tokenID string) (*dao.LandDetail, error) {
+	if s.cfg.DetailCacheTTL <= 0 {
+		return s.loadLandDetail(ctx, tokenID)
+	}
+	detail, err := s.dao.GetCachedLandDetail(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("读取土地详情缓存失败: %v, tokenID: %s", err, tokenID)
+	}
+	if detail != nil {
+		return detail, nil
+	}
+
+	detail, err = s.loadLandDetail(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dao.CacheLandDetail(ctx, detail, s.cfg.DetailCacheTTL); err != nil {
+		logger.Errorf("写入土地详情缓存失败: %v, tokenID: %s", err, tokenID)
+	}
+	return detail, nil
+}
+
+// loadLandDetail 并行查询组成土地详情的各项数据, 每项一次查询; 土地不存在时返回gorm.ErrRecordNotFound
+func (s *landServiceImpl) loadLandDetail(ctx context.Context, tokenID string) (*dao.LandDetail, error) {
+	detail := &dao.LandDetail{}
+	loaders := []func() error{
+		func() (err error) {
+			detail.Land, err = s.dao.GetLandInfoByTokenID(ctx, tokenID)
+			return err
+		},
+		func() (err error) {
+			detail.Layouts, err = s.dao.GetLayoutsByTokenID(ctx, tokenID)
+			return err
+		},
+		func() (err error) {
+			detail.Activities, err = s.dao.GetActiveByTokenID(ctx, tokenID)
+			return err
+		},
+		func() error {
+			rental, err := s.dao.GetLandRentalByTokenID(ctx, tokenID)
+			if err == nil {
+				detail.Rental = rental
+			}
+			return ignoreNotFound(err)
+		},
+		func() error {
+			listing, err := s.dao.GetLandMarketByTokenID(ctx, tokenID)
+			if err == nil {
+				detail.Listing = listing
+			}
+			return ignoreNotFound(err)
+		},
+		func() error {
+			upgrade, err := s.dao.GetLastLandUpgrade(ctx, tokenID)
+			if err == nil {
+				detail.LastUpgrade = upgrade
+			}
+			return ignoreNotFound(err)
+		},
+	}
+
+	errs := make([]error, len(loaders))
+	var wg sync.WaitGroup
+	for i, load := range loaders {
+		wg.Add(1)
+		go func(i int, load func() error) {
+			defer wg.Done()
+			errs[i] = load()
+		}(i, load)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return detail, nil
+}
+
+// ignoreNotFound 可选的关联记录不存在时不视为错误
+func ignoreNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// invalidateLandDetails 在修改土地相关数据的事务提交后删除土地详情缓存, 删除失败时等待缓存过期
+func (s *landServiceImpl) invalidateLandDetails(ctx context.Context, tokenIDs ...string) {
+	if s.cfg.DetailCacheTTL <= 0 || len(tokenIDs) == 0 {
+		return
+	}
+	if err := s.dao.DeleteCachedLandDetails(ctx, tokenIDs...); err != nil {
+		logger.Errorf("删除土地详情缓存失败: %v, tokenIDs: %v", err, tokenIDs)
+	}
+}
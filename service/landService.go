@@ -2,7 +2,10 @@ package service
 
 import (
 	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/config"
+	"MetaFarmBackend/component/events"
 	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/component/pagination"
 	"MetaFarmBackend/dao"
 	"context"
 	"time"
@@ -13,24 +16,70 @@ import (
 
 // LandService 土地系统业务逻辑接口
 type LandService interface {
-	// 获取用户拥有的土地列表
-	GetUserLands(ctx context.Context, userAddress string) ([]*dao.LandInfo, error)
-	// 获取土地详细信息
-	GetLandDetail(ctx context.Context, tokenID string) (*dao.LandInfo, error)
-	// 升级土地
-	UpgradeLand(ctx context.Context, req request.UpgradeLandRequest) error
+	// 分页获取用户拥有的土地列表
+	GetUserLands(ctx context.Context, userAddress string, req request.ListUserLandsRequest) ([]*dao.LandInfo, *pagination.Result, error)
+	// 获取土地详情: 土地属性、分区、生长中的活动、租赁、挂牌及最近一次升级, 优先读取缓存
+	GetLandDetail(ctx context.Context, tokenID string) (*dao.LandDetail, error)
+	// 使用肥料恢复土地肥力
+	FertilizeLand(ctx context.Context, req request.FertilizeLandRequest) (*dao.LandInfo, error)
+	// 分页获取土地历史时间线
+	GetLandHistory(ctx context.Context, tokenID string, req request.PageRequest) ([]*dao.LandHistoryEntry, *pagination.Result, error)
+	// 升级土地, 返回施工中的升级记录
+	UpgradeLand(ctx context.Context, req request.UpgradeLandRequest) (*dao.LandUpgrade, error)
+	// 使用道具或MFG加速升级施工
+	SpeedUpUpgrade(ctx context.Context, req request.SpeedUpUpgradeRequest) (*dao.LandUpgrade, error)
+	// 取消升级施工并部分退款
+	CancelUpgrade(ctx context.Context, req request.CancelUpgradeRequest) (*dao.LandUpgrade, error)
+	// 获取用户施工中的升级队列
+	GetUpgradeQueue(ctx context.Context, userAddress string) ([]*dao.LandUpgrade, error)
+	// 完成已到完工时间的升级, 由定时任务调用
+	CompleteDueUpgrades(ctx context.Context) error
 	// 创建土地租赁订单
 	CreateRental(ctx context.Context, req request.CreateRentRequest) (*dao.LandRental, error)
-	// 获取活跃租赁订单
-	GetActiveRentals(ctx context.Context, userAddress string) ([]*dao.LandRental, error)
+	// 分页获取活跃租赁订单
+	GetActiveRentals(ctx context.Context, userAddress string, req request.ListRentLandsRequest) ([]*dao.LandRental, *pagination.Result, error)
+	// 分页获取待出售的土地挂牌
+	GetMarketListings(ctx context.Context, req request.ListMarketLandsRequest) ([]*dao.LandMarket, *pagination.Result, error)
+	// 按属性、出售及租赁状态分页搜索全部土地, 首页同时返回按稀有度、地形类型的分面统计
+	SearchLands(ctx context.Context, req request.SearchLandsRequest) ([]*dao.LandSearchResult, *dao.LandFacets, *pagination.Result, error)
 	// 创建土地挂牌
 	CreateMarketListing(ctx context.Context, req request.CreateMarketListingRequest) error
-	// 更新土地布局
-	UpdateLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) error
-	// 种植作物
-	PlantCrop(ctx context.Context, req request.PlantCropRequest) error
-	// 收获作物
-	HarvestCrop(ctx context.Context, req request.HarvestCropRequest) error
+	// 整体替换土地的分区布局
+	UpdateLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) ([]*dao.LandLayout, error)
+	// 获取土地的分区布局
+	GetLandLayout(ctx context.Context, tokenID string) ([]*dao.LandLayout, error)
+	// 预览布局调整后的分区相邻加成, 不保存
+	PreviewLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) ([]*dao.LandLayout, error)
+	// 按作物/动物目录种植作物或养殖动物
+	PlantCrop(ctx context.Context, req request.PlantCropRequest) (*dao.LandActivity, error)
+	// 收获成熟的作物/动物, 收获物与经验值在同一事务中发放
+	HarvestCrop(ctx context.Context, req request.HarvestCropRequest) (*HarvestResult, error)
+	// 收获用户所有土地上已成熟的作物, 可选在原分区重新种植, 每项独立成功或失败
+	HarvestAll(ctx context.Context, req request.BatchHarvestRequest) (*BatchResult, error)
+	// 在已收获活动的原分区重新种植同一作物, 每项独立成功或失败
+	ReplantCrops(ctx context.Context, req request.BatchReplantRequest) (*BatchResult, error)
+	// 将超过收获宽限期的作物标记为枯萎, 由定时任务调用
+	WitherExpiredCrops(ctx context.Context) error
+	// 清理土地上枯萎的作物, 返回清理的数量
+	ClearDeadCrops(ctx context.Context, req request.ClearDeadCropsRequest) (int64, error)
+	// 获取土地各分区的占用面积与剩余面积
+	GetZoneOccupancy(ctx context.Context, tokenID string) ([]*dao.ZoneOccupancy, error)
+	// 浇水、施肥或除虫
+	CarePlot(ctx context.Context, req request.CarePlotRequest) (*dao.PlotPlanting, error)
+	// 获取土地上正在种植的地块
+	GetLandPlots(ctx context.Context, tokenID string) ([]*dao.PlotPlanting, error)
+	// 使用饲料道具或作物收获物喂养动物
+	FeedAnimal(ctx context.Context, req request.FeedAnimalRequest) (*dao.BreedingAnimal, error)
+	// 收集动物本周期的产物, 产物与经验值在同一事务中发放
+	CollectAnimalProducts(ctx context.Context, req request.AnimalActionRequest) (*CollectResult, error)
+	// 繁殖动物, 幼崽作为新的养殖活动放入同一分区
+	BreedAnimal(ctx context.Context, req request.AnimalActionRequest) (*BreedResult, error)
+	// 将健康值降到0的动物标记为饿死, 由定时任务调用
+	StarveHungryAnimals(ctx context.Context) error
+	// 获取土地上存活的养殖动物
+	GetLandAnimals(ctx context.Context, tokenID string) ([]*dao.BreedingAnimal, error)
+	// 获取用户的收获物库存
+	GetUserProduce(ctx context.Context, userAddress string) ([]*dao.UserProduce, error)
 	// 购买土地
 	BuyLand(ctx context.Context, req request.BuyLandRequest) error
 	// 取消土地租赁
@@ -38,114 +87,70 @@ type LandService interface {
 }
 
 type landServiceImpl struct {
-	dao *dao.Dao
+	dao    *dao.Dao
+	events *events.Bus
+	cfg    config.LandConfig
 }
 
 // 构造函数
-func NewLandService(dao *dao.Dao) LandService {
+func NewLandService(dao *dao.Dao, events *events.Bus, cfg config.LandConfig) LandService {
 	return &landServiceImpl{
-		dao: dao,
+		dao:    dao,
+		events: events,
+		cfg:    cfg,
 	}
 }
 
-// GetUserLands 获取用户拥有的土地列表
-func (s *landServiceImpl) GetUserLands(ctx context.Context, userAddress string) ([]*dao.LandInfo, error) {
-	lands, err := s.dao.GetLandsByOwner(ctx, userAddress)
-	if err != nil {
-		logger.Errorf("获取用户土地列表失败: %v, userAddress: %s", err, userAddress)
-		return nil, errors.Wrap(err, "获取土地列表失败")
+// notify 事务提交后推送事件, 推送失败只记录日志, 不影响业务结果
+func (s *landServiceImpl) notify(ctx context.Context, userAddress, eventType string, data interface{}) {
+	if err := s.events.Publish(ctx, userAddress, eventType, data); err != nil {
+		logger.Errorf("推送事件失败: %v, type: %s, user: %s", err, eventType, userAddress)
 	}
-	return lands, nil
 }
 
-// GetLandDetail 获取土地详细信息
-func (s *landServiceImpl) GetLandDetail(ctx context.Context, tokenID string) (*dao.LandInfo, error) {
-	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, tokenID)
-	if err != nil {
-		logger.Errorf("获取土地详情失败: %v, tokenID: %s", err, tokenID)
-		return nil, errors.Wrap(err, "获取土地详情失败")
-	}
-	return landInfo, nil
+// parsePage 按列表的分页规格解析分页参数, 参数不合法时返回的错误可用pagination.ErrInvalidQuery判断
+func parsePage(spec pagination.Spec, req request.PageRequest) (*pagination.Query, error) {
+	return spec.Parse(req.Cursor, req.Limit, req.Sort, req.Order)
 }
 
-// UpgradeLand 升级土地
-func (s *landServiceImpl) UpgradeLand(ctx context.Context, req request.UpgradeLandRequest) error {
-
-	// 1. 验证用户权限
-	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
+// GetUserLands 分页获取用户拥有的土地列表
+func (s *landServiceImpl) GetUserLands(ctx context.Context, userAddress string, req request.ListUserLandsRequest) ([]*dao.LandInfo, *pagination.Result, error) {
+	regen := s.fertilityRegen()
+	q, err := parsePage(dao.LandInfoPageSpec(regen), req.PageRequest)
 	if err != nil {
-		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
-		return errors.Wrap(err, "获取土地信息失败")
+		return nil, nil, err
 	}
-	if landInfo.OwnerAddress != req.UserAddress {
-		logger.Errorf("用户无权限升级土地: tokenID=%s, userAddress=%s, ownerAddress=%s", req.LandTokenID, req.UserAddress, landInfo.OwnerAddress)
-		return errors.New("无权限升级此土地")
-	}
-
-	// 2. 检查土地当前等级和升级条件
-	nextLevel := landInfo.Level + 1
-	upgradeCost := calculateUpgradeCost(int32(landInfo.Level))
-	if upgradeCost == nil {
-		return errors.New("已达到最高等级")
+	filter := dao.LandInfoFilter{
+		Regen:    regen,
+		LandType: req.LandType,
+		Rarity:   req.Rarity,
+		MinLevel: req.MinLevel,
+		MaxLevel: req.MaxLevel,
 	}
-
-	// 3. 扣减升级所需资源 (此处需调用资产服务)
-	// TODO: 实现资源扣减逻辑
-
-	// 4. 创建升级记录
-	tx := s.dao.DB.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	upgradeRecord := dao.NewLandUpgrade(
-		req.LandTokenID,
-		req.UserAddress,
-		landInfo.Level,
-		nextLevel,
-		upgradeCost.TokenAmount,
-		upgradeCost.ItemIDs,
-	)
-	if err := s.dao.CreateLandUpgrade(ctx, tx, upgradeRecord); err != nil {
-		tx.Rollback()
-		logger.Errorf("创建升级记录失败: %v", err)
-		return errors.Wrap(err, "创建升级记录失败")
-	}
-
-	// 5. 更新土地等级
-	if err := s.dao.UpdateLevel(ctx, tx, req.LandTokenID, nextLevel); err != nil {
-		tx.Rollback()
-		logger.Errorf("更新土地等级失败: %v", err)
-		return errors.Wrap(err, "更新土地等级失败")
-	}
-
-	if err := tx.Commit(); err != nil {
-		logger.Errorf("提交事务失败: %v", err)
-		return errors.Wrap(err.Error, "升级土地失败")
+	lands, page, err := s.dao.GetLandsByOwner(ctx, userAddress, filter, q)
+	if err != nil {
+		logger.Errorf("获取用户土地列表失败: %v, userAddress: %s", err, userAddress)
+		return nil, nil, errors.Wrap(err, "获取土地列表失败")
 	}
-
-	logger.Infof("土地升级成功: tokenID=%s, oldLevel=%d, newLevel=%d", req.LandTokenID, landInfo.Level, nextLevel)
-	return nil
+	return lands, page, nil
 }
 
-// calculateUpgradeCost 计算升级成本
-func calculateUpgradeCost(currentLevel int32) *UpgradeCost {
-	// 简单示例: 每级升级成本递增
-	if currentLevel >= 10 {
-		return nil // 最高等级
+// GetLandHistory 分页获取土地历史时间线, 包括铸造、升级、挂牌成交、租赁及种植养殖记录, 供买家了解土地来历
+func (s *landServiceImpl) GetLandHistory(ctx context.Context, tokenID string, req request.PageRequest) ([]*dao.LandHistoryEntry, *pagination.Result, error) {
+	q, err := parsePage(dao.LandHistoryPageSpec, req)
+	if err != nil {
+		return nil, nil, err
 	}
-	return &UpgradeCost{
-		TokenAmount: uint64(100 * int64(currentLevel+1)),
-		ItemIDs:     map[string]int{"1001": 1, "1002": 1}, // 示例道具ID
+	if _, err := s.dao.GetLandInfoByTokenID(ctx, tokenID); err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, tokenID)
+		return nil, nil, errors.Wrap(err, "获取土地信息失败")
 	}
-}
-
-// UpgradeCost 升级成本结构
-type UpgradeCost struct {
-	TokenAmount uint64         // 代币数量
-	ItemIDs     map[string]int // 所需道具ID列表
+	entries, page, err := s.dao.GetLandHistory(ctx, tokenID, q)
+	if err != nil {
+		logger.Errorf("获取土地历史失败: %v, tokenID: %s", err, tokenID)
+		return nil, nil, errors.Wrap(err, "获取土地历史失败")
+	}
+	return entries, page, nil
 }
 
 // CreateRental 创建土地租赁订单
@@ -209,24 +214,102 @@ func (s *landServiceImpl) CreateRental(ctx context.Context, req request.CreateRe
 	// 6. 扣减租客租金并转账给所有者 (此处需调用资产服务)
 	// TODO: 实现租金转账逻辑
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf("提交租赁事务失败: %v", err)
-		return nil, errors.Wrap(err.Error, "创建租赁订单失败")
+		return nil, errors.Wrap(err, "创建租赁订单失败")
 	}
+	s.invalidateLandDetails(ctx, req.LandTokenID)
+
+	rentalData := events.RentalData{
+		RentalID:      landRental.ID,
+		LandTokenID:   landRental.LandTokenID,
+		OwnerAddress:  landRental.OwnerAddress,
+		RenterAddress: landRental.RenterAddress,
+		RentalEndTime: landRental.RentalEndTime,
+	}
+	s.notify(ctx, landRental.OwnerAddress, events.TypeRentalStarted, rentalData)
+	s.notify(ctx, landRental.RenterAddress, events.TypeRentalStarted, rentalData)
 
 	logger.Infof("土地租赁订单创建成功: tokenID=%s, renter=%s, duration=%ds", req.LandTokenID, req.RenterAddress, req.RentalDuration)
 	return landRental, nil
 }
 
-// GetActiveRentals 获取活跃租赁订单
-func (s *landServiceImpl) GetActiveRentals(ctx context.Context, userAddress string) ([]*dao.LandRental, error) {
+// GetActiveRentals 分页获取活跃租赁订单
+func (s *landServiceImpl) GetActiveRentals(ctx context.Context, userAddress string, req request.ListRentLandsRequest) ([]*dao.LandRental, *pagination.Result, error) {
+	q, err := parsePage(dao.LandRentalPageSpec, req.PageRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := dao.LandRentalFilter{
+		LandTokenID:    req.LandTokenID,
+		RentalDuration: req.RentalDuration,
+	}
 	// 查询用户作为租客的活跃租赁订单
-	rentals, err := s.dao.GetLandRentalByRenter(ctx, userAddress)
+	rentals, page, err := s.dao.GetLandRentalByRenter(ctx, userAddress, filter, q)
 	if err != nil {
 		logger.Errorf("获取用户活跃租赁订单失败: %v, userAddress: %s", err, userAddress)
-		return nil, errors.Wrap(err, "获取租赁订单失败")
+		return nil, nil, errors.Wrap(err, "获取租赁订单失败")
+	}
+	return rentals, page, nil
+}
+
+// GetMarketListings 分页获取待出售的土地挂牌
+func (s *landServiceImpl) GetMarketListings(ctx context.Context, req request.ListMarketLandsRequest) ([]*dao.LandMarket, *pagination.Result, error) {
+	q, err := parsePage(dao.LandMarketPageSpec, req.PageRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := dao.LandMarketFilter{
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+		MinArea:  req.MinArea,
+		MaxArea:  req.MaxArea,
+	}
+	listings, page, err := s.dao.GetActiveLandListings(ctx, filter, q)
+	if err != nil {
+		logger.Errorf("获取土地挂牌列表失败: %v", err)
+		return nil, nil, errors.Wrap(err, "获取土地挂牌列表失败")
+	}
+	return listings, page, nil
+}
+
+// SearchLands 按属性、出售及租赁状态分页搜索全部土地, 分面统计只在首页(游标为空)时计算
+func (s *landServiceImpl) SearchLands(ctx context.Context, req request.SearchLandsRequest) ([]*dao.LandSearchResult, *dao.LandFacets, *pagination.Result, error) {
+	regen := s.fertilityRegen()
+	q, err := parsePage(dao.LandSearchPageSpec(regen), req.PageRequest)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	filter := dao.LandSearchFilter{
+		Regen:         regen,
+		LandType:      req.LandType,
+		Rarity:        req.Rarity,
+		MinLevel:      req.MinLevel,
+		MaxLevel:      req.MaxLevel,
+		MinFertility:  req.MinFertility,
+		MaxFertility:  req.MaxFertility,
+		MinArea:       req.MinArea,
+		MaxArea:       req.MaxArea,
+		SpecialEffect: req.SpecialEffect,
+		ForSale:       req.ForSale,
+		ForRent:       req.ForRent,
+		MinPrice:      req.MinPrice,
+		MaxPrice:      req.MaxPrice,
+	}
+	results, page, err := s.dao.SearchLands(ctx, filter, q)
+	if err != nil {
+		logger.Errorf("搜索土地失败: %v", err)
+		return nil, nil, nil, errors.Wrap(err, "搜索土地失败")
+	}
+
+	var facets *dao.LandFacets
+	if req.Cursor == "" {
+		if facets, err = s.dao.GetLandSearchFacets(ctx, filter); err != nil {
+			logger.Errorf("统计土地搜索分面失败: %v", err)
+			return nil, nil, nil, errors.Wrap(err, "搜索土地失败")
+		}
 	}
-	return rentals, nil
+	return results, facets, page, nil
 }
 
 // CreateMarketListing 创建土地挂牌
@@ -279,41 +362,38 @@ func (s *landServiceImpl) CreateMarketListing(ctx context.Context, req request.C
 		return errors.Wrap(err, "创建土地挂牌失败")
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf("提交挂牌事务失败: %v", err)
-		return errors.Wrap(err.Error, "创建土地挂牌失败")
+		return errors.Wrap(err, "创建土地挂牌失败")
 	}
+	s.invalidateLandDetails(ctx, req.TokenID)
 
 	logger.Infof("土地挂牌成功: tokenID=%s, price=%d", req.TokenID, req.Price)
 	return nil
 }
 
-// UpdateLandLayout 更新土地布局
-func (s *landServiceImpl) UpdateLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) error {
+// ErrPlantNotAllowed 不满足作物/动物目录中的种植或养殖条件
+var ErrPlantNotAllowed = errors.New("不满足种植条件")
+
+// PlantCrop 按作物/动物目录在分区中种植作物或养殖动物, 生长时长与肥力消耗由目录决定
+func (s *landServiceImpl) PlantCrop(ctx context.Context, req request.PlantCropRequest) (*dao.LandActivity, error) {
 	// 1. 验证土地所有权
-	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.TokenID)
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
 	if err != nil {
-		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.TokenID)
-		return errors.Wrap(err, "获取土地信息失败")
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
 	}
 	if landInfo.OwnerAddress != req.UserAddress {
-		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.TokenID, landInfo.OwnerAddress, req.UserAddress)
-		return errors.New("无权限更新土地布局")
+		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.LandTokenID, landInfo.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限种植作物")
 	}
 
-	// 2. 验证布局数据 (简单检查非空)
-	// if req.LayoutData == "" {
-	// 	return errors.New("布局数据不能为空")
-	// }
-
-	// 3. 查询现有布局记录
-	layout, err := s.dao.GetLayoutByTokenID(ctx, req.TokenID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Errorf("查询土地布局失败: %v, tokenID: %s", err, req.TokenID)
-		return errors.Wrap(err, "查询土地布局失败")
+	// 2. 按目录校验作物/动物、分区及面积
+	catalog, err := s.checkPlanting(ctx, landInfo, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// 4. 更新或创建布局记录
 	tx := s.dao.DB.Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -321,158 +401,203 @@ func (s *landServiceImpl) UpdateLandLayout(ctx context.Context, req request.Upda
 		}
 	}()
 
-	if layout == nil {
-		// 创建新布局
-		layout = dao.NewLandLayout(req.TokenID, req.Area, req.ZoneType, req.PosX, req.PosY, req.Width, req.Height)
-		if err := s.dao.CreateLandLayout(ctx, tx, layout); err != nil {
-			tx.Rollback()
-			logger.Errorf("创建土地布局失败: %v", err)
-			return errors.Wrap(err, "创建土地布局失败")
-		}
-	} else {
-		// 更新现有布局
-		layout.Area = req.Area
-		layout.ZoneType = req.ZoneType
-		layout.PositionX = req.PosX
-		layout.PositionY = req.PosY
-		layout.Width = req.Width
-		layout.Height = req.Height
-		layout.UpdateTime = time.Now()
-		if err := s.dao.UpdateLandLayout(ctx, tx, layout); err != nil {
-			tx.Rollback()
-			logger.Errorf("更新土地布局失败: %v", err)
-			return errors.Wrap(err, "更新土地布局失败")
-		}
+	// 3. 锁定土地, 结算恢复量后检查土地肥力
+	landInfo, err = s.dao.LockLandInfo(ctx, tx, req.LandTokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定土地失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
 	}
-
-	if err := tx.Commit(); err != nil {
-		logger.Errorf("提交布局事务失败: %v", err)
-		return errors.Wrap(err.Error, "提交布局事务失败")
+	if err := s.settleFertility(ctx, landInfo, time.Now()); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	requiredFertility := req.Area * catalog.FertilityPerSqm
+	if landInfo.Fertility < requiredFertility {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrPlantNotAllowed, "土地肥力不足: 需要%d, 当前%d", requiredFertility, landInfo.Fertility)
 	}
 
-	logger.Infof("土地布局更新成功: tokenID=%s", req.TokenID)
-	return nil
-}
-
-// PlantCrop 种植作物
-func (s *landServiceImpl) PlantCrop(ctx context.Context, req request.PlantCropRequest) error {
-	// 1. 验证土地所有权
-	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
+	// 锁定分区, 生长中及枯萎未清理的活动占用的面积加上本次种植不超过分区面积
+	zone, err := s.dao.LockLandLayout(ctx, tx, req.LandTokenID, req.ZoneID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrPlantNotAllowed, "分区不存在: %d", req.ZoneID)
+	}
 	if err != nil {
-		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
-		return errors.Wrap(err, "获取土地信息失败")
+		tx.Rollback()
+		logger.Errorf("锁定土地分区失败: %v, tokenID: %s, zoneID: %d", err, req.LandTokenID, req.ZoneID)
+		return nil, errors.Wrap(err, "查询土地分区失败")
 	}
-	if landInfo.OwnerAddress != req.UserAddress {
-		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.LandTokenID, landInfo.OwnerAddress, req.UserAddress)
-		return errors.New("无权限种植作物")
+	// 校验后布局或等级可能已被修改, 按锁定的土地和分区重新校验
+	if err := checkPlantingRules(landInfo, catalog, zone, req.Area); err != nil {
+		tx.Rollback()
+		return nil, err
 	}
-
-	// 2. 检查种植面积
-	if req.Area <= 0 || req.Area > landInfo.Area {
-		return errors.New("种植面积无效")
+	occupied, err := s.dao.GetZoneOccupiedArea(ctx, tx, req.LandTokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("查询分区占用面积失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "查询分区占用面积失败")
 	}
-
-	// 3. 检查土地肥力
-	requiredFertility := int(req.Area) * 10 // 每单位面积消耗10点肥力
-	if landInfo.Fertility < requiredFertility {
-		return errors.New("土地肥力不足")
+	if free := zone.Area - occupied[zone.ID]; req.Area > free {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrPlantNotAllowed, "分区剩余面积不足: 剩余%d㎡", max(free, 0))
 	}
 
-	// 4. 创建种植活动
-	tx := s.dao.DB.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// 计算预计收获时间 (示例: 2小时)
-	startTime := time.Now().Unix()
-	endTime := startTime + 7200
-
-	activity := dao.NewLandActivity(
-		req.LandTokenID,
-		req.UserAddress,
-		dao.ActivityTypePlanting,
-		req.CropAnimalID,
-		"", // 作物名称可从配置表获取
-		req.Area,
-		int(requiredFertility),
-		int(endTime),
-	)
-
+	// 4. 创建种植/养殖活动
+	activity := s.newPlanting(landInfo, catalog, req.ZoneID, req.UserAddress, req.Area)
 	if err := s.dao.CreateLandActivity(ctx, tx, activity); err != nil {
 		tx.Rollback()
 		logger.Errorf("创建种植活动失败: %v", err)
-		return errors.Wrap(err, "种植作物失败")
+		return nil, errors.Wrap(err, "种植作物失败")
+	}
+	if activity.ActivityType == dao.ActivityTypePlanting {
+		if err := s.dao.CreatePlotPlanting(ctx, tx, dao.NewPlotPlanting(activity, req.ZoneID)); err != nil {
+			tx.Rollback()
+			logger.Errorf("创建地块失败: %v", err)
+			return nil, errors.Wrap(err, "种植作物失败")
+		}
+	} else {
+		if err := s.dao.CreateBreedingAnimal(ctx, tx, dao.NewBreedingAnimal(activity, s.starveAfter(dao.AnimalHealthMax))); err != nil {
+			tx.Rollback()
+			logger.Errorf("创建养殖动物失败: %v", err)
+			return nil, errors.Wrap(err, "养殖动物失败")
+		}
 	}
 
 	// 5. 扣减土地肥力
-	if err := s.dao.UpdateFertility(ctx, tx, req.LandTokenID, landInfo.Fertility-requiredFertility); err != nil {
+	if err := s.dao.UpdateFertility(ctx, tx, req.LandTokenID, landInfo.Fertility-requiredFertility, *landInfo.FertilityUpdateTime); err != nil {
 		tx.Rollback()
 		logger.Errorf("更新土地肥力失败: %v", err)
-		return errors.Wrap(err, "种植作物失败")
+		return nil, errors.Wrap(err, "种植作物失败")
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf("提交种植事务失败: %v", err)
-		return errors.Wrap(err.Error, "种植作物失败")
+		return nil, errors.Wrap(err, "种植作物失败")
 	}
+	s.invalidateLandDetails(ctx, req.LandTokenID)
 
-	logger.Infof("作物种植成功: tokenID=%s, cropID=%d, area=%.2f", req.LandTokenID, req.CropAnimalID, req.Area)
-	return nil
+	logger.Infof("作物种植成功: tokenID=%s, cropID=%d, area=%d", req.LandTokenID, req.CropAnimalID, req.Area)
+	return activity, nil
 }
 
-// HarvestCrop 收获作物
-func (s *landServiceImpl) HarvestCrop(ctx context.Context, req request.HarvestCropRequest) error {
-	// 1. 获取活动记录
-	activity, err := s.dao.GetLandActivityByID(ctx, req.ActivityID)
+// newPlanting 按目录构造种植/养殖活动, 记录种植时的土地肥力与基础产量, 肥力消耗为面积×每平方米肥力;
+// 作物按目录的收获宽限期设置枯萎时间, 动物不会枯萎, 不喂养时按健康值饿死
+func (s *landServiceImpl) newPlanting(land *dao.LandInfo, catalog *dao.CropAnimal, zoneID uint64, owner string, area int) *dao.LandActivity {
+	activity := dao.NewLandActivity(
+		land.LandTokenID,
+		owner,
+		catalog.Kind,
+		catalog.ID,
+		catalog.Name,
+		area,
+		area*catalog.FertilityPerSqm,
+		catalog.GrowthDuration(),
+	)
+	activity.ZoneID = zoneID
+	activity.PlantFertility = land.Fertility
+	activity.BaseYield = catalog.BaseYield
+	if activity.ActivityType == dao.ActivityTypePlanting {
+		witherTime := activity.ExpectedEndTime.Add(catalog.WitherGrace(s.witherGrace()))
+		activity.WitherTime = &witherTime
+	}
+	return activity
+}
+
+// checkPlanting 校验作物/动物在目录中已上架, 且土地等级、地形、季节及分区满足条件
+func (s *landServiceImpl) checkPlanting(ctx context.Context, land *dao.LandInfo, req request.PlantCropRequest) (*dao.CropAnimal, error) {
+	catalog, err := s.dao.GetCropAnimalByID(ctx, req.CropAnimalID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(ErrPlantNotAllowed, "作物/动物不存在: %d", req.CropAnimalID)
+	}
 	if err != nil {
-		logger.Errorf("获取种植活动失败: %v, activityID: %d", err, req.ActivityID)
-		return errors.Wrap(err, "获取活动信息失败")
+		logger.Errorf("查询作物/动物目录失败: %v, id: %d", err, req.CropAnimalID)
+		return nil, errors.Wrap(err, "查询作物/动物目录失败")
 	}
-
-	// 2. 验证权限和状态
-	if activity.OwnerAddress != req.UserAddress {
-		logger.Errorf("用户非活动所有者: activityID=%d, ownerAddress=%s, user=%s", req.ActivityID, activity.OwnerAddress, req.UserAddress)
-		return errors.New("无权限收获此作物")
+	zone, err := s.dao.GetLandLayoutByID(ctx, req.LandTokenID, req.ZoneID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrapf(ErrPlantNotAllowed, "分区不存在: %d", req.ZoneID)
 	}
-
-	if activity.Status != dao.ActivityStatusGrowing {
-		return errors.New("作物未处于生长状态")
+	if err != nil {
+		logger.Errorf("查询土地分区失败: %v, tokenID: %s, zoneID: %d", err, req.LandTokenID, req.ZoneID)
+		return nil, errors.Wrap(err, "查询土地分区失败")
 	}
-
-	// 3. 检查是否成熟
-	currentTime := time.Now().Unix()
-	if currentTime < activity.ExpectedEndTime.Unix() {
-		return errors.New("作物尚未成熟")
+	if err := checkPlantingRules(land, catalog, zone, req.Area); err != nil {
+		return nil, err
 	}
+	return catalog, nil
+}
 
-	// 4. 更新活动状态和收获
-	tx := s.dao.DB.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+// checkPlantingRules 按目录规则校验在分区中种植指定面积的作物/动物, 不检查肥力及分区剩余面积
+func checkPlantingRules(land *dao.LandInfo, catalog *dao.CropAnimal, zone *dao.LandLayout, area int) error {
+	if !catalog.Enabled {
+		return errors.Wrapf(ErrPlantNotAllowed, "%s暂未开放", catalog.Name)
+	}
+	if land.Level < catalog.UnlockLevel {
+		return errors.Wrapf(ErrPlantNotAllowed, "%s需要土地达到%d级", catalog.Name, catalog.UnlockLevel)
+	}
+	if !catalog.SuitsLandType(land.LandType) {
+		return errors.Wrapf(ErrPlantNotAllowed, "%s不适宜该地形", catalog.Name)
+	}
+	if !catalog.InSeason(time.Now()) {
+		return errors.Wrapf(ErrPlantNotAllowed, "%s不在当前季节种植", catalog.Name)
+	}
+	if !land.ZoneUnlocked(zone.ZoneType) {
+		return errors.Wrap(ErrZoneLocked, "分区类型尚未解锁")
+	}
+	if zone.ZoneType != plantingZoneType(catalog.Kind) {
+		if catalog.Kind == dao.ActivityTypeBreeding {
+			return errors.Wrap(ErrPlantNotAllowed, "动物只能养殖在养殖区")
 		}
-	}()
-
-	// 更新活动状态
-	if err := s.dao.UpdateLandActivityStatus(ctx, tx, activity.ID, dao.ActivityStatusHarvested); err != nil {
-		tx.Rollback()
-		logger.Errorf("更新活动状态失败: %v", err)
-		return errors.Wrap(err, "收获作物失败")
+		return errors.Wrap(ErrPlantNotAllowed, "作物只能种植在种植区")
+	}
+	if !catalog.SuitsZoneType(zone.ZoneType) {
+		return errors.Wrapf(ErrPlantNotAllowed, "%s不适宜该分区", catalog.Name)
+	}
+	if area > zone.Area {
+		return errors.Wrapf(ErrPlantNotAllowed, "种植面积超出分区面积%d㎡", zone.Area)
 	}
+	return nil
+}
 
-	// 5. 增加用户资产 (此处需调用资产服务)
-	// TODO: 实现收获物发放逻辑
+// plantingZoneType 作物只能种植在种植区, 动物只能养殖在养殖区
+func plantingZoneType(kind int8) int8 {
+	if kind == dao.ActivityTypeBreeding {
+		return dao.ZoneTypeBreeding
+	}
+	return dao.ZoneTypePlanting
+}
 
-	if err := tx.Commit(); err != nil {
-		logger.Errorf("提交收获事务失败: %v", err)
-		return errors.Wrap(err.Error, "收获作物失败")
+// GetZoneOccupancy 获取土地各分区的占用面积与剩余面积
+func (s *landServiceImpl) GetZoneOccupancy(ctx context.Context, tokenID string) ([]*dao.ZoneOccupancy, error) {
+	if _, err := s.dao.GetLandInfoByTokenID(ctx, tokenID); err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	layouts, err := s.dao.GetLayoutsByTokenID(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取土地分区失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地分区失败")
+	}
+	occupied, err := s.dao.GetZoneOccupiedArea(ctx, nil, tokenID)
+	if err != nil {
+		logger.Errorf("查询分区占用面积失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "查询分区占用面积失败")
 	}
 
-	logger.Infof("作物收获成功: activityID=%d, cropID=%d", req.ActivityID, activity.CropAnimalID)
-	return nil
+	zones := make([]*dao.ZoneOccupancy, 0, len(layouts))
+	for _, layout := range layouts {
+		zones = append(zones, &dao.ZoneOccupancy{
+			ZoneID:       layout.ID,
+			ZoneType:     layout.ZoneType,
+			Area:         layout.Area,
+			OccupiedArea: occupied[layout.ID],
+			FreeArea:     max(layout.Area-occupied[layout.ID], 0),
+		})
+	}
+	return zones, nil
 }
 
 // BuyLand 购买土地
@@ -527,10 +652,21 @@ func (s *landServiceImpl) BuyLand(ctx context.Context, req request.BuyLandReques
 	// 创建交易记录
 	// TODO: 实现交易记录创建逻辑
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf("提交购买事务失败: %v", err)
-		return errors.Wrap(err.Error, "购买土地失败")
+		return errors.Wrap(err, "购买土地失败")
 	}
+	s.invalidateLandDetails(ctx, listing.LandTokenID)
+
+	soldData := events.LandSoldData{
+		MarketID:      listing.ID,
+		LandTokenID:   listing.LandTokenID,
+		Price:         listing.Price,
+		SellerAddress: listing.SellerAddress,
+		BuyerAddress:  req.BuyerAddress,
+	}
+	s.notify(ctx, listing.SellerAddress, events.TypeLandSold, soldData)
+	s.notify(ctx, req.BuyerAddress, events.TypeLandSold, soldData)
 
 	logger.Infof("土地购买成功: marketID=%d, tokenID=%s, buyer=%s", req.MarketID, listing.LandTokenID, req.BuyerAddress)
 	return nil
@@ -577,10 +713,21 @@ func (s *landServiceImpl) CancelRental(ctx context.Context, req request.CancelRe
 
 	// TODO: 实现退款逻辑
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		logger.Errorf("提交取消租赁事务失败: %v", err)
-		return errors.Wrap(err.Error, "取消租赁失败")
+		return errors.Wrap(err, "取消租赁失败")
+	}
+	s.invalidateLandDetails(ctx, rental.LandTokenID)
+
+	rentalData := events.RentalData{
+		RentalID:      rental.ID,
+		LandTokenID:   rental.LandTokenID,
+		OwnerAddress:  rental.OwnerAddress,
+		RenterAddress: rental.RenterAddress,
+		RentalEndTime: rental.RentalEndTime,
 	}
+	s.notify(ctx, rental.OwnerAddress, events.TypeRentalCancelled, rentalData)
+	s.notify(ctx, rental.RenterAddress, events.TypeRentalCancelled, rentalData)
 
 	logger.Infof("租赁取消成功: rentalID=%d, tokenID=%s", req.RentalID, rental.LandTokenID)
 	return nil
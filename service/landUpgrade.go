@@ -0,0 +1,497 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrUpgradeNotAllowed 不满足升级条件, 如目标等级不连续、已达最高等级、未满足前置条件
+var ErrUpgradeNotAllowed = errors.New("不满足升级条件")
+
+// ErrZoneLocked 分区类型尚未通过升级解锁
+var ErrZoneLocked = errors.New("分区类型尚未解锁")
+
+// 每次扫描最多完成的升级数量
+const dueUpgradeBatch = 100
+
+// UpgradeLand 升级土地, 在同一事务中扣减MFG及道具、记录消费流水并开始施工
+// 施工期间土地不能再次升级且产量降低, 施工完成后应用等级效果
+func (s *landServiceImpl) UpgradeLand(ctx context.Context, req request.UpgradeLandRequest) (*dao.LandUpgrade, error) {
+
+	// 1. 验证用户权限
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户无权限升级土地: tokenID=%s, userAddress=%s, ownerAddress=%s", req.LandTokenID, req.UserAddress, landInfo.OwnerAddress)
+		return nil, errors.New("无权限升级此土地")
+	}
+
+	// 2. 查找升级规则并检查前置条件
+	s.refreshFertility(ctx, landInfo)
+	rule, err := s.checkUpgrade(ctx, landInfo, req.Level)
+	if err != nil {
+		return nil, err
+	}
+	costItems, err := rule.Items()
+	if err != nil {
+		logger.Errorf("解析升级消耗道具失败: %v, ruleID: %d", err, rule.ID)
+		return nil, errors.Wrap(err, "解析升级规则失败")
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 3. 扣减升级所需MFG及道具
+	balance, err := s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Tokens: rule.CostTokens, Items: costItems})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 4. 创建升级记录及消费流水
+	upgradeRecord := dao.NewLandUpgrade(
+		req.LandTokenID,
+		req.UserAddress,
+		landInfo.Level,
+		rule.Level,
+		rule.CostTokens,
+		costItems,
+		rule.ID,
+		time.Duration(rule.BuildMinutes)*time.Minute,
+	)
+	if err := s.dao.CreateLandUpgrade(ctx, tx, upgradeRecord); err != nil {
+		tx.Rollback()
+		logger.Errorf("创建升级记录失败: %v", err)
+		return nil, errors.Wrap(err, "创建升级记录失败")
+	}
+	spend := newUpgradeTransaction(req.UserAddress, dao.TransactionTypeUpgrade, fmt.Sprintf("upgrade-%d", upgradeRecord.ID), req.LandTokenID, rule.CostTokens)
+	if err := s.dao.CreateTransactionRecord(ctx, tx, spend); err != nil {
+		tx.Rollback()
+		logger.Errorf("创建消费记录失败: %v", err)
+		return nil, errors.Wrap(err, "创建消费记录失败")
+	}
+
+	// 5. 开始施工, 施工时长为0时立即完成
+	started, err := s.dao.StartLandConstruction(ctx, tx, req.LandTokenID, landInfo.Level, *upgradeRecord.CompleteTime)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("更新土地施工状态失败: %v", err)
+		return nil, errors.Wrap(err, "更新土地施工状态失败")
+	}
+	if !started {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrUpgradeNotAllowed, "土地等级或施工状态已变化, 请刷新后重试")
+	}
+	finished := rule.BuildMinutes <= 0
+	if finished {
+		if err := s.finishUpgrade(ctx, tx, upgradeRecord); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交事务失败: %v", err)
+		return nil, errors.Wrap(err, "升级土地失败")
+	}
+	s.invalidateLandDetails(ctx, req.LandTokenID)
+
+	logger.Infof("土地开始升级: tokenID=%s, oldLevel=%d, newLevel=%d, completeTime=%s", req.LandTokenID, upgradeRecord.OldLevel, upgradeRecord.NewLevel, upgradeRecord.CompleteTime.Format(time.DateTime))
+	s.notifyBalance(ctx, req.UserAddress, -float64(rule.CostTokens), balance, "land_upgrade")
+	if finished {
+		s.notifyUpgradeFinished(ctx, upgradeRecord)
+	}
+	return upgradeRecord, nil
+}
+
+// SpeedUpUpgrade 使用加速道具缩短施工时间, 未指定道具时按剩余分钟支付MFG立即完成
+func (s *landServiceImpl) SpeedUpUpgrade(ctx context.Context, req request.SpeedUpUpgradeRequest) (*dao.LandUpgrade, error) {
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	upgrade, err := s.lockBuildingUpgrade(ctx, tx, req.UpgradeID, req.UserAddress)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	now := time.Now()
+	remaining := upgrade.CompleteTime.Sub(now)
+	var spent uint64
+	var balance float64
+	switch {
+	case remaining <= 0:
+		// 已到完工时间但定时任务尚未处理, 直接完成
+	case req.ItemTokenID != nil:
+		uses := max(req.Uses, 1)
+		minutes, err := s.speedUpItemMinutes(ctx, req.UserAddress, *req.ItemTokenID)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		key := strconv.FormatInt(*req.ItemTokenID, 10)
+		if _, err := s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Items: map[string]int{key: uses}}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		completeTime := upgrade.CompleteTime.Add(-time.Duration(uses*minutes) * time.Minute)
+		upgrade.CompleteTime = &completeTime
+	default:
+		spent = uint64(math.Ceil(remaining.Minutes())) * s.cfg.SpeedUpMFGPerMinute
+		if balance, err = s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Tokens: spent}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		record := newUpgradeTransaction(req.UserAddress, dao.TransactionTypeSpeedUp, fmt.Sprintf("speedup-%d-%d", upgrade.ID, now.UnixNano()), upgrade.LandTokenID, spent)
+		if err := s.dao.CreateTransactionRecord(ctx, tx, record); err != nil {
+			tx.Rollback()
+			logger.Errorf("创建加速消费记录失败: %v", err)
+			return nil, errors.Wrap(err, "创建消费记录失败")
+		}
+		upgrade.CompleteTime = &now
+	}
+
+	finished := !upgrade.CompleteTime.After(now)
+	if finished {
+		if err := s.finishUpgrade(ctx, tx, upgrade); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	} else {
+		if err := s.dao.UpdateLandUpgrade(ctx, tx, upgrade); err != nil {
+			tx.Rollback()
+			logger.Errorf("更新升级记录失败: %v", err)
+			return nil, errors.Wrap(err, "更新升级记录失败")
+		}
+		if err := s.dao.UpdateLandConstruction(ctx, tx, upgrade.LandTokenID, upgrade.CompleteTime); err != nil {
+			tx.Rollback()
+			logger.Errorf("更新土地施工状态失败: %v", err)
+			return nil, errors.Wrap(err, "更新土地施工状态失败")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交事务失败: %v", err)
+		return nil, errors.Wrap(err, "加速升级失败")
+	}
+	s.invalidateLandDetails(ctx, upgrade.LandTokenID)
+
+	logger.Infof("升级加速成功: upgradeID=%d, completeTime=%s, spentMFG=%d", upgrade.ID, upgrade.CompleteTime.Format(time.DateTime), spent)
+	s.notifyBalance(ctx, req.UserAddress, -float64(spent), balance, "upgrade_speedup")
+	if finished {
+		s.notifyUpgradeFinished(ctx, upgrade)
+	}
+	return upgrade, nil
+}
+
+// CancelUpgrade 取消施工中的升级, 按比例退还MFG, 已消耗的道具不退还
+func (s *landServiceImpl) CancelUpgrade(ctx context.Context, req request.CancelUpgradeRequest) (*dao.LandUpgrade, error) {
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	upgrade, err := s.lockBuildingUpgrade(ctx, tx, req.UpgradeID, req.UserAddress)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 1. 按比例退还MFG
+	refund := uint64(float64(upgrade.CostTokens) * s.cfg.CancelRefundRate)
+	var balance float64
+	if refund > 0 {
+		account, err := s.dao.LockUserAccount(ctx, tx, req.UserAddress)
+		if err != nil {
+			tx.Rollback()
+			logger.Errorf("锁定用户账户失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "查询用户账户失败")
+		}
+		ok, err := s.dao.AddMFGBalance(ctx, tx, req.UserAddress, float64(refund))
+		if err != nil {
+			tx.Rollback()
+			logger.Errorf("退还MFG失败: %v, user: %s", err, req.UserAddress)
+			return nil, errors.Wrap(err, "退还MFG失败")
+		}
+		if !ok {
+			tx.Rollback()
+			return nil, errors.New("退还MFG失败: 用户账户不存在")
+		}
+		balance = account.MFGBalance + float64(refund)
+		record := newUpgradeTransaction(req.UserAddress, dao.TransactionTypeRefund, fmt.Sprintf("refund-%d", upgrade.ID), upgrade.LandTokenID, refund)
+		if err := s.dao.CreateTransactionRecord(ctx, tx, record); err != nil {
+			tx.Rollback()
+			logger.Errorf("创建退款记录失败: %v", err)
+			return nil, errors.Wrap(err, "创建退款记录失败")
+		}
+	}
+
+	// 2. 结束施工并标记升级已取消
+	now := time.Now()
+	upgrade.Status = dao.UpgradeStatusCancelled
+	upgrade.RefundTokens = refund
+	upgrade.FinishTime = &now
+	if err := s.dao.UpdateLandUpgrade(ctx, tx, upgrade); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新升级记录失败: %v", err)
+		return nil, errors.Wrap(err, "更新升级记录失败")
+	}
+	if err := s.dao.UpdateLandConstruction(ctx, tx, upgrade.LandTokenID, nil); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新土地施工状态失败: %v", err)
+		return nil, errors.Wrap(err, "更新土地施工状态失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交事务失败: %v", err)
+		return nil, errors.Wrap(err, "取消升级失败")
+	}
+	s.invalidateLandDetails(ctx, upgrade.LandTokenID)
+
+	logger.Infof("升级已取消: upgradeID=%d, tokenID=%s, refund=%d", upgrade.ID, upgrade.LandTokenID, refund)
+	s.notifyBalance(ctx, req.UserAddress, float64(refund), balance, "upgrade_cancel_refund")
+	return upgrade, nil
+}
+
+// GetUpgradeQueue 获取用户施工中的升级队列
+func (s *landServiceImpl) GetUpgradeQueue(ctx context.Context, userAddress string) ([]*dao.LandUpgrade, error) {
+	upgrades, err := s.dao.GetBuildingUpgradesByOwner(ctx, userAddress)
+	if err != nil {
+		logger.Errorf("获取升级队列失败: %v, user: %s", err, userAddress)
+		return nil, errors.Wrap(err, "获取升级队列失败")
+	}
+	return upgrades, nil
+}
+
+// CompleteDueUpgrades 完成已到完工时间的升级, 由定时任务调用, 单条失败不影响其他升级
+func (s *landServiceImpl) CompleteDueUpgrades(ctx context.Context) error {
+	upgrades, err := s.dao.GetDueLandUpgrades(ctx, time.Now(), dueUpgradeBatch)
+	if err != nil {
+		return errors.Wrap(err, "查询到期升级失败")
+	}
+	for _, due := range upgrades {
+		upgrade, err := s.completeUpgrade(ctx, due.ID)
+		if err != nil {
+			logger.Errorf("完成土地升级失败: %v, upgradeID: %d", err, due.ID)
+			continue
+		}
+		if upgrade != nil {
+			s.notifyUpgradeFinished(ctx, upgrade)
+		}
+	}
+	return nil
+}
+
+// completeUpgrade 在独立事务中完成一条升级, 已被加速完成或取消时返回nil
+func (s *landServiceImpl) completeUpgrade(ctx context.Context, upgradeID uint64) (*dao.LandUpgrade, error) {
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	upgrade, err := s.dao.LockLandUpgrade(ctx, tx, upgradeID)
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrap(err, "锁定升级记录失败")
+	}
+	if upgrade.Status != dao.UpgradeStatusBuilding {
+		tx.Rollback()
+		return nil, nil
+	}
+	if err := s.finishUpgrade(ctx, tx, upgrade); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.Wrap(err, "提交升级完成事务失败")
+	}
+	s.invalidateLandDetails(ctx, upgrade.LandTokenID)
+	logger.Infof("土地升级完成: tokenID=%s, oldLevel=%d, newLevel=%d", upgrade.LandTokenID, upgrade.OldLevel, upgrade.NewLevel)
+	return upgrade, nil
+}
+
+// finishUpgrade 在事务中应用升级规则效果、结束施工并标记升级已完成
+// 肥力上限变化前先按原上限结算已恢复的肥力
+func (s *landServiceImpl) finishUpgrade(ctx context.Context, tx *gorm.DB, upgrade *dao.LandUpgrade) error {
+	rule, err := s.dao.GetLandUpgradeRuleByID(ctx, upgrade.RuleID)
+	if err != nil {
+		logger.Errorf("获取升级规则失败: %v, ruleID: %d", err, upgrade.RuleID)
+		return errors.Wrap(err, "获取升级规则失败")
+	}
+	landInfo, err := s.dao.LockLandInfo(ctx, tx, upgrade.LandTokenID)
+	if err != nil {
+		logger.Errorf("锁定土地失败: %v, tokenID: %s", err, upgrade.LandTokenID)
+		return errors.Wrap(err, "获取土地信息失败")
+	}
+	if err := s.settleFertility(ctx, landInfo, time.Now()); err != nil {
+		return err
+	}
+
+	applyUpgradeEffects(landInfo, rule)
+	updated, err := s.dao.ApplyLandUpgrade(ctx, tx, landInfo, upgrade.OldLevel)
+	if err != nil {
+		logger.Errorf("更新土地等级失败: %v", err)
+		return errors.Wrap(err, "更新土地等级失败")
+	}
+	if !updated {
+		return errors.Wrap(ErrUpgradeNotAllowed, "土地等级已变化, 请刷新后重试")
+	}
+
+	now := time.Now()
+	upgrade.Status = dao.UpgradeStatusFinished
+	upgrade.FinishTime = &now
+	if err := s.dao.UpdateLandUpgrade(ctx, tx, upgrade); err != nil {
+		logger.Errorf("更新升级记录失败: %v", err)
+		return errors.Wrap(err, "更新升级记录失败")
+	}
+	return nil
+}
+
+// lockBuildingUpgrade 锁定用户施工中的升级记录
+func (s *landServiceImpl) lockBuildingUpgrade(ctx context.Context, tx *gorm.DB, upgradeID uint64, userAddress string) (*dao.LandUpgrade, error) {
+	upgrade, err := s.dao.LockLandUpgrade(ctx, tx, upgradeID)
+	if err != nil {
+		logger.Errorf("获取升级记录失败: %v, upgradeID: %d", err, upgradeID)
+		return nil, errors.Wrap(err, "获取升级记录失败")
+	}
+	if upgrade.OwnerAddress != userAddress {
+		return nil, errors.New("无权限操作此升级")
+	}
+	if upgrade.Status != dao.UpgradeStatusBuilding {
+		return nil, errors.Wrap(ErrUpgradeNotAllowed, "升级不在施工中")
+	}
+	return upgrade, nil
+}
+
+// speedUpItemMinutes 校验加速道具并返回每次使用缩短的分钟数, 数量由chargeAssets加锁扣减时校验
+func (s *landServiceImpl) speedUpItemMinutes(ctx context.Context, userAddress string, itemTokenID int64) (int, error) {
+	item, err := s.dao.GetUserItemByUserAndToken(ctx, userAddress, itemTokenID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, &InsufficientAssetsError{Asset: strconv.FormatInt(itemTokenID, 10), Required: 1}
+	}
+	if err != nil {
+		logger.Errorf("查询加速道具失败: %v, user: %s", err, userAddress)
+		return 0, errors.Wrap(err, "查询加速道具失败")
+	}
+	if item.ItemType != dao.ItemTypeSpeedUp || item.Power <= 0 {
+		return 0, errors.Wrap(ErrUpgradeNotAllowed, "该道具不是加速道具")
+	}
+	return item.Power, nil
+}
+
+// effectiveYieldMultiplier 土地当前的产量倍率, 施工期间按配置比例降低
+func (s *landServiceImpl) effectiveYieldMultiplier(land *dao.LandInfo) float64 {
+	if land.UnderConstruction() {
+		return land.YieldMultiplier * s.cfg.ConstructionYieldRate
+	}
+	return land.YieldMultiplier
+}
+
+// checkUpgrade 校验目标等级并返回对应的升级规则
+func (s *landServiceImpl) checkUpgrade(ctx context.Context, land *dao.LandInfo, targetLevel int8) (*dao.LandUpgradeRule, error) {
+	if land.UnderConstruction() {
+		return nil, errors.Wrap(ErrUpgradeNotAllowed, "土地正在升级施工中")
+	}
+	if targetLevel != land.Level+1 {
+		return nil, errors.Wrapf(ErrUpgradeNotAllowed, "只能升级到下一等级(当前%d级)", land.Level)
+	}
+
+	rule, err := s.dao.GetLandUpgradeRule(ctx, land.Rarity, land.LandType, targetLevel)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(ErrUpgradeNotAllowed, "已达到最高等级")
+	}
+	if err != nil {
+		logger.Errorf("查询升级规则失败: %v, tokenID: %s", err, land.LandTokenID)
+		return nil, errors.Wrap(err, "查询升级规则失败")
+	}
+
+	if land.Fertility < rule.MinFertility {
+		return nil, errors.Wrapf(ErrUpgradeNotAllowed, "土地肥力需达到%d", rule.MinFertility)
+	}
+	if rule.CooldownHours > 0 {
+		last, err := s.dao.GetLastLandUpgrade(ctx, land.LandTokenID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Errorf("查询升级记录失败: %v, tokenID: %s", err, land.LandTokenID)
+			return nil, errors.Wrap(err, "查询升级记录失败")
+		}
+		if err == nil {
+			readyAt := last.UpgradeTime.Add(time.Duration(rule.CooldownHours) * time.Hour)
+			if time.Now().Before(readyAt) {
+				return nil, errors.Wrapf(ErrUpgradeNotAllowed, "升级冷却中, %s后可升级", readyAt.Format(time.DateTime))
+			}
+		}
+	}
+	return rule, nil
+}
+
+// applyUpgradeEffects 将升级规则的效果应用到土地
+func applyUpgradeEffects(land *dao.LandInfo, rule *dao.LandUpgradeRule) {
+	land.Level = rule.Level
+	land.Area += rule.AreaIncrease
+	if rule.FertilityCap > 0 {
+		land.FertilityCap = rule.FertilityCap
+	}
+	if rule.YieldMultiplier > 0 {
+		land.YieldMultiplier = rule.YieldMultiplier
+	}
+	land.UnlockZones(rule.UnlockZoneTypes)
+}
+
+// newUpgradeTransaction 升级相关的MFG流水, 链下流水以业务记录ID作为交易标识
+func newUpgradeTransaction(userAddress string, transactionType int8, txHash, landTokenID string, amount uint64) *dao.TransactionRecords {
+	record := dao.NewTransactionRecord(userAddress, transactionType)
+	record.TxHash = txHash
+	record.TokenID, _ = strconv.ParseInt(landTokenID, 10, 64)
+	record.Amount = sql.NullFloat64{Float64: float64(amount), Valid: true}
+	record.Status = dao.TransactionStatusSuccess
+	return record
+}
+
+// notifyBalance 推送MFG余额变动, 变动为0时不推送
+func (s *landServiceImpl) notifyBalance(ctx context.Context, userAddress string, delta, balance float64, reason string) {
+	if delta == 0 {
+		return
+	}
+	s.notify(ctx, userAddress, events.TypeBalanceChanged, events.BalanceData{
+		Asset:   AssetMFG,
+		Delta:   delta,
+		Balance: balance,
+		Reason:  reason,
+	})
+}
+
+// notifyUpgradeFinished 推送升级施工完成
+func (s *landServiceImpl) notifyUpgradeFinished(ctx context.Context, upgrade *dao.LandUpgrade) {
+	s.notify(ctx, upgrade.OwnerAddress, events.TypeUpgradeFinished, events.UpgradeData{
+		UpgradeID:   upgrade.ID,
+		LandTokenID: upgrade.LandTokenID,
+		NewLevel:    upgrade.NewLevel,
+	})
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+)
+
+// ErrLayoutInvalid 布局不合法, 如分区超出土地范围、相互重叠、面积与宽高不符或修改了有作物的分区
+var ErrLayoutInvalid = errors.New("布局不合法")
+
+// validateLayout 校验分区几何: 面积等于宽×高、位于土地网格内、互不重叠, 分区类型已解锁且ID不重复
+func validateLayout(land *dao.LandInfo, zones []request.LayoutZoneReq) error {
+	grid := land.GridSize()
+	seen := make(map[uint64]bool, len(zones))
+	for i, zone := range zones {
+		if zone.ID != 0 {
+			if seen[zone.ID] {
+				return errors.Wrapf(ErrLayoutInvalid, "分区%d重复", zone.ID)
+			}
+			seen[zone.ID] = true
+		}
+		if zone.Area != zone.Width*zone.Height {
+			return errors.Wrapf(ErrLayoutInvalid, "第%d个分区面积%d与宽高%d×%d不符", i+1, zone.Area, zone.Width, zone.Height)
+		}
+		if zone.PositionX+zone.Width > grid || zone.PositionY+zone.Height > grid {
+			return errors.Wrapf(ErrLayoutInvalid, "第%d个分区超出土地范围%d×%d", i+1, grid, grid)
+		}
+		if !land.ZoneUnlocked(zone.ZoneType) {
+			return errors.Wrapf(ErrZoneLocked, "分区类型%d需升级土地后使用", zone.ZoneType)
+		}
+		for j := 0; j < i; j++ {
+			if zonesOverlap(zones[j], zone) {
+				return errors.Wrapf(ErrLayoutInvalid, "第%d个分区与第%d个分区重叠", i+1, j+1)
+			}
+		}
+	}
+	return nil
+}
+
+// zonesOverlap 两个分区的矩形是否相交, 仅共享边界不算重叠
+func zonesOverlap(a, b request.LayoutZoneReq) bool {
+	return a.PositionX < b.PositionX+b.Width && b.PositionX < a.PositionX+a.Width &&
+		a.PositionY < b.PositionY+b.Height && b.PositionY < a.PositionY+a.Height
+}
+
+// zoneChanged 分区的类型或几何是否与请求不同
+func zoneChanged(layout *dao.LandLayout, zone request.LayoutZoneReq) bool {
+	return layout.ZoneType != zone.ZoneType || layout.Area != zone.Area ||
+		layout.PositionX != zone.PositionX || layout.PositionY != zone.PositionY ||
+		layout.Width != zone.Width || layout.Height != zone.Height
+}
+
+// UpdateLandLayout 整体替换土地的分区布局: 带ID的分区更新, 不带ID的新建, 未列出的删除;
+// 有生长中或枯萎未清理作物的分区不能修改或删除, 布局变更后重新计算分区相邻加成
+func (s *landServiceImpl) UpdateLandLayout(ctx context.Context, req request.UpdateLandLayoutRequest) ([]*dao.LandLayout, error) {
+	// 1. 验证土地所有权
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.TokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.TokenID, landInfo.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限更新土地布局")
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. 锁定土地及现有分区, 与种植互斥, 按最新的土地面积校验几何
+	landInfo, err = s.dao.LockLandInfo(ctx, tx, req.TokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定土地失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	if err := validateLayout(landInfo, req.Zones); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	layouts, err := s.dao.LockLayoutsByTokenID(ctx, tx, req.TokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("查询土地布局失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, errors.Wrap(err, "查询土地布局失败")
+	}
+	occupied, err := s.dao.GetZoneOccupiedArea(ctx, tx, req.TokenID)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("查询分区占用面积失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, errors.Wrap(err, "查询分区占用面积失败")
+	}
+	existing := make(map[uint64]*dao.LandLayout, len(layouts))
+	for _, layout := range layouts {
+		existing[layout.ID] = layout
+	}
+
+	// 3. 更新或新建请求中的分区
+	now := time.Now()
+	kept := make(map[uint64]bool, len(req.Zones))
+	for _, zone := range req.Zones {
+		if zone.ID == 0 {
+			layout := dao.NewLandLayout(req.TokenID, zone.Area, zone.ZoneType, zone.PositionX, zone.PositionY, zone.Width, zone.Height)
+			if err := s.dao.CreateLandLayout(ctx, tx, layout); err != nil {
+				tx.Rollback()
+				logger.Errorf("创建土地布局失败: %v", err)
+				return nil, errors.Wrap(err, "创建土地布局失败")
+			}
+			continue
+		}
+		layout, ok := existing[zone.ID]
+		if !ok {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrLayoutInvalid, "分区不存在: %d", zone.ID)
+		}
+		kept[zone.ID] = true
+		if !zoneChanged(layout, zone) {
+			continue
+		}
+		if occupied[zone.ID] > 0 {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrLayoutInvalid, "分区%d中有未收获或未清理的作物, 不能修改", zone.ID)
+		}
+		layout.Area = zone.Area
+		layout.ZoneType = zone.ZoneType
+		layout.PositionX = zone.PositionX
+		layout.PositionY = zone.PositionY
+		layout.Width = zone.Width
+		layout.Height = zone.Height
+		layout.UpdateTime = now
+		if err := s.dao.UpdateLandLayout(ctx, tx, layout); err != nil {
+			tx.Rollback()
+			logger.Errorf("更新土地布局失败: %v", err)
+			return nil, errors.Wrap(err, "更新土地布局失败")
+		}
+	}
+
+	// 4. 删除未列出的分区
+	var removed []uint64
+	for _, layout := range layouts {
+		if kept[layout.ID] {
+			continue
+		}
+		if occupied[layout.ID] > 0 {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrLayoutInvalid, "分区%d中有未收获或未清理的作物, 不能删除", layout.ID)
+		}
+		removed = append(removed, layout.ID)
+	}
+	if len(removed) > 0 {
+		if err := s.dao.DeleteLandLayouts(ctx, tx, req.TokenID, removed); err != nil {
+			tx.Rollback()
+			logger.Errorf("删除土地布局失败: %v", err)
+			return nil, errors.Wrap(err, "删除土地布局失败")
+		}
+	}
+
+	// 5. 按调整后的分区重新计算相邻加成
+	if err := s.refreshAdjacencyBonuses(ctx, tx, req.TokenID); err != nil {
+		tx.Rollback()
+		logger.Errorf("计算分区相邻加成失败: %v, tokenID: %s", err, req.TokenID)
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交布局事务失败: %v", err)
+		return nil, errors.Wrap(err, "提交布局事务失败")
+	}
+	s.invalidateLandDetails(ctx, req.TokenID)
+
+	logger.Infof("土地布局更新成功: tokenID=%s, zones=%d, removed=%d", req.TokenID, len(req.Zones), len(removed))
+	return s.GetLandLayout(ctx, req.TokenID)
+}
+
+// GetLandLayout 获取土地的分区布局
+func (s *landServiceImpl) GetLandLayout(ctx context.Context, tokenID string) ([]*dao.LandLayout, error) {
+	if _, err := s.dao.GetLandInfoByTokenID(ctx, tokenID); err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	layouts, err := s.dao.GetLayoutsByTokenID(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取土地分区失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地分区失败")
+	}
+	return layouts, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+)
+
+func TestZonesOverlap(t *testing.T) {
+	base := request.LayoutZoneReq{PositionX: 2, PositionY: 2, Width: 3, Height: 2}
+	tests := []struct {
+		name string
+		zone request.LayoutZoneReq
+		want bool
+	}{
+		{name: "完全相同", zone: base, want: true},
+		{name: "部分相交", zone: request.LayoutZoneReq{PositionX: 4, PositionY: 3, Width: 2, Height: 2}, want: true},
+		{name: "包含", zone: request.LayoutZoneReq{PositionX: 3, PositionY: 2, Width: 1, Height: 1}, want: true},
+		{name: "共享竖边", zone: request.LayoutZoneReq{PositionX: 5, PositionY: 2, Width: 1, Height: 2}},
+		{name: "共享横边", zone: request.LayoutZoneReq{PositionX: 2, PositionY: 4, Width: 3, Height: 1}},
+		{name: "角点接触", zone: request.LayoutZoneReq{PositionX: 5, PositionY: 4, Width: 1, Height: 1}},
+		{name: "相离", zone: request.LayoutZoneReq{PositionX: 0, PositionY: 0, Width: 1, Height: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zonesOverlap(base, tt.zone); got != tt.want {
+				t.Fatalf("zonesOverlap = %v, want %v", got, tt.want)
+			}
+			if got := zonesOverlap(tt.zone, base); got != tt.want {
+				t.Fatalf("zonesOverlap not symmetric: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateLayout(t *testing.T) {
+	land := &dao.LandInfo{Area: 110, UnlockedZones: "0,1"}
+	zone := func(id uint64, zoneType int8, x, y, w, h int) request.LayoutZoneReq {
+		return request.LayoutZoneReq{ID: id, Area: w * h, ZoneType: zoneType, PositionX: x, PositionY: y, Width: w, Height: h}
+	}
+	tests := []struct {
+		name    string
+		zones   []request.LayoutZoneReq
+		wantErr error
+	}{
+		{name: "空布局", zones: nil},
+		{name: "铺满网格", zones: []request.LayoutZoneReq{zone(1, 0, 0, 0, 10, 5), zone(0, 1, 0, 5, 10, 5)}},
+		{name: "面积与宽高不符", zones: []request.LayoutZoneReq{{Area: 5, Width: 2, Height: 2}}, wantErr: ErrLayoutInvalid},
+		{name: "超出网格", zones: []request.LayoutZoneReq{zone(0, 0, 8, 0, 3, 1)}, wantErr: ErrLayoutInvalid},
+		{name: "重叠", zones: []request.LayoutZoneReq{zone(0, 0, 0, 0, 3, 3), zone(0, 1, 2, 2, 3, 3)}, wantErr: ErrLayoutInvalid},
+		{name: "分区ID重复", zones: []request.LayoutZoneReq{zone(1, 0, 0, 0, 1, 1), zone(1, 0, 5, 5, 1, 1)}, wantErr: ErrLayoutInvalid},
+		{name: "分区类型未解锁", zones: []request.LayoutZoneReq{zone(0, 2, 0, 0, 1, 1)}, wantErr: ErrZoneLocked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLayout(land, tt.zones)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrCareNotAllowed 无法照料地块, 如道具类型不符、冷却中或作物已收获
+var ErrCareNotAllowed = errors.New("无法照料地块")
+
+// 地块照料方式
+const (
+	CareWater     = "water"     // 浇水
+	CareFertilize = "fertilize" // 施肥
+	CarePesticide = "pesticide" // 除虫
+)
+
+// careItemTypes 各照料方式消耗的道具类型
+var careItemTypes = map[string]int8{
+	CareWater:     dao.ItemTypeWater,
+	CareFertilize: dao.ItemTypeFertilizer,
+	CarePesticide: dao.ItemTypePesticide,
+}
+
+// careCooldown 照料方式的冷却时间
+func (s *landServiceImpl) careCooldown(action string) time.Duration {
+	switch action {
+	case CareWater:
+		return time.Duration(s.cfg.WaterCooldownMinutes) * time.Minute
+	case CareFertilize:
+		return time.Duration(s.cfg.FertilizeCooldownMinutes) * time.Minute
+	default:
+		return time.Duration(s.cfg.PesticideCooldownMinutes) * time.Minute
+	}
+}
+
+// settlePlot 按上次结算后经过的时间计算水分流失与虫害增长
+func (s *landServiceImpl) settlePlot(plot *dao.PlotPlanting, now time.Time) {
+	pestSettledAt := plot.PestSettledAtOrDefault()
+	plot.WaterLevel, plot.CareSettledAt = settleLinear(plot.WaterLevel, plot.CareSettledAt, now, -s.cfg.WaterDecayPerHour, dao.PlotLevelMin, dao.PlotLevelMax)
+	plot.PestLevel, pestSettledAt = settleLinear(plot.PestLevel, pestSettledAt, now, s.cfg.PestGrowthPerHour, dao.PlotLevelMin, dao.PlotLevelMax)
+	plot.PestSettledAt = &pestSettledAt
+}
+
+// careFactor 照料状态对产量的修正: 缺水最多减半, 施肥最多增加50%, 虫害最多减半
+func careFactor(plot *dao.PlotPlanting) float64 {
+	water := float64(plot.WaterLevel) / dao.PlotLevelMax
+	fertilizer := float64(plot.FertilizerLevel) / dao.PlotLevelMax
+	pest := float64(plot.PestLevel) / dao.PlotLevelMax
+	return (0.5 + 0.5*water) * (1 + 0.5*fertilizer) * (1 - 0.5*pest)
+}
+
+// refreshPlot 结算地块照料状态, 按baseYield重新计算预计产量及是否可收获; 只修改内存中的数据
+func (s *landServiceImpl) refreshPlot(plot *dao.PlotPlanting, activity *dao.LandActivity, baseYield int, now time.Time) {
+	s.settlePlot(plot, now)
+	plot.ExpectedYield = max(1, int(float64(baseYield)*careFactor(plot)))
+	plot.IsHarvestable = 0
+	if activity.Status == dao.ActivityStatusGrowing &&
+		!now.Before(activity.ExpectedEndTime) && now.Before(activity.WitherDeadline(s.witherGrace())) &&
+		plot.WaterLevel > dao.PlotLevelMin && plot.PestLevel < dao.PlotLevelMax {
+		plot.IsHarvestable = 1
+	}
+}
+
+// CarePlot 浇水、施肥或除虫, 每次消耗一次对应道具并受冷却时间限制
+func (s *landServiceImpl) CarePlot(ctx context.Context, req request.CarePlotRequest) (*dao.PlotPlanting, error) {
+	// 1. 验证活动权限与状态及道具类型
+	activity, err := s.dao.GetLandActivityByID(ctx, req.ActivityID)
+	if err != nil {
+		logger.Errorf("获取种植活动失败: %v, activityID: %d", err, req.ActivityID)
+		return nil, errors.Wrap(err, "获取活动信息失败")
+	}
+	if activity.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非活动所有者: activityID=%d, ownerAddress=%s, user=%s", req.ActivityID, activity.OwnerAddress, req.UserAddress)
+		return nil, errors.New("无权限照料此地块")
+	}
+	now := time.Now()
+	if activity.Status != dao.ActivityStatusGrowing || !now.Before(activity.WitherDeadline(s.witherGrace())) {
+		return nil, errors.Wrap(ErrCareNotAllowed, "作物已收获或枯萎")
+	}
+	power, err := s.careItemPower(ctx, req.UserAddress, req.ItemTokenID, careItemTypes[req.Action])
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 计算未修正的产量, 用于照料后更新预计产量
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, activity.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	bonuses, err := s.dao.GetZoneBonuses(ctx, []string{activity.LandTokenID}, dao.BonusTypeYield)
+	if err != nil {
+		logger.Errorf("查询产量加成失败: %v, tokenID: %s", err, activity.LandTokenID)
+		return nil, errors.Wrap(err, "照料地块失败")
+	}
+	baseYield := s.harvestYield(landInfo, activity, bonuses[activity.ZoneID])
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 3. 扣减道具使用次数
+	itemKey := strconv.FormatInt(req.ItemTokenID, 10)
+	if _, err := s.chargeAssets(ctx, tx, req.UserAddress, assetCost{Items: map[string]int{itemKey: 1}}); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 4. 锁定地块, 结算后检查冷却并应用照料效果
+	plot, err := s.dao.LockPlotPlanting(ctx, tx, activity.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrCareNotAllowed, "该活动没有可照料的地块")
+	}
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("锁定地块失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "照料地块失败")
+	}
+	if plot.IsPlanted == 0 {
+		tx.Rollback()
+		return nil, errors.Wrap(ErrCareNotAllowed, "作物已收获或枯萎")
+	}
+	s.settlePlot(plot, now)
+
+	var last **time.Time
+	switch req.Action {
+	case CareWater:
+		last = &plot.LastWaterTime
+	case CareFertilize:
+		last = &plot.LastFertilizeTime
+	default:
+		last = &plot.LastPesticideTime
+	}
+	if *last != nil {
+		if remaining := (*last).Add(s.careCooldown(req.Action)).Sub(now); remaining > 0 {
+			tx.Rollback()
+			return nil, errors.Wrapf(ErrCareNotAllowed, "冷却中, 剩余%d分钟", int(remaining.Minutes())+1)
+		}
+	}
+	switch req.Action {
+	case CareWater:
+		plot.WaterLevel = min(dao.PlotLevelMax, plot.WaterLevel+power)
+	case CareFertilize:
+		plot.FertilizerLevel = min(dao.PlotLevelMax, plot.FertilizerLevel+power)
+	default:
+		plot.PestLevel = max(dao.PlotLevelMin, plot.PestLevel-power)
+	}
+	*last = &now
+	s.refreshPlot(plot, activity, baseYield, now)
+
+	if err := s.dao.UpdatePlotPlanting(ctx, tx, plot); err != nil {
+		tx.Rollback()
+		logger.Errorf("更新地块失败: %v, activityID: %d", err, activity.ID)
+		return nil, errors.Wrap(err, "照料地块失败")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Errorf("提交照料事务失败: %v", err)
+		return nil, errors.Wrap(err, "照料地块失败")
+	}
+
+	logger.Infof("照料地块成功: activityID=%d, action=%s, water=%d, fertilizer=%d, pest=%d", activity.ID, req.Action, plot.WaterLevel, plot.FertilizerLevel, plot.PestLevel)
+	return plot, nil
+}
+
+// careItemPower 校验道具类型与照料方式一致并返回每次使用的效果值
+func (s *landServiceImpl) careItemPower(ctx context.Context, userAddress string, itemTokenID int64, itemType int8) (int, error) {
+	item, err := s.dao.GetUserItemByUserAndToken(ctx, userAddress, itemTokenID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, &InsufficientAssetsError{Asset: strconv.FormatInt(itemTokenID, 10), Required: 1}
+	}
+	if err != nil {
+		logger.Errorf("查询照料道具失败: %v, user: %s", err, userAddress)
+		return 0, errors.Wrap(err, "查询照料道具失败")
+	}
+	if item.ItemType != itemType || item.Power <= 0 {
+		return 0, errors.Wrap(ErrCareNotAllowed, "道具类型与照料方式不符")
+	}
+	return item.Power, nil
+}
+
+// GetLandPlots 获取土地上正在种植的地块, 照料状态及预计产量按当前时间计算
+func (s *landServiceImpl) GetLandPlots(ctx context.Context, tokenID string) ([]*dao.PlotPlanting, error) {
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取土地信息失败")
+	}
+	plots, err := s.dao.GetPlotPlantingsByLandTokenID(ctx, tokenID)
+	if err != nil {
+		logger.Errorf("获取地块失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取地块失败")
+	}
+	if len(plots) == 0 {
+		return plots, nil
+	}
+
+	activityIDs := make([]uint64, 0, len(plots))
+	for _, plot := range plots {
+		activityIDs = append(activityIDs, plot.ActivityID)
+	}
+	activities, err := s.dao.GetLandActivitiesByIDs(ctx, activityIDs)
+	if err != nil {
+		logger.Errorf("获取种植活动失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取地块失败")
+	}
+	bonuses, err := s.dao.GetZoneBonuses(ctx, []string{tokenID}, dao.BonusTypeYield)
+	if err != nil {
+		logger.Errorf("查询产量加成失败: %v, tokenID: %s", err, tokenID)
+		return nil, errors.Wrap(err, "获取地块失败")
+	}
+
+	now := time.Now()
+	for _, plot := range plots {
+		if activity, ok := activities[plot.ActivityID]; ok {
+			s.refreshPlot(plot, activity, s.harvestYield(landInfo, activity, bonuses[activity.ZoneID]), now)
+		}
+	}
+	return plots, nil
+}
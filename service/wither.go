@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"MetaFarmBackend/api/request"
+	"MetaFarmBackend/component/events"
+	"MetaFarmBackend/component/logger"
+	"MetaFarmBackend/dao"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// 单次扫描处理的枯萎作物数量, 未处理完的在下次扫描继续
+const witherBatch = 200
+
+// witherGrace 作物成熟后的默认收获宽限期, 目录中未单独配置时使用
+func (s *landServiceImpl) witherGrace() time.Duration {
+	return time.Duration(s.cfg.WitherGraceMinutes) * time.Minute
+}
+
+// WitherExpiredCrops 将超过收获宽限期的作物标记为枯萎, 按目录规则退还部分肥力, 单条失败不影响其他作物
+func (s *landServiceImpl) WitherExpiredCrops(ctx context.Context) error {
+	activities, err := s.dao.GetWitheredActivities(ctx, time.Now(), s.witherGrace(), witherBatch)
+	if err != nil {
+		return errors.Wrap(err, "查询枯萎作物失败")
+	}
+	for _, activity := range activities {
+		refund, withered, err := s.witherCrop(ctx, activity)
+		if err != nil {
+			logger.Errorf("标记作物枯萎失败: %v, activityID: %d", err, activity.ID)
+			continue
+		}
+		if !withered {
+			continue
+		}
+		s.notify(ctx, activity.OwnerAddress, events.TypeCropWithered, events.CropData{
+			ActivityID:      activity.ID,
+			LandTokenID:     activity.LandTokenID,
+			CropAnimalID:    activity.CropAnimalID,
+			ExpectedEndTime: activity.ExpectedEndTime,
+			FertilityRefund: refund,
+		})
+	}
+	return nil
+}
+
+// witherCrop 在独立事务中标记一条活动枯萎并退还肥力, 活动已被收获时返回false
+func (s *landServiceImpl) witherCrop(ctx context.Context, activity *dao.LandActivity) (int, bool, error) {
+	// 目录条目已删除时按全部损失处理
+	refund := 0
+	catalog, err := s.dao.GetCropAnimalByID(ctx, activity.CropAnimalID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, false, errors.Wrap(err, "查询作物/动物目录失败")
+	}
+	if err == nil {
+		refund = int(float64(activity.FertilityCost) * catalog.WitherRefund)
+	}
+
+	tx := s.dao.DB.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 与收获相同, 先更新活动再锁定土地
+	withered, err := s.dao.WitherLandActivity(ctx, tx, activity.ID)
+	if err != nil {
+		tx.Rollback()
+		return 0, false, errors.Wrap(err, "更新活动状态失败")
+	}
+	if !withered {
+		tx.Rollback()
+		return 0, false, nil
+	}
+	if err := s.dao.FinishPlotPlanting(ctx, tx, activity.ID); err != nil {
+		tx.Rollback()
+		return 0, false, errors.Wrap(err, "更新地块失败")
+	}
+
+	if refund > 0 {
+		land, err := s.dao.LockLandInfo(ctx, tx, activity.LandTokenID)
+		if err != nil {
+			tx.Rollback()
+			return 0, false, errors.Wrap(err, "锁定土地失败")
+		}
+		if err := s.settleFertility(ctx, land, time.Now()); err != nil {
+			tx.Rollback()
+			return 0, false, err
+		}
+		fertility := min(s.fertilityCap(land), land.Fertility+refund)
+		if err := s.dao.UpdateFertility(ctx, tx, land.LandTokenID, fertility, *land.FertilityUpdateTime); err != nil {
+			tx.Rollback()
+			return 0, false, errors.Wrap(err, "退还土地肥力失败")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, false, errors.Wrap(err, "提交作物枯萎事务失败")
+	}
+	s.invalidateLandDetails(ctx, activity.LandTokenID)
+	logger.Infof("作物已枯萎: activityID=%d, tokenID=%s, fertilityRefund=%d", activity.ID, activity.LandTokenID, refund)
+	return refund, true, nil
+}
+
+// ClearDeadCrops 土地所有者清理土地上枯萎的作物, 释放占用的面积
+func (s *landServiceImpl) ClearDeadCrops(ctx context.Context, req request.ClearDeadCropsRequest) (int64, error) {
+	landInfo, err := s.dao.GetLandInfoByTokenID(ctx, req.LandTokenID)
+	if err != nil {
+		logger.Errorf("获取土地信息失败: %v, tokenID: %s", err, req.LandTokenID)
+		return 0, errors.Wrap(err, "获取土地信息失败")
+	}
+	if landInfo.OwnerAddress != req.UserAddress {
+		logger.Errorf("用户非土地所有者: tokenID=%s, ownerAddress=%s, user=%s", req.LandTokenID, landInfo.OwnerAddress, req.UserAddress)
+		return 0, errors.New("无权限清理此土地")
+	}
+
+	cleared, err := s.dao.ClearDeadActivities(ctx, req.LandTokenID)
+	if err != nil {
+		logger.Errorf("清理枯萎作物失败: %v, tokenID: %s", err, req.LandTokenID)
+		return 0, errors.Wrap(err, "清理枯萎作物失败")
+	}
+	if cleared > 0 {
+		s.invalidateLandDetails(ctx, req.LandTokenID)
+	}
+	logger.Infof("枯萎作物已清理: tokenID=%s, count=%d", req.LandTokenID, cleared)
+	return cleared, nil
+}